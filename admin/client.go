@@ -23,16 +23,19 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	gotime "time"
 
 	"connectrpc.com/connect"
 	"go.uber.org/zap"
 	"golang.org/x/net/http2"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/api/yorkie/v1/v1connect"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/diff"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/yson"
 )
@@ -317,6 +320,88 @@ func (c *Client) UpdateDocument(
 	return converter.FromDocumentSummary(response.Msg.Document), nil
 }
 
+// RestoreDocument restores a document to the given server sequence.
+func (c *Client) RestoreDocument(
+	ctx context.Context,
+	projectName string,
+	documentKey key.Key,
+	serverSeq int64,
+) (*types.DocumentSummary, error) {
+	response, err := c.client.RestoreDocument(
+		ctx,
+		connect.NewRequest(&api.RestoreDocumentRequest{
+			ProjectName: projectName,
+			DocumentKey: documentKey.String(),
+			ServerSeq:   serverSeq,
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentSummary(response.Msg.Document), nil
+}
+
+// GetDocumentGCStatus gets the status of the garbage collection of the
+// document.
+func (c *Client) GetDocumentGCStatus(
+	ctx context.Context,
+	projectName string,
+	documentKey key.Key,
+) (*types.DocumentGCStatus, error) {
+	response, err := c.client.GetDocumentGCStatus(
+		ctx,
+		connect.NewRequest(&api.GetDocumentGCStatusRequest{
+			ProjectName: projectName,
+			DocumentKey: documentKey.String(),
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentGCStatus(response.Msg.Status)
+}
+
+// CollectDocumentGarbage detaches the clients that have not been accessed for
+// the given threshold from the document, and compacts the document if no
+// clients remain attached. If the threshold is empty, the client deactivate
+// threshold of the project is used.
+func (c *Client) CollectDocumentGarbage(
+	ctx context.Context,
+	projectName string,
+	documentKey key.Key,
+	staleThreshold string,
+) (*types.DocumentGCResult, error) {
+	response, err := c.client.CollectDocumentGarbage(
+		ctx,
+		connect.NewRequest(&api.CollectDocumentGarbageRequest{
+			ProjectName:    projectName,
+			DocumentKey:    documentKey.String(),
+			StaleThreshold: staleThreshold,
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := converter.FromDocumentGCStatus(response.Msg.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	var detachedClients []types.ID
+	for _, id := range response.Msg.DetachedClientIds {
+		detachedClients = append(detachedClients, types.ID(id))
+	}
+
+	return &types.DocumentGCResult{
+		DetachedClients: detachedClients,
+		Compacted:       response.Msg.Compacted,
+		Status:          status,
+	}, nil
+}
+
 // RemoveDocument removes a document of the given key.
 func (c *Client) RemoveDocument(
 	ctx context.Context,
@@ -417,6 +502,59 @@ func (c *Client) ListChangeSummaries(
 	return summaries, nil
 }
 
+// GetDocumentAtRevision gets the document at the given server sequence. If the
+// given time is not zero, the document at the time is returned instead. A
+// negative server sequence means the latest revision.
+func (c *Client) GetDocumentAtRevision(
+	ctx context.Context,
+	projectName string,
+	documentKey key.Key,
+	serverSeq int64,
+	at gotime.Time,
+) (*types.DocumentRevision, error) {
+	req := &api.GetDocumentAtRevisionRequest{
+		ProjectName: projectName,
+		DocumentKey: documentKey.String(),
+		ServerSeq:   serverSeq,
+	}
+	if !at.IsZero() {
+		req.Timestamp = timestamppb.New(at)
+	}
+
+	response, err := c.client.GetDocumentAtRevision(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DocumentRevision{
+		Key:       documentKey,
+		ServerSeq: response.Msg.ServerSeq,
+		Root:      response.Msg.Root,
+	}, nil
+}
+
+// GetDocumentDiff gets the differences of the document between the given
+// server sequences.
+func (c *Client) GetDocumentDiff(
+	ctx context.Context,
+	projectName string,
+	documentKey key.Key,
+	fromServerSeq int64,
+	toServerSeq int64,
+) ([]*diff.Diff, error) {
+	response, err := c.client.GetDocumentDiff(ctx, connect.NewRequest(&api.GetDocumentDiffRequest{
+		ProjectName:   projectName,
+		DocumentKey:   documentKey.String(),
+		FromServerSeq: fromServerSeq,
+		ToServerSeq:   toServerSeq,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentDiffs(response.Msg.Diffs), nil
+}
+
 // GetServerVersion gets the server version.
 func (c *Client) GetServerVersion(ctx context.Context) (*types.VersionDetail, error) {
 	response, err := c.client.GetServerVersion(ctx, connect.NewRequest(&api.GetServerVersionRequest{}))
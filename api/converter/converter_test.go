@@ -24,11 +24,13 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/test/helper"
@@ -237,6 +239,114 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, tree.ToXML(), clone.ToXML())
 	})
 
+	t.Run("tree move converting test", func(t *testing.T) {
+		d1 := document.New("d1")
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", json.TreeNode{
+				Type: "r",
+				Children: []json.TreeNode{
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "hello"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "world"}}},
+				},
+			}).Move([]int{1}, []int{0})
+			return nil
+		}))
+		assert.Equal(t, "<r><p>world</p><p>hello</p></r>", d1.Root().GetTree("t").ToXML())
+
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+
+		d2 := document.New("d1")
+		assert.NoError(t, d2.ApplyChangePack(pack))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		bytes, err := converter.TreeToBytes(d1.Root().GetTree("t").Tree)
+		assert.NoError(t, err)
+		clone, err := converter.BytesToTree(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, d1.Root().GetTree("t").ToXML(), clone.ToXML())
+		assert.NotNil(t, clone.Root().Children()[0].MovedAt())
+	})
+
+	t.Run("tree move log converting test", func(t *testing.T) {
+		d1, d2 := document.New("d1"), document.New("d1")
+		d1.SetActor(time.ActorID{2})
+		d2.SetActor(time.ActorID{1})
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", json.TreeNode{
+				Type: "r",
+				Children: []json.TreeNode{
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "c"}}},
+				},
+			})
+			return nil
+		}))
+		assert.NoError(t, d2.ApplyChangePack(d1.CreateChangePack()))
+
+		// 01. Move nodes concurrently. The move of d2 is executed earlier.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k", "v")
+			return nil
+		}))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{3})
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{2}, []int{0})
+			return nil
+		}))
+
+		// 02. Restore the tree of d1 from the snapshot and apply the move of d2
+		// to both of them.
+		bytes, err := converter.TreeToBytes(d1.Root().GetTree("t").Tree)
+		assert.NoError(t, err)
+		clone, err := converter.BytesToTree(bytes)
+		assert.NoError(t, err)
+		assert.Len(t, clone.Moves(), 1)
+
+		pack := d2.CreateChangePack()
+		ops := pack.Changes[len(pack.Changes)-1].Operations()
+		move := ops[len(ops)-1].(*operations.TreeMove)
+		assert.NoError(t, clone.Move(move.Target(), move.ToPos(), move.ExecutedAt()))
+
+		pbPack, err := converter.ToChangePack(pack)
+		assert.NoError(t, err)
+		pack, err = converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		assert.NoError(t, d1.ApplyChangePack(pack))
+		assert.Equal(t, "<r><p>c</p><p>a</p><p>b</p></r>", d1.Root().GetTree("t").ToXML())
+		assert.Equal(t, d1.Root().GetTree("t").ToXML(), clone.ToXML())
+	})
+
+	t.Run("tree schema converting test", func(t *testing.T) {
+		root := helper.BuildTreeNode(&json.TreeNode{
+			Type:     "r",
+			Children: []json.TreeNode{{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "hello"}}}},
+		})
+
+		tree := crdt.NewTree(root, time.InitialTicket)
+		tree.SetSchema(&crdt.TreeSchema{
+			Root: "r",
+			Nodes: map[string]crdt.TreeNodeSpec{
+				"r":   {Content: []string{"p", "img"}},
+				"p":   {TextOnly: true},
+				"img": {RequiredAttributes: []string{"src"}, Attributes: []string{"alt"}},
+			},
+		})
+		bytes, err := converter.TreeToBytes(tree)
+		assert.NoError(t, err)
+		clone, err := converter.BytesToTree(bytes)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tree.Schema(), clone.Schema())
+		assert.NoError(t, clone.Validate())
+	})
+
 	t.Run("array converting to bytes test", func(t *testing.T) {
 		root := helper.TestRoot()
 		ctx := helper.TextChangeContext(root)
@@ -270,6 +380,20 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, change, clone)
 	})
 
+	t.Run("presence patch converting test", func(t *testing.T) {
+		p := innerpresence.New()
+		assert.NoError(t, p.SetValue("cursor", map[string]int{"x": 1, "y": 2}))
+		change := &innerpresence.Change{
+			ChangeType: innerpresence.Patch,
+			Presence:   p,
+		}
+
+		pbChange := converter.ToPresenceChange(change)
+		assert.Equal(t, api.PresenceChange_CHANGE_TYPE_PATCH, pbChange.Type)
+		assert.Equal(t, map[string]string{"cursor": `{"x":1,"y":2}`}, pbChange.Presence.Data)
+		assert.Equal(t, change, converter.FromPresenceChange(pbChange))
+	})
+
 	t.Run("properly encode and decode tree test", func(t *testing.T) {
 		doc := document.New(helper.TestDocKey(t))
 		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
@@ -300,4 +424,10 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, obj.Get("t").(*crdt.Tree).Root().Len(), doc.Root().GetTree("t").Len())
 		assert.Equal(t, obj.Get("t").(*crdt.Tree).ToXML(), doc.Root().GetTree("t").ToXML())
 	})
+	t.Run("project size limit converting test", func(t *testing.T) {
+		project := &types.Project{MaxDocumentSize: math.MaxInt32 + 1, MaxChangeSize: 1024}
+		pbProject := converter.ToProject(project)
+		assert.Equal(t, int32(math.MaxInt32), pbProject.MaxDocumentSize)
+		assert.Equal(t, int32(1024), pbProject.MaxChangeSize)
+	})
 }
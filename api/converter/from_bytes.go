@@ -105,6 +105,20 @@ func BytesToTree(snapshot []byte) (*crdt.Tree, error) {
 	return tree, nil
 }
 
+// BytesToCounter creates a Counter from the given bytes.
+func BytesToCounter(snapshot []byte) (*crdt.Counter, error) {
+	if len(snapshot) == 0 {
+		return nil, errors.New("snapshot should not be empty")
+	}
+
+	pbCounter := &api.JSONElement{}
+	if err := proto.Unmarshal(snapshot, pbCounter); err != nil {
+		return nil, fmt.Errorf("unmarshal counter: %w", err)
+	}
+
+	return fromJSONCounter(pbCounter.GetCounter())
+}
+
 func fromJSONElement(pbElem *api.JSONElement) (crdt.Element, error) {
 	switch decoded := pbElem.Body.(type) {
 	case *api.JSONElement_JsonObject:
@@ -119,6 +133,10 @@ func fromJSONElement(pbElem *api.JSONElement) (crdt.Element, error) {
 		return fromJSONCounter(decoded.Counter)
 	case *api.JSONElement_Tree_:
 		return fromJSONTree(decoded.Tree)
+	case *api.JSONElement_JsonSet:
+		return fromJSONSet(decoded.JsonSet)
+	case *api.JSONElement_MvRegister:
+		return fromMVRegister(decoded.MvRegister)
 	default:
 		return nil, fmt.Errorf("%s: %w", decoded, ErrUnsupportedElement)
 	}
@@ -269,6 +287,14 @@ func fromJSONText(
 	text.SetMovedAt(movedAt)
 	text.SetRemovedAt(removedAt)
 
+	for _, pbMark := range pbText.Marks {
+		mark, err := fromTextMark(pbMark)
+		if err != nil {
+			return nil, err
+		}
+		text.Mark(mark, mark.ID())
+	}
+
 	return text, nil
 }
 
@@ -305,9 +331,50 @@ func fromJSONCounter(pbCnt *api.JSONElement_Counter) (*crdt.Counter, error) {
 	counter.SetMovedAt(movedAt)
 	counter.SetRemovedAt(removedAt)
 
+	if pbCnt.Bounded {
+		counter.SetLowerBound(pbCnt.LowerBound)
+	}
+	for _, pbTransfer := range pbCnt.Transfers {
+		from, err := time.ActorIDFromBytes(pbTransfer.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := time.ActorIDFromBytes(pbTransfer.To)
+		if err != nil {
+			return nil, err
+		}
+		if err := counter.Transfer(from, to, pbTransfer.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	increases, err := fromCounterEntries(pbCnt.Increases)
+	if err != nil {
+		return nil, err
+	}
+	resets, err := fromCounterEntries(pbCnt.Resets)
+	if err != nil {
+		return nil, err
+	}
+	if err := counter.Restore(increases, resets, pbCnt.IsReset); err != nil {
+		return nil, err
+	}
+
 	return counter, nil
 }
 
+func fromCounterEntries(pbEntries []*api.CounterEntry) ([]*crdt.CounterEntry, error) {
+	var entries []*crdt.CounterEntry
+	for _, pbEntry := range pbEntries {
+		updatedAt, err := fromTimeTicket(pbEntry.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, crdt.NewCounterEntry(pbEntry.Sum, updatedAt))
+	}
+	return entries, nil
+}
+
 func fromTextNode(
 	pbNode *api.TextNode,
 ) (*crdt.RGATreeSplitNode[*crdt.TextValue], error) {
@@ -357,6 +424,101 @@ func fromTextNodeID(
 	), nil
 }
 
+func fromTextMark(pbMark *api.TextMark) (*crdt.TextMark, error) {
+	id, err := fromTimeTicket(pbMark.Id)
+	if err != nil {
+		return nil, err
+	}
+	start, err := fromTextMarkAnchor(pbMark.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := fromTextMarkAnchor(pbMark.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return crdt.NewTextMark(
+		id,
+		pbMark.Key,
+		pbMark.Value,
+		crdt.MarkExpand(pbMark.Expand),
+		pbMark.Mergeable,
+		pbMark.Removed,
+		start,
+		end,
+	), nil
+}
+
+func fromTextMarkAnchor(pbAnchor *api.TextMarkAnchor) (*crdt.TextMarkAnchor, error) {
+	if pbAnchor == nil {
+		return nil, nil
+	}
+
+	id, err := fromTextNodeID(pbAnchor.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return crdt.NewTextMarkAnchor(id, pbAnchor.After), nil
+}
+
+func fromJSONSet(pbSet *api.JSONElement_JSONSet) (*crdt.Set, error) {
+	createdAt, err := fromTimeTicket(pbSet.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	movedAt, err := fromTimeTicket(pbSet.MovedAt)
+	if err != nil {
+		return nil, err
+	}
+	removedAt, err := fromTimeTicket(pbSet.RemovedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	set := crdt.NewSet(createdAt)
+	for _, pbNode := range pbSet.Nodes {
+		primitive, err := fromJSONPrimitive(pbNode)
+		if err != nil {
+			return nil, err
+		}
+		set.Add(primitive)
+	}
+	set.SetMovedAt(movedAt)
+	set.SetRemovedAt(removedAt)
+
+	return set, nil
+}
+
+func fromMVRegister(pbRegister *api.JSONElement_MVRegister) (*crdt.MVRegister, error) {
+	createdAt, err := fromTimeTicket(pbRegister.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	movedAt, err := fromTimeTicket(pbRegister.MovedAt)
+	if err != nil {
+		return nil, err
+	}
+	removedAt, err := fromTimeTicket(pbRegister.RemovedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	register := crdt.NewMVRegister(createdAt)
+	for _, pbNode := range pbRegister.Nodes {
+		primitive, err := fromJSONPrimitive(pbNode)
+		if err != nil {
+			return nil, err
+		}
+		register.Assign(primitive, nil, nil)
+	}
+	register.SetMovedAt(movedAt)
+	register.SetRemovedAt(removedAt)
+
+	return register, nil
+}
+
 func fromJSONTree(
 	pbTree *api.JSONElement_Tree,
 ) (*crdt.Tree, error) {
@@ -376,6 +538,10 @@ func fromJSONTree(
 	if err != nil {
 		return nil, err
 	}
+	moves, err := fromTreeMoveRecords(pbTree.Moves)
+	if err != nil {
+		return nil, err
+	}
 
 	tree := crdt.NewTree(
 		root,
@@ -383,6 +549,70 @@ func fromJSONTree(
 	)
 	tree.SetMovedAt(movedAt)
 	tree.SetRemovedAt(removedAt)
+	tree.SetSchema(fromTreeSchema(pbTree.Schema))
+	tree.SetMoves(moves)
 
 	return tree, nil
 }
+
+func fromTreeMoveRecords(pbMoves []*api.TreeMoveRecord) ([]*crdt.TreeMoveRecord, error) {
+	var moves []*crdt.TreeMoveRecord
+	for _, pbMove := range pbMoves {
+		target, err := fromTreeNodeID(pbMove.Target)
+		if err != nil {
+			return nil, err
+		}
+		to, err := fromTreePos(pbMove.To)
+		if err != nil {
+			return nil, err
+		}
+		movedAt, err := fromTimeTicket(pbMove.MovedAt)
+		if err != nil {
+			return nil, err
+		}
+		prevMovedAt, err := fromTimeTicket(pbMove.PrevMovedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		move := &crdt.TreeMoveRecord{
+			Target:      target,
+			To:          to,
+			MovedAt:     movedAt,
+			Applied:     pbMove.Applied,
+			PrevMovedAt: prevMovedAt,
+		}
+		if pbMove.PrevTo != nil {
+			if move.PrevTo, err = fromTreePos(pbMove.PrevTo); err != nil {
+				return nil, err
+			}
+		}
+		moves = append(moves, move)
+	}
+	return moves, nil
+}
+
+func fromTreeSchema(pbSchema *api.TreeSchema) *crdt.TreeSchema {
+	if pbSchema == nil {
+		return nil
+	}
+
+	nodes := make(map[string]crdt.TreeNodeSpec, len(pbSchema.Nodes))
+	for nodeType, pbSpec := range pbSchema.Nodes {
+		spec := crdt.TreeNodeSpec{
+			Content:            pbSpec.Content,
+			Mark:               pbSpec.Mark,
+			TextOnly:           pbSpec.TextOnly,
+			RequiredAttributes: pbSpec.RequiredAttributes,
+		}
+		if !pbSpec.AnyAttributes {
+			spec.Attributes = append([]string{}, pbSpec.Attributes...)
+		}
+		nodes[nodeType] = spec
+	}
+
+	return &crdt.TreeSchema{
+		Root:  pbSchema.Root,
+		Nodes: nodes,
+	}
+}
@@ -25,10 +25,12 @@ import (
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/diff"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/resource"
 )
 
 var (
@@ -67,7 +69,10 @@ func FromProject(pbProject *api.Project) *types.Project {
 		ClientDeactivateThreshold: pbProject.ClientDeactivateThreshold,
 		MaxSubscribersPerDocument: int(pbProject.MaxSubscribersPerDocument),
 		MaxAttachmentsPerDocument: int(pbProject.MaxAttachmentsPerDocument),
+		MaxDocumentSize:           int(pbProject.MaxDocumentSize),
+		MaxChangeSize:             int(pbProject.MaxChangeSize),
 		AllowedOrigins:            pbProject.AllowedOrigins,
+		Schema:                    pbProject.Schema,
 		PublicKey:                 pbProject.PublicKey,
 		SecretKey:                 pbProject.SecretKey,
 		CreatedAt:                 pbProject.CreatedAt.AsTime(),
@@ -84,6 +89,25 @@ func FromDocumentSummaries(pbSummaries []*api.DocumentSummary) []*types.Document
 	return summaries
 }
 
+// FromDocumentDiffs converts the given Protobuf formats to model format.
+func FromDocumentDiffs(pbDiffs []*api.DocumentDiff) []*diff.Diff {
+	var diffs []*diff.Diff
+	for _, pbDiff := range pbDiffs {
+		diffs = append(diffs, &diff.Diff{
+			Type:               diff.Type(pbDiff.Type),
+			Path:               pbDiff.Path,
+			From:               int(pbDiff.From),
+			To:                 int(pbDiff.To),
+			Old:                pbDiff.OldValue,
+			New:                pbDiff.NewValue,
+			Attributes:         pbDiff.Attributes,
+			AttributesToRemove: pbDiff.AttributesToRemove,
+			Delta:              pbDiff.Delta,
+		})
+	}
+	return diffs
+}
+
 // FromDocumentSummary converts the given Protobuf formats to model format.
 func FromDocumentSummary(pbSummary *api.DocumentSummary) *types.DocumentSummary {
 	return &types.DocumentSummary{
@@ -97,6 +121,49 @@ func FromDocumentSummary(pbSummary *api.DocumentSummary) *types.DocumentSummary
 	}
 }
 
+// FromDocumentGCStatus converts the given Protobuf formats to model format.
+func FromDocumentGCStatus(pbStatus *api.DocumentGCStatus) (*types.DocumentGCStatus, error) {
+	minVersionVector, err := FromVersionVector(pbStatus.MinVersionVector)
+	if err != nil {
+		return nil, err
+	}
+
+	var blockers []types.GCBlocker
+	for _, pbBlocker := range pbStatus.Blockers {
+		versionVector, err := FromVersionVector(pbBlocker.VersionVector)
+		if err != nil {
+			return nil, err
+		}
+
+		blockers = append(blockers, types.GCBlocker{
+			ClientID:      types.ID(pbBlocker.ClientId),
+			VersionVector: versionVector,
+			UpdatedAt:     pbBlocker.UpdatedAt.AsTime(),
+		})
+	}
+
+	return &types.DocumentGCStatus{
+		Key:              key.Key(pbStatus.Key),
+		GarbageLen:       int(pbStatus.GarbageLen),
+		DocSize:          fromDocSize(pbStatus.DocumentSize),
+		MinVersionVector: minVersionVector,
+		AttachedClients:  int(pbStatus.AttachedClients),
+		Blockers:         blockers,
+	}, nil
+}
+
+// fromDocSize converts the given Protobuf formats to model format.
+func fromDocSize(pbDocSize *api.DocSize) resource.DocSize {
+	if pbDocSize == nil {
+		return resource.DocSize{}
+	}
+
+	return resource.DocSize{
+		Live: resource.DataSize{Data: int(pbDocSize.Live.GetData()), Meta: int(pbDocSize.Live.GetMeta())},
+		GC:   resource.DataSize{Data: int(pbDocSize.Gc.GetData()), Meta: int(pbDocSize.Gc.GetMeta())},
+	}
+}
+
 // FromChangePack converts the given Protobuf formats to model format.
 func FromChangePack(pbPack *api.ChangePack) (*change.Pack, error) {
 	if pbPack == nil {
@@ -249,8 +316,20 @@ func FromOperations(pbOps []*api.Operation) ([]operations.Operation, error) {
 			op, err = fromTreeEdit(decoded.TreeEdit)
 		case *api.Operation_TreeStyle_:
 			op, err = fromTreeStyle(decoded.TreeStyle)
+		case *api.Operation_TreeMove_:
+			op, err = fromTreeMove(decoded.TreeMove)
 		case *api.Operation_ArraySet_:
 			op, err = fromArraySet(decoded.ArraySet)
+		case *api.Operation_SetAdd_:
+			op, err = fromSetAdd(decoded.SetAdd)
+		case *api.Operation_SetRemove_:
+			op, err = fromSetRemove(decoded.SetRemove)
+		case *api.Operation_RegisterSet_:
+			op, err = fromRegisterSet(decoded.RegisterSet)
+		case *api.Operation_CounterReset_:
+			op, err = fromCounterReset(decoded.CounterReset)
+		case *api.Operation_CounterTransfer_:
+			op, err = fromCounterTransfer(decoded.CounterTransfer)
 		default:
 			return nil, ErrUnsupportedOperation
 		}
@@ -300,6 +379,14 @@ func FromPresenceChange(pbPresenceChange *api.PresenceChange) *innerpresence.Cha
 		if p.Presence == nil {
 			p.Presence = innerpresence.New()
 		}
+	case api.PresenceChange_CHANGE_TYPE_PATCH:
+		p = innerpresence.Change{
+			ChangeType: innerpresence.Patch,
+			Presence:   pbPresenceChange.Presence.Data,
+		}
+		if p.Presence == nil {
+			p.Presence = innerpresence.New()
+		}
 	case api.PresenceChange_CHANGE_TYPE_CLEAR:
 		p = innerpresence.Change{
 			ChangeType: innerpresence.Clear,
@@ -446,6 +533,31 @@ func fromStyle(pbStyle *api.Operation_Style) (*operations.Style, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	if pbStyle.Mark != nil {
+		mark, err := fromTextMark(pbStyle.Mark)
+		if err != nil {
+			return nil, err
+		}
+		return operations.NewStyleMark(
+			parentCreatedAt,
+			from,
+			to,
+			mark,
+			executedAt,
+		), nil
+	}
+
+	if len(pbStyle.AttributesToRemove) > 0 {
+		return operations.NewStyleRemove(
+			parentCreatedAt,
+			from,
+			to,
+			pbStyle.AttributesToRemove,
+			executedAt,
+		), nil
+	}
+
 	return operations.NewStyle(
 		parentCreatedAt,
 		from,
@@ -551,6 +663,125 @@ func fromTreeStyle(pbTreeStyle *api.Operation_TreeStyle) (*operations.TreeStyle,
 	), nil
 }
 
+func fromSetAdd(pbSetAdd *api.Operation_SetAdd) (*operations.SetAdd, error) {
+	parentCreatedAt, err := fromTimeTicket(pbSetAdd.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	elem, err := fromElement(pbSetAdd.Value)
+	if err != nil {
+		return nil, err
+	}
+	executedAt, err := fromTimeTicket(pbSetAdd.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return operations.NewSetAdd(
+		parentCreatedAt,
+		elem,
+		executedAt,
+	), nil
+}
+
+func fromSetRemove(pbSetRemove *api.Operation_SetRemove) (*operations.SetRemove, error) {
+	parentCreatedAt, err := fromTimeTicket(pbSetRemove.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	var createdAts []*time.Ticket
+	for _, pbCreatedAt := range pbSetRemove.CreatedAts {
+		createdAt, err := fromTimeTicket(pbCreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		createdAts = append(createdAts, createdAt)
+	}
+	executedAt, err := fromTimeTicket(pbSetRemove.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return operations.NewSetRemove(
+		parentCreatedAt,
+		createdAts,
+		executedAt,
+	), nil
+}
+
+func fromRegisterSet(pbRegisterSet *api.Operation_RegisterSet) (*operations.RegisterSet, error) {
+	parentCreatedAt, err := fromTimeTicket(pbRegisterSet.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	elem, err := fromElement(pbRegisterSet.Value)
+	if err != nil {
+		return nil, err
+	}
+	var overwrites []*time.Ticket
+	for _, pbCreatedAt := range pbRegisterSet.Overwrites {
+		createdAt, err := fromTimeTicket(pbCreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		overwrites = append(overwrites, createdAt)
+	}
+	executedAt, err := fromTimeTicket(pbRegisterSet.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return operations.NewRegisterSet(
+		parentCreatedAt,
+		elem,
+		overwrites,
+		executedAt,
+	), nil
+}
+
+func fromCounterReset(pbCounterReset *api.Operation_CounterReset) (*operations.CounterReset, error) {
+	parentCreatedAt, err := fromTimeTicket(pbCounterReset.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	observed, err := fromCounterEntries(pbCounterReset.Observed)
+	if err != nil {
+		return nil, err
+	}
+	executedAt, err := fromTimeTicket(pbCounterReset.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return operations.NewCounterReset(
+		parentCreatedAt,
+		observed,
+		executedAt,
+	), nil
+}
+
+func fromCounterTransfer(pbCounterTransfer *api.Operation_CounterTransfer) (*operations.CounterTransfer, error) {
+	parentCreatedAt, err := fromTimeTicket(pbCounterTransfer.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	from, err := time.ActorIDFromBytes(pbCounterTransfer.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := time.ActorIDFromBytes(pbCounterTransfer.To)
+	if err != nil {
+		return nil, err
+	}
+	executedAt, err := fromTimeTicket(pbCounterTransfer.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return operations.NewCounterTransfer(
+		parentCreatedAt,
+		from,
+		to,
+		pbCounterTransfer.Amount,
+		executedAt,
+	), nil
+}
+
 func fromArraySet(pbSetByIndex *api.Operation_ArraySet) (*operations.ArraySet, error) {
 	parentCreatedAt, err := fromTimeTicket(pbSetByIndex.ParentCreatedAt)
 	if err != nil {
@@ -695,9 +926,44 @@ func fromTreeNode(pbNode *api.TreeNode) (*crdt.TreeNode, error) {
 	}
 	node.SetRemovedAt(removedAt)
 
+	movedAt, err := fromTimeTicket(pbNode.MovedAt)
+	if err != nil {
+		return nil, err
+	}
+	node.SetMovedAt(movedAt)
+
 	return node, nil
 }
 
+func fromTreeMove(pbTreeMove *api.Operation_TreeMove) (*operations.TreeMove, error) {
+	parentCreatedAt, err := fromTimeTicket(pbTreeMove.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	executedAt, err := fromTimeTicket(pbTreeMove.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := fromTreeNodeID(pbTreeMove.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := fromTreePos(pbTreeMove.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return operations.NewTreeMove(
+		parentCreatedAt,
+		target,
+		to,
+		executedAt,
+	), nil
+}
+
 func fromTreePos(pbPos *api.TreePos) (*crdt.TreePos, error) {
 	parentID, err := fromTreeNodeID(pbPos.ParentId)
 	if err != nil {
@@ -832,6 +1098,28 @@ func fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
 		return counter, nil
 	case api.ValueType_VALUE_TYPE_TREE:
 		return BytesToTree(pbElement.Value)
+	case api.ValueType_VALUE_TYPE_JSON_SET, api.ValueType_VALUE_TYPE_MV_REGISTER:
+		return fromEmptyElement(pbElement)
+	case api.ValueType_VALUE_TYPE_COUNTER_SNAPSHOT:
+		return BytesToCounter(pbElement.Value)
+	}
+
+	return nil, fmt.Errorf("%d, %w", pbElement.Type, ErrUnsupportedElement)
+}
+
+// fromEmptyElement converts the given element that is encoded with only the
+// creation time. Its values are added by the following operations.
+func fromEmptyElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
+	createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pbElement.Type {
+	case api.ValueType_VALUE_TYPE_JSON_SET:
+		return crdt.NewSet(createdAt), nil
+	case api.ValueType_VALUE_TYPE_MV_REGISTER:
+		return crdt.NewMVRegister(createdAt), nil
 	}
 
 	return nil, fmt.Errorf("%d, %w", pbElement.Type, ErrUnsupportedElement)
@@ -900,9 +1188,20 @@ func FromUpdatableProjectFields(pbProjectFields *api.UpdatableProjectFields) (*t
 		value := int(pbProjectFields.MaxAttachmentsPerDocument.Value)
 		updatableProjectFields.MaxAttachmentsPerDocument = &value
 	}
+	if pbProjectFields.MaxDocumentSize != nil {
+		value := int(pbProjectFields.MaxDocumentSize.Value)
+		updatableProjectFields.MaxDocumentSize = &value
+	}
+	if pbProjectFields.MaxChangeSize != nil {
+		value := int(pbProjectFields.MaxChangeSize.Value)
+		updatableProjectFields.MaxChangeSize = &value
+	}
 	if pbProjectFields.AllowedOrigins != nil {
 		updatableProjectFields.AllowedOrigins = &pbProjectFields.AllowedOrigins.Origins
 	}
+	if pbProjectFields.Schema != nil {
+		updatableProjectFields.Schema = &pbProjectFields.Schema.Value
+	}
 
 	return updatableProjectFields, nil
 }
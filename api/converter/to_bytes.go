@@ -85,6 +85,19 @@ func TreeToBytes(tree *crdt.Tree) ([]byte, error) {
 	return bytes, nil
 }
 
+// CounterToBytes converts the given counter to bytes.
+func CounterToBytes(counter *crdt.Counter) ([]byte, error) {
+	pbCounter, err := toCounter(counter)
+	if err != nil {
+		return nil, err
+	}
+	bytes, err := proto.Marshal(pbCounter)
+	if err != nil {
+		return nil, fmt.Errorf("marshal Counter to bytes: %w", err)
+	}
+	return bytes, nil
+}
+
 func toJSONElement(elem crdt.Element) (*api.JSONElement, error) {
 	switch elem := elem.(type) {
 	case *crdt.Object:
@@ -99,6 +112,10 @@ func toJSONElement(elem crdt.Element) (*api.JSONElement, error) {
 		return toCounter(elem)
 	case *crdt.Tree:
 		return toTree(elem), nil
+	case *crdt.Set:
+		return toJSONSet(elem)
+	case *crdt.MVRegister:
+		return toMVRegister(elem)
 	default:
 		return nil, fmt.Errorf("%v: %w", reflect.TypeOf(elem), ErrUnsupportedElement)
 	}
@@ -161,6 +178,7 @@ func toText(text *crdt.Text) *api.JSONElement {
 			CreatedAt: ToTimeTicket(text.CreatedAt()),
 			MovedAt:   ToTimeTicket(text.MovedAt()),
 			RemovedAt: ToTimeTicket(text.RemovedAt()),
+			Marks:     toTextMarks(text.Marks()),
 		}},
 	}
 }
@@ -175,17 +193,43 @@ func toCounter(counter *crdt.Counter) (*api.JSONElement, error) {
 		return nil, err
 	}
 
+	var pbTransfers []*api.CounterTransfer
+	for _, transfer := range counter.Transfers() {
+		pbTransfers = append(pbTransfers, &api.CounterTransfer{
+			From:   transfer.From().Bytes(),
+			To:     transfer.To().Bytes(),
+			Amount: transfer.Amount(),
+		})
+	}
+
 	return &api.JSONElement{
 		Body: &api.JSONElement_Counter_{Counter: &api.JSONElement_Counter{
-			Type:      pbCounterType,
-			Value:     counterValue,
-			CreatedAt: ToTimeTicket(counter.CreatedAt()),
-			MovedAt:   ToTimeTicket(counter.MovedAt()),
-			RemovedAt: ToTimeTicket(counter.RemovedAt()),
+			Type:       pbCounterType,
+			Value:      counterValue,
+			CreatedAt:  ToTimeTicket(counter.CreatedAt()),
+			MovedAt:    ToTimeTicket(counter.MovedAt()),
+			RemovedAt:  ToTimeTicket(counter.RemovedAt()),
+			Increases:  toCounterEntries(counter.Increases()),
+			Resets:     toCounterEntries(counter.Resets()),
+			IsReset:    counter.IsReset(),
+			Bounded:    counter.IsBounded(),
+			LowerBound: counter.LowerBound(),
+			Transfers:  pbTransfers,
 		}},
 	}, nil
 }
 
+func toCounterEntries(entries []*crdt.CounterEntry) []*api.CounterEntry {
+	var pbEntries []*api.CounterEntry
+	for _, entry := range entries {
+		pbEntries = append(pbEntries, &api.CounterEntry{
+			UpdatedAt: ToTimeTicket(entry.UpdatedAt()),
+			Sum:       entry.Sum(),
+		})
+	}
+	return pbEntries
+}
+
 func toTree(tree *crdt.Tree) *api.JSONElement {
 	return &api.JSONElement{
 		Body: &api.JSONElement_Tree_{Tree: &api.JSONElement_Tree{
@@ -193,10 +237,93 @@ func toTree(tree *crdt.Tree) *api.JSONElement {
 			CreatedAt: ToTimeTicket(tree.CreatedAt()),
 			MovedAt:   ToTimeTicket(tree.MovedAt()),
 			RemovedAt: ToTimeTicket(tree.RemovedAt()),
+			Schema:    toTreeSchema(tree.Schema()),
+			Moves:     toTreeMoveRecords(tree.Moves()),
 		}},
 	}
 }
 
+func toTreeMoveRecords(moves []*crdt.TreeMoveRecord) []*api.TreeMoveRecord {
+	var pbMoves []*api.TreeMoveRecord
+	for _, move := range moves {
+		pbMove := &api.TreeMoveRecord{
+			Target:      toTreeNodeID(move.Target),
+			To:          toTreePos(move.To),
+			MovedAt:     ToTimeTicket(move.MovedAt),
+			Applied:     move.Applied,
+			PrevMovedAt: ToTimeTicket(move.PrevMovedAt),
+		}
+		if move.PrevTo != nil {
+			pbMove.PrevTo = toTreePos(move.PrevTo)
+		}
+		pbMoves = append(pbMoves, pbMove)
+	}
+	return pbMoves
+}
+
+func toTreeSchema(schema *crdt.TreeSchema) *api.TreeSchema {
+	if schema == nil {
+		return nil
+	}
+
+	pbNodes := make(map[string]*api.TreeSchema_NodeSpec, len(schema.Nodes))
+	for nodeType, spec := range schema.Nodes {
+		pbNodes[nodeType] = &api.TreeSchema_NodeSpec{
+			Content:            spec.Content,
+			Mark:               spec.Mark,
+			TextOnly:           spec.TextOnly,
+			RequiredAttributes: spec.RequiredAttributes,
+			Attributes:         spec.Attributes,
+			AnyAttributes:      spec.Attributes == nil,
+		}
+	}
+
+	return &api.TreeSchema{
+		Root:  schema.Root,
+		Nodes: pbNodes,
+	}
+}
+
+func toJSONSet(set *crdt.Set) (*api.JSONElement, error) {
+	var pbNodes []*api.JSONElement_Primitive
+	for _, node := range set.Nodes() {
+		pbElem, err := toPrimitive(node.Value())
+		if err != nil {
+			return nil, err
+		}
+		pbNodes = append(pbNodes, pbElem.GetPrimitive())
+	}
+
+	return &api.JSONElement{
+		Body: &api.JSONElement_JsonSet{JsonSet: &api.JSONElement_JSONSet{
+			Nodes:     pbNodes,
+			CreatedAt: ToTimeTicket(set.CreatedAt()),
+			MovedAt:   ToTimeTicket(set.MovedAt()),
+			RemovedAt: ToTimeTicket(set.RemovedAt()),
+		}},
+	}, nil
+}
+
+func toMVRegister(register *crdt.MVRegister) (*api.JSONElement, error) {
+	var pbNodes []*api.JSONElement_Primitive
+	for _, node := range register.Nodes() {
+		pbElem, err := toPrimitive(node.Value())
+		if err != nil {
+			return nil, err
+		}
+		pbNodes = append(pbNodes, pbElem.GetPrimitive())
+	}
+
+	return &api.JSONElement{
+		Body: &api.JSONElement_MvRegister{MvRegister: &api.JSONElement_MVRegister{
+			Nodes:     pbNodes,
+			CreatedAt: ToTimeTicket(register.CreatedAt()),
+			MovedAt:   ToTimeTicket(register.MovedAt()),
+			RemovedAt: ToTimeTicket(register.RemovedAt()),
+		}},
+	}, nil
+}
+
 func toRHTNodes(rhtNodes []*crdt.ElementRHTNode) ([]*api.RHTNode, error) {
 	var pbRHTNodes []*api.RHTNode
 	for _, rhtNode := range rhtNodes {
@@ -264,6 +391,42 @@ func toTextNodeID(id *crdt.RGATreeSplitNodeID) *api.TextNodeID {
 	}
 }
 
+func toTextMarks(marks []*crdt.TextMark) []*api.TextMark {
+	var pbMarks []*api.TextMark
+	for _, mark := range marks {
+		pbMarks = append(pbMarks, toTextMark(mark))
+	}
+	return pbMarks
+}
+
+func toTextMark(mark *crdt.TextMark) *api.TextMark {
+	if mark == nil {
+		return nil
+	}
+
+	return &api.TextMark{
+		Id:        ToTimeTicket(mark.ID()),
+		Key:       mark.Key(),
+		Value:     mark.Value(),
+		Expand:    api.MarkExpand(mark.Expand()),
+		Mergeable: mark.Mergeable(),
+		Removed:   mark.Removed(),
+		Start:     toTextMarkAnchor(mark.Start()),
+		End:       toTextMarkAnchor(mark.End()),
+	}
+}
+
+func toTextMarkAnchor(anchor *crdt.TextMarkAnchor) *api.TextMarkAnchor {
+	if anchor == nil {
+		return nil
+	}
+
+	return &api.TextMarkAnchor{
+		Id:    toTextNodeID(anchor.ID()),
+		After: anchor.After(),
+	}
+}
+
 // ToTreeNodes converts a TreeNode to a slice of TreeNodes in post-order traversal.
 func ToTreeNodes(treeNode *crdt.TreeNode) []*api.TreeNode {
 	var pbTreeNodes []*api.TreeNode
@@ -321,6 +484,7 @@ func toTreeNode(treeNode *crdt.TreeNode, depth int) *api.TreeNode {
 		RemovedAt:  ToTimeTicket(treeNode.RemovedAt()),
 		Depth:      int32(depth),
 		Attributes: toRHT(treeNode.Attrs),
+		MovedAt:    ToTimeTicket(treeNode.MovedAt()),
 	}
 
 	if treeNode.InsPrevID != nil {
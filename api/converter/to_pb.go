@@ -18,6 +18,7 @@ package converter
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -28,6 +29,7 @@ import (
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/diff"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
@@ -66,7 +68,10 @@ func ToProject(project *types.Project) *api.Project {
 		ClientDeactivateThreshold: project.ClientDeactivateThreshold,
 		MaxSubscribersPerDocument: int32(project.MaxSubscribersPerDocument),
 		MaxAttachmentsPerDocument: int32(project.MaxAttachmentsPerDocument),
+		MaxDocumentSize:           ClampToInt32(project.MaxDocumentSize),
+		MaxChangeSize:             ClampToInt32(project.MaxChangeSize),
 		AllowedOrigins:            project.AllowedOrigins,
+		Schema:                    project.Schema,
 		PublicKey:                 project.PublicKey,
 		SecretKey:                 project.SecretKey,
 		CreatedAt:                 timestamppb.New(project.CreatedAt),
@@ -95,6 +100,25 @@ func ToDocumentSummaries(summaries []*types.DocumentSummary) []*api.DocumentSumm
 	return pbSummaries
 }
 
+// ToDocumentDiffs converts the given model to Protobuf format.
+func ToDocumentDiffs(diffs []*diff.Diff) []*api.DocumentDiff {
+	var pbDiffs []*api.DocumentDiff
+	for _, d := range diffs {
+		pbDiffs = append(pbDiffs, &api.DocumentDiff{
+			Type:               string(d.Type),
+			Path:               d.Path,
+			From:               int32(d.From),
+			To:                 int32(d.To),
+			OldValue:           d.Old,
+			NewValue:           d.New,
+			Attributes:         d.Attributes,
+			AttributesToRemove: d.AttributesToRemove,
+			Delta:              d.Delta,
+		})
+	}
+	return pbDiffs
+}
+
 // ToDocumentSummary converts the given model to Protobuf format.
 func ToDocumentSummary(summary *types.DocumentSummary) *api.DocumentSummary {
 	return &api.DocumentSummary{
@@ -109,6 +133,37 @@ func ToDocumentSummary(summary *types.DocumentSummary) *api.DocumentSummary {
 	}
 }
 
+// ToDocumentGCStatus converts the given model to Protobuf format.
+func ToDocumentGCStatus(status *types.DocumentGCStatus) (*api.DocumentGCStatus, error) {
+	minVersionVector, err := ToVersionVector(status.MinVersionVector)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbBlockers []*api.DocumentGCStatus_Blocker
+	for _, blocker := range status.Blockers {
+		pbVersionVector, err := ToVersionVector(blocker.VersionVector)
+		if err != nil {
+			return nil, err
+		}
+
+		pbBlockers = append(pbBlockers, &api.DocumentGCStatus_Blocker{
+			ClientId:      blocker.ClientID.String(),
+			VersionVector: pbVersionVector,
+			UpdatedAt:     timestamppb.New(blocker.UpdatedAt),
+		})
+	}
+
+	return &api.DocumentGCStatus{
+		Key:              status.Key.String(),
+		GarbageLen:       int32(status.GarbageLen),
+		DocumentSize:     ToDocSize(status.DocSize),
+		MinVersionVector: minVersionVector,
+		AttachedClients:  int32(status.AttachedClients),
+		Blockers:         pbBlockers,
+	}, nil
+}
+
 // ToPresences converts the given model to Protobuf format.
 func ToPresences(presences map[string]innerpresence.Presence) map[string]*api.Presence {
 	pbPresences := make(map[string]*api.Presence)
@@ -141,6 +196,11 @@ func ToPresenceChange(p *innerpresence.Change) *api.PresenceChange {
 			Type:     api.PresenceChange_CHANGE_TYPE_PUT,
 			Presence: &api.Presence{Data: p.Presence},
 		}
+	case innerpresence.Patch:
+		return &api.PresenceChange{
+			Type:     api.PresenceChange_CHANGE_TYPE_PATCH,
+			Presence: &api.Presence{Data: p.Presence},
+		}
 	case innerpresence.Clear:
 		return &api.PresenceChange{
 			Type: api.PresenceChange_CHANGE_TYPE_CLEAR,
@@ -269,8 +329,20 @@ func ToOperations(ops []operations.Operation) ([]*api.Operation, error) {
 			pbOperation.Body, err = toTreeEdit(op)
 		case *operations.TreeStyle:
 			pbOperation.Body, err = toTreeStyle(op)
+		case *operations.TreeMove:
+			pbOperation.Body, err = toTreeMove(op)
 		case *operations.ArraySet:
 			pbOperation.Body, err = toArraySet(op)
+		case *operations.SetAdd:
+			pbOperation.Body, err = toSetAdd(op)
+		case *operations.SetRemove:
+			pbOperation.Body = toSetRemove(op)
+		case *operations.RegisterSet:
+			pbOperation.Body, err = toRegisterSet(op)
+		case *operations.CounterReset:
+			pbOperation.Body = toCounterReset(op)
+		case *operations.CounterTransfer:
+			pbOperation.Body = toCounterTransfer(op)
 		default:
 			return nil, ErrUnsupportedOperation
 		}
@@ -391,11 +463,13 @@ func toEdit(e *operations.Edit) (*api.Operation_Edit_, error) {
 func toStyle(style *operations.Style) (*api.Operation_Style_, error) {
 	return &api.Operation_Style_{
 		Style: &api.Operation_Style{
-			ParentCreatedAt: ToTimeTicket(style.ParentCreatedAt()),
-			From:            toTextNodePos(style.From()),
-			To:              toTextNodePos(style.To()),
-			Attributes:      style.Attributes(),
-			ExecutedAt:      ToTimeTicket(style.ExecutedAt()),
+			ParentCreatedAt:    ToTimeTicket(style.ParentCreatedAt()),
+			From:               toTextNodePos(style.From()),
+			To:                 toTextNodePos(style.To()),
+			Attributes:         style.Attributes(),
+			AttributesToRemove: style.AttributesToRemove(),
+			Mark:               toTextMark(style.Mark()),
+			ExecutedAt:         ToTimeTicket(style.ExecutedAt()),
 		},
 	}, nil
 }
@@ -441,6 +515,17 @@ func toTreeStyle(style *operations.TreeStyle) (*api.Operation_TreeStyle_, error)
 	}, nil
 }
 
+func toTreeMove(move *operations.TreeMove) (*api.Operation_TreeMove_, error) {
+	return &api.Operation_TreeMove_{
+		TreeMove: &api.Operation_TreeMove{
+			ParentCreatedAt: ToTimeTicket(move.ParentCreatedAt()),
+			Target:          toTreeNodeID(move.Target()),
+			To:              toTreePos(move.ToPos()),
+			ExecutedAt:      ToTimeTicket(move.ExecutedAt()),
+		},
+	}, nil
+}
+
 func toArraySet(setByIndex *operations.ArraySet) (*api.Operation_ArraySet_, error) {
 	pbElem, err := toJSONElementSimple(setByIndex.Value())
 	if err != nil {
@@ -457,6 +542,79 @@ func toArraySet(setByIndex *operations.ArraySet) (*api.Operation_ArraySet_, erro
 	}, nil
 }
 
+func toSetAdd(setAdd *operations.SetAdd) (*api.Operation_SetAdd_, error) {
+	pbElem, err := toJSONElementSimple(setAdd.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Operation_SetAdd_{
+		SetAdd: &api.Operation_SetAdd{
+			ParentCreatedAt: ToTimeTicket(setAdd.ParentCreatedAt()),
+			Value:           pbElem,
+			ExecutedAt:      ToTimeTicket(setAdd.ExecutedAt()),
+		},
+	}, nil
+}
+
+func toSetRemove(setRemove *operations.SetRemove) *api.Operation_SetRemove_ {
+	var pbCreatedAts []*api.TimeTicket
+	for _, createdAt := range setRemove.CreatedAts() {
+		pbCreatedAts = append(pbCreatedAts, ToTimeTicket(createdAt))
+	}
+
+	return &api.Operation_SetRemove_{
+		SetRemove: &api.Operation_SetRemove{
+			ParentCreatedAt: ToTimeTicket(setRemove.ParentCreatedAt()),
+			CreatedAts:      pbCreatedAts,
+			ExecutedAt:      ToTimeTicket(setRemove.ExecutedAt()),
+		},
+	}
+}
+
+func toRegisterSet(registerSet *operations.RegisterSet) (*api.Operation_RegisterSet_, error) {
+	pbElem, err := toJSONElementSimple(registerSet.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	var pbOverwrites []*api.TimeTicket
+	for _, createdAt := range registerSet.Overwrites() {
+		pbOverwrites = append(pbOverwrites, ToTimeTicket(createdAt))
+	}
+
+	return &api.Operation_RegisterSet_{
+		RegisterSet: &api.Operation_RegisterSet{
+			ParentCreatedAt: ToTimeTicket(registerSet.ParentCreatedAt()),
+			Value:           pbElem,
+			Overwrites:      pbOverwrites,
+			ExecutedAt:      ToTimeTicket(registerSet.ExecutedAt()),
+		},
+	}, nil
+}
+
+func toCounterReset(counterReset *operations.CounterReset) *api.Operation_CounterReset_ {
+	return &api.Operation_CounterReset_{
+		CounterReset: &api.Operation_CounterReset{
+			ParentCreatedAt: ToTimeTicket(counterReset.ParentCreatedAt()),
+			Observed:        toCounterEntries(counterReset.Observed()),
+			ExecutedAt:      ToTimeTicket(counterReset.ExecutedAt()),
+		},
+	}
+}
+
+func toCounterTransfer(counterTransfer *operations.CounterTransfer) *api.Operation_CounterTransfer_ {
+	return &api.Operation_CounterTransfer_{
+		CounterTransfer: &api.Operation_CounterTransfer{
+			ParentCreatedAt: ToTimeTicket(counterTransfer.ParentCreatedAt()),
+			From:            counterTransfer.From().Bytes(),
+			To:              counterTransfer.To().Bytes(),
+			Amount:          counterTransfer.Amount(),
+			ExecutedAt:      ToTimeTicket(counterTransfer.ExecutedAt()),
+		},
+	}
+}
+
 func toJSONElementSimple(elem crdt.Element) (*api.JSONElementSimple, error) {
 	switch elem := elem.(type) {
 	case *crdt.Object:
@@ -495,6 +653,21 @@ func toJSONElementSimple(elem crdt.Element) (*api.JSONElementSimple, error) {
 			CreatedAt: ToTimeTicket(elem.CreatedAt()),
 		}, nil
 	case *crdt.Counter:
+		// NOTE: The counter that has more than the value is encoded as the
+		// snapshot, so that the increases of each actor and the lower bound
+		// are the same in every replica.
+		if !elem.IsPlain() {
+			bytes, err := CounterToBytes(elem)
+			if err != nil {
+				return nil, err
+			}
+			return &api.JSONElementSimple{
+				Type:      api.ValueType_VALUE_TYPE_COUNTER_SNAPSHOT,
+				CreatedAt: ToTimeTicket(elem.CreatedAt()),
+				Value:     bytes,
+			}, nil
+		}
+
 		pbCounterType, err := toCounterType(elem.ValueType())
 		if err != nil {
 			return nil, err
@@ -519,6 +692,16 @@ func toJSONElementSimple(elem crdt.Element) (*api.JSONElementSimple, error) {
 			CreatedAt: ToTimeTicket(elem.CreatedAt()),
 			Value:     bytes,
 		}, nil
+	case *crdt.Set:
+		return &api.JSONElementSimple{
+			Type:      api.ValueType_VALUE_TYPE_JSON_SET,
+			CreatedAt: ToTimeTicket(elem.CreatedAt()),
+		}, nil
+	case *crdt.MVRegister:
+		return &api.JSONElementSimple{
+			Type:      api.ValueType_VALUE_TYPE_MV_REGISTER,
+			CreatedAt: ToTimeTicket(elem.CreatedAt()),
+		}, nil
 	}
 
 	return nil, fmt.Errorf("%v, %w", reflect.TypeOf(elem), ErrUnsupportedElement)
@@ -607,5 +790,33 @@ func ToUpdatableProjectFields(fields *types.UpdatableProjectFields) (*api.Updata
 			Value: int32(*fields.MaxAttachmentsPerDocument),
 		}
 	}
+	if fields.MaxDocumentSize != nil {
+		pbUpdatableProjectFields.MaxDocumentSize = &wrapperspb.Int32Value{
+			Value: ClampToInt32(*fields.MaxDocumentSize),
+		}
+	}
+	if fields.MaxChangeSize != nil {
+		pbUpdatableProjectFields.MaxChangeSize = &wrapperspb.Int32Value{
+			Value: ClampToInt32(*fields.MaxChangeSize),
+		}
+	}
+	if fields.Schema != nil {
+		pbUpdatableProjectFields.Schema = &wrapperspb.StringValue{
+			Value: *fields.Schema,
+		}
+	}
 	return pbUpdatableProjectFields, nil
 }
+
+// ClampToInt32 converts the given value to int32. The value out of the range
+// of int32 is clamped instead of overflowing, e.g. a size limit larger than
+// the range is not turned into a negative value.
+func ClampToInt32(value int) int32 {
+	if value > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	if value < math.MinInt32 {
+		return math.MinInt32
+	}
+	return int32(value)
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/resource"
+)
+
+// DocumentGCStatus represents the status of the garbage collection of a
+// document in the server.
+type DocumentGCStatus struct {
+	// Key is the key of the document.
+	Key key.Key
+
+	// GarbageLen is the count of the removed elements that are not collected
+	// yet.
+	GarbageLen int
+
+	// DocSize is the size of the document. The size of the garbage is
+	// represented by DocSize.GC.
+	DocSize resource.DocSize
+
+	// MinVersionVector is the minimum version vector of the attached
+	// clients. Only the removed elements that all the clients have seen,
+	// that is, the ones removed before this vector, can be collected.
+	MinVersionVector time.VersionVector
+
+	// AttachedClients is the count of clients attached to the document.
+	AttachedClients int
+
+	// Blockers are the attached clients that hold back the minimum version
+	// vector.
+	Blockers []GCBlocker
+}
+
+// GCBlocker represents an attached client that holds back the garbage
+// collection of a document.
+type GCBlocker struct {
+	// ClientID is the ID of the client.
+	ClientID ID
+
+	// VersionVector is the version vector that the client has synced.
+	VersionVector time.VersionVector
+
+	// UpdatedAt is the last time the client was accessed.
+	UpdatedAt gotime.Time
+}
+
+// DocumentGCResult represents the result of the forced garbage collection of
+// a document.
+type DocumentGCResult struct {
+	// DetachedClients are the stale clients detached from the document.
+	DetachedClients []ID
+
+	// Compacted represents whether the document has been compacted.
+	Compacted bool
+
+	// Status is the status of the garbage collection after the collection.
+	Status *DocumentGCStatus
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// DocumentRevision represents a document at a specific server sequence.
+type DocumentRevision struct {
+	// Key is the key of the document.
+	Key key.Key
+
+	// ServerSeq is the server sequence of the revision.
+	ServerSeq int64
+
+	// Root is the JSON representation of the document at the revision.
+	Root string
+}
@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/schema"
 )
 
 var (
@@ -63,9 +65,21 @@ type Project struct {
 	// If it is 0, there is no limit.
 	MaxAttachmentsPerDocument int `bson:"max_attachments_per_document"`
 
+	// MaxDocumentSize is the maximum size of a document in bytes.
+	// If it is 0, there is no limit.
+	MaxDocumentSize int `bson:"max_document_size"`
+
+	// MaxChangeSize is the maximum size in bytes that a single change can add
+	// to a document. If it is 0, there is no limit.
+	MaxChangeSize int `bson:"max_change_size"`
+
 	// AllowedOrigins is the list of allowed origins.
 	AllowedOrigins []string `json:"allowed_origins"`
 
+	// Schema is the schema that the root of documents should conform to.
+	// If it is empty, the documents are not validated.
+	Schema string `json:"schema"`
+
 	// PublicKey is the API key of this project.
 	PublicKey string `json:"public_key"`
 
@@ -138,3 +152,18 @@ func (p *Project) IsAttachmentLimitExceeded(count int) error {
 
 	return nil
 }
+
+// HasSchema returns whether the documents of the project have a schema.
+func (p *Project) HasSchema() bool {
+	return len(p.Schema) > 0
+}
+
+// DocumentSchema returns the parsed schema of the documents. If the project
+// does not have a schema, it returns nil.
+func (p *Project) DocumentSchema() (*schema.Schema, error) {
+	if !p.HasSchema() {
+		return nil, nil
+	}
+
+	return schema.Parse(p.Schema)
+}
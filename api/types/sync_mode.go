@@ -26,4 +26,13 @@ const (
 
 	// SyncModePushOnly is the mode that pushes changes only.
 	SyncModePushOnly
+
+	// SyncModePullOnly is the mode that pulls changes only. The server rejects
+	// the changes pushed in this mode, so it is used to attach documents
+	// without the risk of writes.
+	SyncModePullOnly
+
+	// SyncModeRealtimeSyncOff is the mode that neither pushes nor pulls
+	// changes in the sync loop while the document stays attached.
+	SyncModeRealtimeSyncOff
 )
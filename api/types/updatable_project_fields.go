@@ -23,6 +23,7 @@ import (
 	"os"
 
 	"github.com/yorkie-team/yorkie/internal/validation"
+	"github.com/yorkie-team/yorkie/pkg/document/schema"
 )
 
 // ErrEmptyProjectFields is returned when all the fields are empty.
@@ -56,8 +57,20 @@ type UpdatableProjectFields struct {
 	// If it is 0, there is no limit.
 	MaxAttachmentsPerDocument *int `bson:"max_attachments_per_document,omitempty" validate:"omitempty,min=0"`
 
+	// MaxDocumentSize is the maximum size of a document in bytes.
+	// If it is 0, there is no limit.
+	MaxDocumentSize *int `bson:"max_document_size,omitempty" validate:"omitempty,min=0"`
+
+	// MaxChangeSize is the maximum size in bytes that a single change can add
+	// to a document. If it is 0, there is no limit.
+	MaxChangeSize *int `bson:"max_change_size,omitempty" validate:"omitempty,min=0"`
+
 	// AllowedOrigins is the list of origins that are allowed to access the project.
 	AllowedOrigins *[]string `bson:"allowed_origins,omitempty" validate:"omitempty,dive,valid_origin"`
+
+	// Schema is the schema that the root of documents should conform to.
+	// If it is empty, the documents are not validated.
+	Schema *string `bson:"schema,omitempty" validate:"omitempty,valid_schema"`
 }
 
 // Validate validates the UpdatableProjectFields.
@@ -69,7 +82,10 @@ func (i *UpdatableProjectFields) Validate() error {
 		i.EventWebhookURL == nil &&
 		i.EventWebhookEvents == nil &&
 		i.MaxSubscribersPerDocument == nil &&
-		i.MaxAttachmentsPerDocument == nil {
+		i.MaxAttachmentsPerDocument == nil &&
+		i.MaxDocumentSize == nil &&
+		i.MaxChangeSize == nil &&
+		i.Schema == nil {
 		return ErrEmptyProjectFields
 	}
 
@@ -140,4 +156,24 @@ func init() {
 		fmt.Fprintln(os.Stderr, "updatable project fields: ", err)
 		os.Exit(1)
 	}
+
+	if err := validation.RegisterValidation(
+		"valid_schema",
+		func(level validation.FieldLevel) bool {
+			data := level.Field().String()
+			if data == "" {
+				return true
+			}
+			_, err := schema.Parse(data)
+			return err == nil
+		},
+	); err != nil {
+		fmt.Fprintln(os.Stderr, "updatable project fields: ", err)
+		os.Exit(1)
+	}
+
+	if err := validation.RegisterTranslation("valid_schema", "given {0} must be a valid schema"); err != nil {
+		fmt.Fprintln(os.Stderr, "updatable project fields: ", err)
+		os.Exit(1)
+	}
 }
@@ -186,4 +186,45 @@ func TestUpdatableProjectFields(t *testing.T) {
 		}
 		assert.ErrorAs(t, fields.Validate(), &formErr)
 	})
+
+	t.Run("size limits test", func(t *testing.T) {
+		validMaxDocumentSize, validMaxChangeSize := 10*1024*1024, 0
+		fields := &types.UpdatableProjectFields{
+			MaxDocumentSize: &validMaxDocumentSize,
+			MaxChangeSize:   &validMaxChangeSize,
+		}
+		assert.NoError(t, fields.Validate())
+
+		invalidMaxDocumentSize := -1
+		fields = &types.UpdatableProjectFields{
+			MaxDocumentSize: &invalidMaxDocumentSize,
+		}
+		assert.ErrorAs(t, fields.Validate(), &formErr)
+
+		invalidMaxChangeSize := -1
+		fields = &types.UpdatableProjectFields{
+			MaxChangeSize: &invalidMaxChangeSize,
+		}
+		assert.ErrorAs(t, fields.Validate(), &formErr)
+	})
+
+	t.Run("schema test", func(t *testing.T) {
+		validSchema := `{"type": "object", "properties": {"content": {"type": "text"}}}`
+		fields := &types.UpdatableProjectFields{
+			Schema: &validSchema,
+		}
+		assert.NoError(t, fields.Validate())
+
+		validSchema = ""
+		fields = &types.UpdatableProjectFields{
+			Schema: &validSchema,
+		}
+		assert.NoError(t, fields.Validate())
+
+		invalidSchema := `{"type": "object", "properties": {"content": {"type": "unknown"}}}`
+		fields = &types.UpdatableProjectFields{
+			Schema: &invalidSchema,
+		}
+		assert.ErrorAs(t, fields.Validate(), &formErr)
+	})
 }
@@ -93,6 +93,21 @@ const (
 	// AdminServiceGetSnapshotMetaProcedure is the fully-qualified name of the AdminService's
 	// GetSnapshotMeta RPC.
 	AdminServiceGetSnapshotMetaProcedure = "/yorkie.v1.AdminService/GetSnapshotMeta"
+	// AdminServiceGetDocumentAtRevisionProcedure is the fully-qualified name of the AdminService's
+	// GetDocumentAtRevision RPC.
+	AdminServiceGetDocumentAtRevisionProcedure = "/yorkie.v1.AdminService/GetDocumentAtRevision"
+	// AdminServiceGetDocumentDiffProcedure is the fully-qualified name of the AdminService's
+	// GetDocumentDiff RPC.
+	AdminServiceGetDocumentDiffProcedure = "/yorkie.v1.AdminService/GetDocumentDiff"
+	// AdminServiceRestoreDocumentProcedure is the fully-qualified name of the AdminService's
+	// RestoreDocument RPC.
+	AdminServiceRestoreDocumentProcedure = "/yorkie.v1.AdminService/RestoreDocument"
+	// AdminServiceGetDocumentGCStatusProcedure is the fully-qualified name of the AdminService's
+	// GetDocumentGCStatus RPC.
+	AdminServiceGetDocumentGCStatusProcedure = "/yorkie.v1.AdminService/GetDocumentGCStatus"
+	// AdminServiceCollectDocumentGarbageProcedure is the fully-qualified name of the AdminService's
+	// CollectDocumentGarbage RPC.
+	AdminServiceCollectDocumentGarbageProcedure = "/yorkie.v1.AdminService/CollectDocumentGarbage"
 	// AdminServiceSearchDocumentsProcedure is the fully-qualified name of the AdminService's
 	// SearchDocuments RPC.
 	AdminServiceSearchDocumentsProcedure = "/yorkie.v1.AdminService/SearchDocuments"
@@ -122,6 +137,11 @@ type AdminServiceClient interface {
 	UpdateDocument(context.Context, *connect.Request[v1.UpdateDocumentRequest]) (*connect.Response[v1.UpdateDocumentResponse], error)
 	RemoveDocumentByAdmin(context.Context, *connect.Request[v1.RemoveDocumentByAdminRequest]) (*connect.Response[v1.RemoveDocumentByAdminResponse], error)
 	GetSnapshotMeta(context.Context, *connect.Request[v1.GetSnapshotMetaRequest]) (*connect.Response[v1.GetSnapshotMetaResponse], error)
+	GetDocumentAtRevision(context.Context, *connect.Request[v1.GetDocumentAtRevisionRequest]) (*connect.Response[v1.GetDocumentAtRevisionResponse], error)
+	GetDocumentDiff(context.Context, *connect.Request[v1.GetDocumentDiffRequest]) (*connect.Response[v1.GetDocumentDiffResponse], error)
+	RestoreDocument(context.Context, *connect.Request[v1.RestoreDocumentRequest]) (*connect.Response[v1.RestoreDocumentResponse], error)
+	GetDocumentGCStatus(context.Context, *connect.Request[v1.GetDocumentGCStatusRequest]) (*connect.Response[v1.GetDocumentGCStatusResponse], error)
+	CollectDocumentGarbage(context.Context, *connect.Request[v1.CollectDocumentGarbageRequest]) (*connect.Response[v1.CollectDocumentGarbageResponse], error)
 	SearchDocuments(context.Context, *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error)
 	ListChanges(context.Context, *connect.Request[v1.ListChangesRequest]) (*connect.Response[v1.ListChangesResponse], error)
 	GetServerVersion(context.Context, *connect.Request[v1.GetServerVersionRequest]) (*connect.Response[v1.GetServerVersionResponse], error)
@@ -217,6 +237,31 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+AdminServiceGetSnapshotMetaProcedure,
 			opts...,
 		),
+		getDocumentAtRevision: connect.NewClient[v1.GetDocumentAtRevisionRequest, v1.GetDocumentAtRevisionResponse](
+			httpClient,
+			baseURL+AdminServiceGetDocumentAtRevisionProcedure,
+			opts...,
+		),
+		getDocumentDiff: connect.NewClient[v1.GetDocumentDiffRequest, v1.GetDocumentDiffResponse](
+			httpClient,
+			baseURL+AdminServiceGetDocumentDiffProcedure,
+			opts...,
+		),
+		restoreDocument: connect.NewClient[v1.RestoreDocumentRequest, v1.RestoreDocumentResponse](
+			httpClient,
+			baseURL+AdminServiceRestoreDocumentProcedure,
+			opts...,
+		),
+		getDocumentGCStatus: connect.NewClient[v1.GetDocumentGCStatusRequest, v1.GetDocumentGCStatusResponse](
+			httpClient,
+			baseURL+AdminServiceGetDocumentGCStatusProcedure,
+			opts...,
+		),
+		collectDocumentGarbage: connect.NewClient[v1.CollectDocumentGarbageRequest, v1.CollectDocumentGarbageResponse](
+			httpClient,
+			baseURL+AdminServiceCollectDocumentGarbageProcedure,
+			opts...,
+		),
 		searchDocuments: connect.NewClient[v1.SearchDocumentsRequest, v1.SearchDocumentsResponse](
 			httpClient,
 			baseURL+AdminServiceSearchDocumentsProcedure,
@@ -237,25 +282,30 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 
 // adminServiceClient implements AdminServiceClient.
 type adminServiceClient struct {
-	signUp                *connect.Client[v1.SignUpRequest, v1.SignUpResponse]
-	logIn                 *connect.Client[v1.LogInRequest, v1.LogInResponse]
-	deleteAccount         *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
-	changePassword        *connect.Client[v1.ChangePasswordRequest, v1.ChangePasswordResponse]
-	createProject         *connect.Client[v1.CreateProjectRequest, v1.CreateProjectResponse]
-	listProjects          *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
-	getProject            *connect.Client[v1.GetProjectRequest, v1.GetProjectResponse]
-	updateProject         *connect.Client[v1.UpdateProjectRequest, v1.UpdateProjectResponse]
-	getProjectStats       *connect.Client[v1.GetProjectStatsRequest, v1.GetProjectStatsResponse]
-	createDocument        *connect.Client[v1.CreateDocumentRequest, v1.CreateDocumentResponse]
-	listDocuments         *connect.Client[v1.ListDocumentsRequest, v1.ListDocumentsResponse]
-	getDocument           *connect.Client[v1.GetDocumentRequest, v1.GetDocumentResponse]
-	getDocuments          *connect.Client[v1.GetDocumentsRequest, v1.GetDocumentsResponse]
-	updateDocument        *connect.Client[v1.UpdateDocumentRequest, v1.UpdateDocumentResponse]
-	removeDocumentByAdmin *connect.Client[v1.RemoveDocumentByAdminRequest, v1.RemoveDocumentByAdminResponse]
-	getSnapshotMeta       *connect.Client[v1.GetSnapshotMetaRequest, v1.GetSnapshotMetaResponse]
-	searchDocuments       *connect.Client[v1.SearchDocumentsRequest, v1.SearchDocumentsResponse]
-	listChanges           *connect.Client[v1.ListChangesRequest, v1.ListChangesResponse]
-	getServerVersion      *connect.Client[v1.GetServerVersionRequest, v1.GetServerVersionResponse]
+	signUp                 *connect.Client[v1.SignUpRequest, v1.SignUpResponse]
+	logIn                  *connect.Client[v1.LogInRequest, v1.LogInResponse]
+	deleteAccount          *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
+	changePassword         *connect.Client[v1.ChangePasswordRequest, v1.ChangePasswordResponse]
+	createProject          *connect.Client[v1.CreateProjectRequest, v1.CreateProjectResponse]
+	listProjects           *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
+	getProject             *connect.Client[v1.GetProjectRequest, v1.GetProjectResponse]
+	updateProject          *connect.Client[v1.UpdateProjectRequest, v1.UpdateProjectResponse]
+	getProjectStats        *connect.Client[v1.GetProjectStatsRequest, v1.GetProjectStatsResponse]
+	createDocument         *connect.Client[v1.CreateDocumentRequest, v1.CreateDocumentResponse]
+	listDocuments          *connect.Client[v1.ListDocumentsRequest, v1.ListDocumentsResponse]
+	getDocument            *connect.Client[v1.GetDocumentRequest, v1.GetDocumentResponse]
+	getDocuments           *connect.Client[v1.GetDocumentsRequest, v1.GetDocumentsResponse]
+	updateDocument         *connect.Client[v1.UpdateDocumentRequest, v1.UpdateDocumentResponse]
+	removeDocumentByAdmin  *connect.Client[v1.RemoveDocumentByAdminRequest, v1.RemoveDocumentByAdminResponse]
+	getSnapshotMeta        *connect.Client[v1.GetSnapshotMetaRequest, v1.GetSnapshotMetaResponse]
+	getDocumentAtRevision  *connect.Client[v1.GetDocumentAtRevisionRequest, v1.GetDocumentAtRevisionResponse]
+	getDocumentDiff        *connect.Client[v1.GetDocumentDiffRequest, v1.GetDocumentDiffResponse]
+	restoreDocument        *connect.Client[v1.RestoreDocumentRequest, v1.RestoreDocumentResponse]
+	getDocumentGCStatus    *connect.Client[v1.GetDocumentGCStatusRequest, v1.GetDocumentGCStatusResponse]
+	collectDocumentGarbage *connect.Client[v1.CollectDocumentGarbageRequest, v1.CollectDocumentGarbageResponse]
+	searchDocuments        *connect.Client[v1.SearchDocumentsRequest, v1.SearchDocumentsResponse]
+	listChanges            *connect.Client[v1.ListChangesRequest, v1.ListChangesResponse]
+	getServerVersion       *connect.Client[v1.GetServerVersionRequest, v1.GetServerVersionResponse]
 }
 
 // SignUp calls yorkie.v1.AdminService.SignUp.
@@ -338,6 +388,31 @@ func (c *adminServiceClient) GetSnapshotMeta(ctx context.Context, req *connect.R
 	return c.getSnapshotMeta.CallUnary(ctx, req)
 }
 
+// GetDocumentAtRevision calls yorkie.v1.AdminService.GetDocumentAtRevision.
+func (c *adminServiceClient) GetDocumentAtRevision(ctx context.Context, req *connect.Request[v1.GetDocumentAtRevisionRequest]) (*connect.Response[v1.GetDocumentAtRevisionResponse], error) {
+	return c.getDocumentAtRevision.CallUnary(ctx, req)
+}
+
+// GetDocumentDiff calls yorkie.v1.AdminService.GetDocumentDiff.
+func (c *adminServiceClient) GetDocumentDiff(ctx context.Context, req *connect.Request[v1.GetDocumentDiffRequest]) (*connect.Response[v1.GetDocumentDiffResponse], error) {
+	return c.getDocumentDiff.CallUnary(ctx, req)
+}
+
+// RestoreDocument calls yorkie.v1.AdminService.RestoreDocument.
+func (c *adminServiceClient) RestoreDocument(ctx context.Context, req *connect.Request[v1.RestoreDocumentRequest]) (*connect.Response[v1.RestoreDocumentResponse], error) {
+	return c.restoreDocument.CallUnary(ctx, req)
+}
+
+// GetDocumentGCStatus calls yorkie.v1.AdminService.GetDocumentGCStatus.
+func (c *adminServiceClient) GetDocumentGCStatus(ctx context.Context, req *connect.Request[v1.GetDocumentGCStatusRequest]) (*connect.Response[v1.GetDocumentGCStatusResponse], error) {
+	return c.getDocumentGCStatus.CallUnary(ctx, req)
+}
+
+// CollectDocumentGarbage calls yorkie.v1.AdminService.CollectDocumentGarbage.
+func (c *adminServiceClient) CollectDocumentGarbage(ctx context.Context, req *connect.Request[v1.CollectDocumentGarbageRequest]) (*connect.Response[v1.CollectDocumentGarbageResponse], error) {
+	return c.collectDocumentGarbage.CallUnary(ctx, req)
+}
+
 // SearchDocuments calls yorkie.v1.AdminService.SearchDocuments.
 func (c *adminServiceClient) SearchDocuments(ctx context.Context, req *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error) {
 	return c.searchDocuments.CallUnary(ctx, req)
@@ -371,6 +446,11 @@ type AdminServiceHandler interface {
 	UpdateDocument(context.Context, *connect.Request[v1.UpdateDocumentRequest]) (*connect.Response[v1.UpdateDocumentResponse], error)
 	RemoveDocumentByAdmin(context.Context, *connect.Request[v1.RemoveDocumentByAdminRequest]) (*connect.Response[v1.RemoveDocumentByAdminResponse], error)
 	GetSnapshotMeta(context.Context, *connect.Request[v1.GetSnapshotMetaRequest]) (*connect.Response[v1.GetSnapshotMetaResponse], error)
+	GetDocumentAtRevision(context.Context, *connect.Request[v1.GetDocumentAtRevisionRequest]) (*connect.Response[v1.GetDocumentAtRevisionResponse], error)
+	GetDocumentDiff(context.Context, *connect.Request[v1.GetDocumentDiffRequest]) (*connect.Response[v1.GetDocumentDiffResponse], error)
+	RestoreDocument(context.Context, *connect.Request[v1.RestoreDocumentRequest]) (*connect.Response[v1.RestoreDocumentResponse], error)
+	GetDocumentGCStatus(context.Context, *connect.Request[v1.GetDocumentGCStatusRequest]) (*connect.Response[v1.GetDocumentGCStatusResponse], error)
+	CollectDocumentGarbage(context.Context, *connect.Request[v1.CollectDocumentGarbageRequest]) (*connect.Response[v1.CollectDocumentGarbageResponse], error)
 	SearchDocuments(context.Context, *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error)
 	ListChanges(context.Context, *connect.Request[v1.ListChangesRequest]) (*connect.Response[v1.ListChangesResponse], error)
 	GetServerVersion(context.Context, *connect.Request[v1.GetServerVersionRequest]) (*connect.Response[v1.GetServerVersionResponse], error)
@@ -462,6 +542,31 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		svc.GetSnapshotMeta,
 		opts...,
 	)
+	adminServiceGetDocumentAtRevisionHandler := connect.NewUnaryHandler(
+		AdminServiceGetDocumentAtRevisionProcedure,
+		svc.GetDocumentAtRevision,
+		opts...,
+	)
+	adminServiceGetDocumentDiffHandler := connect.NewUnaryHandler(
+		AdminServiceGetDocumentDiffProcedure,
+		svc.GetDocumentDiff,
+		opts...,
+	)
+	adminServiceRestoreDocumentHandler := connect.NewUnaryHandler(
+		AdminServiceRestoreDocumentProcedure,
+		svc.RestoreDocument,
+		opts...,
+	)
+	adminServiceGetDocumentGCStatusHandler := connect.NewUnaryHandler(
+		AdminServiceGetDocumentGCStatusProcedure,
+		svc.GetDocumentGCStatus,
+		opts...,
+	)
+	adminServiceCollectDocumentGarbageHandler := connect.NewUnaryHandler(
+		AdminServiceCollectDocumentGarbageProcedure,
+		svc.CollectDocumentGarbage,
+		opts...,
+	)
 	adminServiceSearchDocumentsHandler := connect.NewUnaryHandler(
 		AdminServiceSearchDocumentsProcedure,
 		svc.SearchDocuments,
@@ -511,6 +616,16 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceRemoveDocumentByAdminHandler.ServeHTTP(w, r)
 		case AdminServiceGetSnapshotMetaProcedure:
 			adminServiceGetSnapshotMetaHandler.ServeHTTP(w, r)
+		case AdminServiceGetDocumentAtRevisionProcedure:
+			adminServiceGetDocumentAtRevisionHandler.ServeHTTP(w, r)
+		case AdminServiceGetDocumentDiffProcedure:
+			adminServiceGetDocumentDiffHandler.ServeHTTP(w, r)
+		case AdminServiceRestoreDocumentProcedure:
+			adminServiceRestoreDocumentHandler.ServeHTTP(w, r)
+		case AdminServiceGetDocumentGCStatusProcedure:
+			adminServiceGetDocumentGCStatusHandler.ServeHTTP(w, r)
+		case AdminServiceCollectDocumentGarbageProcedure:
+			adminServiceCollectDocumentGarbageHandler.ServeHTTP(w, r)
 		case AdminServiceSearchDocumentsProcedure:
 			adminServiceSearchDocumentsHandler.ServeHTTP(w, r)
 		case AdminServiceListChangesProcedure:
@@ -590,6 +705,26 @@ func (UnimplementedAdminServiceHandler) GetSnapshotMeta(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.GetSnapshotMeta is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) GetDocumentAtRevision(context.Context, *connect.Request[v1.GetDocumentAtRevisionRequest]) (*connect.Response[v1.GetDocumentAtRevisionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.GetDocumentAtRevision is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetDocumentDiff(context.Context, *connect.Request[v1.GetDocumentDiffRequest]) (*connect.Response[v1.GetDocumentDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.GetDocumentDiff is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RestoreDocument(context.Context, *connect.Request[v1.RestoreDocumentRequest]) (*connect.Response[v1.RestoreDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.RestoreDocument is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetDocumentGCStatus(context.Context, *connect.Request[v1.GetDocumentGCStatusRequest]) (*connect.Response[v1.GetDocumentGCStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.GetDocumentGCStatus is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CollectDocumentGarbage(context.Context, *connect.Request[v1.CollectDocumentGarbageRequest]) (*connect.Response[v1.CollectDocumentGarbageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.CollectDocumentGarbage is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) SearchDocuments(context.Context, *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.SearchDocuments is not implemented"))
 }
@@ -28,7 +28,9 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"sync/atomic"
+	gotime "time"
 
 	"connectrpc.com/connect"
 	"github.com/rs/xid"
@@ -40,11 +42,14 @@ import (
 	"github.com/yorkie-team/yorkie/api/types/events"
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/api/yorkie/v1/v1connect"
+	"github.com/yorkie-team/yorkie/pkg/cmap"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/schema"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -77,6 +82,10 @@ var (
 
 	// ErrAlreadySubscribed occurs when the client is already subscribed to the document.
 	ErrAlreadySubscribed = errors.New("already subscribed")
+
+	// ErrDocumentPullOnly occurs when the document attached in pull-only mode
+	// is changed to the mode that pushes changes, or it has local changes.
+	ErrDocumentPullOnly = errors.New("document is attached in pull-only mode")
 )
 
 // Attachment represents the document attached.
@@ -90,6 +99,59 @@ type Attachment struct {
 	rch              <-chan WatchResponse
 	watchCtx         context.Context
 	closeWatchStream context.CancelFunc
+
+	// base is the snapshot of the document at the time it was last
+	// synchronized. It is persisted to the local store with the local changes.
+	base *change.Pack
+
+	// persistMu serializes the persistence of the local state, which is
+	// requested by the local changes and by the synchronization. It also
+	// guards base.
+	persistMu sync.Mutex
+
+	// unwatchLocalChanges stops persisting the local changes of the document.
+	unwatchLocalChanges func()
+
+	// syncMu serializes the synchronization of the document, which can be
+	// requested by the user and by the watch stream on reconnect.
+	syncMu sync.Mutex
+
+	// remoteChangeEventReceived is set when the watch stream notifies that
+	// the document is changed by others, so that the sync loop pulls them.
+	remoteChangeEventReceived atomic.Bool
+
+	// isPaused is set when the sync loop is paused for the document.
+	isPaused atomic.Bool
+
+	// mode is the sync mode of the document.
+	mode atomic.Int32
+
+	// pullOnly is set when the document is attached in pull-only mode. The
+	// server rejects the changes of the document for the whole attachment, so
+	// the document is read-only.
+	pullOnly bool
+}
+
+// syncMode returns the sync mode of the attachment.
+func (a *Attachment) syncMode() types.SyncMode {
+	return types.SyncMode(a.mode.Load())
+}
+
+// setSyncMode sets the sync mode of the attachment.
+func (a *Attachment) setSyncMode(mode types.SyncMode) {
+	a.mode.Store(int32(mode)) //nolint:gosec
+}
+
+// createChangePack creates a change pack of the given document. In pull-only
+// mode, the local changes are held back and stay in the document until the
+// mode is changed to push them, because the server rejects them.
+func createChangePack(doc *document.Document, pullOnly bool) *change.Pack {
+	pack := doc.CreateChangePack()
+	if !pullOnly {
+		return pack
+	}
+
+	return change.NewPack(pack.DocumentKey, doc.Checkpoint(), nil, pack.VersionVector, nil)
 }
 
 // Client is a normal client that can communicate with the server.
@@ -105,8 +167,11 @@ type Client struct {
 
 	id          time.ActorID
 	key         string
-	status      status
-	attachments map[key.Key]*Attachment
+	attachments *cmap.Map[key.Key, *Attachment]
+
+	// status is the status of the client. It is accessed atomically, because
+	// the sync loops and the watch streams check it in the background.
+	status atomic.Int32
 }
 
 // WatchResponseType is type of watch response.
@@ -119,6 +184,13 @@ const (
 	DocumentUnwatched WatchResponseType = "document-unwatched"
 	PresenceChanged   WatchResponseType = "presence-changed"
 	DocumentBroadcast WatchResponseType = "document-broadcast"
+
+	// StreamConnecting, StreamConnected and StreamDisconnected represent the
+	// state of the watch stream. The watch stream is reconnected according to
+	// the ReconnectPolicy of the client after it is disconnected.
+	StreamConnecting   = WatchResponseType(document.StreamConnecting)
+	StreamConnected    = WatchResponseType(document.StreamConnected)
+	StreamDisconnected = WatchResponseType(document.StreamDisconnected)
 )
 
 // WatchResponse is a structure representing response of Watch.
@@ -157,6 +229,8 @@ func New(opts ...Option) (*Client, error) {
 		clientOptions = append(clientOptions, connect.WithReadMaxBytes(options.MaxCallRecvMsgSize))
 	}
 
+	options.ReconnectPolicy = options.ReconnectPolicy.withDefaults()
+
 	logger := options.Logger
 	if logger == nil {
 		l, err := zap.NewProduction()
@@ -174,8 +248,7 @@ func New(opts ...Option) (*Client, error) {
 		interceptor:   interceptor,
 
 		key:         k,
-		status:      deactivated,
-		attachments: make(map[key.Key]*Attachment),
+		attachments: cmap.New[key.Key, *Attachment](),
 	}, nil
 }
 
@@ -228,7 +301,7 @@ func (c *Client) Close() error {
 // and receives a unique ID from the server. The given ID is used to distinguish
 // different clients.
 func (c *Client) Activate(ctx context.Context) error {
-	if c.status == activated {
+	if c.IsActive() {
 		return nil
 	}
 
@@ -247,15 +320,15 @@ func (c *Client) Activate(ctx context.Context) error {
 		return err
 	}
 
-	c.status = activated
 	c.id = clientID
+	c.status.Store(int32(activated))
 
 	return nil
 }
 
 // Deactivate deactivates this client.
 func (c *Client) Deactivate(ctx context.Context) error {
-	if c.status == deactivated {
+	if !c.IsActive() {
 		return nil
 	}
 
@@ -269,7 +342,13 @@ func (c *Client) Deactivate(ctx context.Context) error {
 		return err
 	}
 
-	c.status = deactivated
+	c.status.Store(int32(deactivated))
+
+	// NOTE: The sync loops and the watch streams of the documents are stopped,
+	// because the server no longer accepts the requests of this client.
+	for _, attachment := range c.attachments.Values() {
+		attachment.closeWatchStream()
+	}
 
 	return nil
 }
@@ -277,7 +356,7 @@ func (c *Client) Deactivate(ctx context.Context) error {
 // Attach attaches the given document to this client. It tells the server that
 // this client will synchronize the given document.
 func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...AttachOption) error {
-	if c.status != activated {
+	if !c.IsActive() {
 		return ErrClientNotActivated
 	}
 
@@ -290,14 +369,27 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 		opt(opts)
 	}
 
+	// NOTE: The document attached in PullOnly mode is read-only, since its
+	// changes can never be pushed.
+	pullOnly := opts.SyncMode == types.SyncModePullOnly
 	doc.SetActor(c.id)
+	doc.SetReadOnly(opts.IsReadOnly || pullOnly)
 
-	if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
-		p.Initialize(opts.Presence)
-		return nil
-	}); err != nil {
+	if err := c.restoreLocalState(doc); err != nil {
 		return err
 	}
+	if pullOnly && doc.HasLocalChanges() {
+		return ErrDocumentPullOnly
+	}
+
+	if !doc.IsReadOnly() {
+		if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			p.Initialize(opts.Presence)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
 
 	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
 	if err != nil {
@@ -309,6 +401,8 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 		withShardKey(connect.NewRequest(&api.AttachDocumentRequest{
 			ClientId:   c.id.String(),
 			ChangePack: pbChangePack,
+			ReadOnly:   opts.IsReadOnly,
+			PullOnly:   pullOnly,
 		},
 		), c.options.APIKey, doc.Key().String()))
 	if err != nil {
@@ -335,21 +429,59 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 		return nil
 	}
 
-	doc.SetStatus(document.StatusAttached)
-	c.attachments[doc.Key()] = &Attachment{
-		doc:   doc,
-		docID: types.ID(res.Msg.DocumentId),
+	// NOTE: If the project has a schema, the document validates the updates
+	// locally so that the changes violating the schema are not pushed.
+	if len(res.Msg.Schema) > 0 {
+		s, err := schema.Parse(res.Msg.Schema)
+		if err != nil {
+			return err
+		}
+		doc.SetSchema(s)
 	}
 
+	// NOTE: If the project limits the size of documents, the document checks
+	// the updates locally so that the oversized changes are not pushed.
+	if limit := (document.SizeLimit{
+		MaxDocumentSize: int(res.Msg.MaxDocumentSize),
+		MaxChangeSize:   int(res.Msg.MaxChangeSize),
+	}); limit.IsEnabled() {
+		doc.SetSizeLimit(limit)
+	}
+
+	// NOTE: The presence is sent as a patch only if the server supports it,
+	// since the servers not supporting it can not apply the patch.
+	doc.SetPresencePatch(res.Msg.PresencePatch)
+
+	doc.SetStatus(document.StatusAttached)
 	watchCtx, cancelFunc := context.WithCancel(ctx)
-	c.attachments[doc.Key()].watchCtx = watchCtx
-	c.attachments[doc.Key()].closeWatchStream = cancelFunc
+	attachment := &Attachment{
+		doc:              doc,
+		docID:            types.ID(res.Msg.DocumentId),
+		watchCtx:         watchCtx,
+		closeWatchStream: cancelFunc,
+		pullOnly:         pullOnly,
+	}
+	attachment.setSyncMode(opts.SyncMode)
+	c.attachments.Set(doc.Key(), attachment)
+
+	if err := c.persistLocalState(attachment, true); err != nil {
+		return err
+	}
+	c.watchLocalChanges(attachment)
 
 	if opts.IsRealtime {
 		err = c.runWatchLoop(watchCtx, doc)
 		if err != nil {
 			return err
 		}
+
+		if c.options.SyncLoopDuration > 0 {
+			go c.runSyncLoop(watchCtx, attachment)
+		}
+	}
+
+	if doc.IsReadOnly() {
+		return nil
 	}
 
 	if err = doc.Update(func(root *json.Object, p *presence.Presence) error {
@@ -375,7 +507,7 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 // changes should be applied to other replicas before GC time. For this, if the
 // document is no longer used by this client, it should be detached.
 func (c *Client) Detach(ctx context.Context, doc *document.Document, options ...DetachOption) error {
-	if c.status != activated {
+	if !c.IsActive() {
 		return ErrClientNotActivated
 	}
 
@@ -384,18 +516,20 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document, options ...
 		opt(opts)
 	}
 
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return ErrDocumentNotAttached
 	}
 
 	attachment.closeWatchStream()
 
-	if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
-		p.Clear()
-		return nil
-	}); err != nil {
-		return err
+	if !doc.IsReadOnly() {
+		if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			p.Clear()
+			return nil
+		}); err != nil {
+			return err
+		}
 	}
 
 	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
@@ -427,7 +561,11 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document, options ...
 	if doc.Status() != document.StatusRemoved {
 		doc.SetStatus(document.StatusDetached)
 	}
-	delete(c.attachments, doc.Key())
+	c.deleteAttachment(doc.Key())
+
+	if err := c.deleteLocalState(attachment); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -437,7 +575,7 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document, options ...
 // local documents.
 func (c *Client) Sync(ctx context.Context, options ...SyncOptions) error {
 	if len(options) == 0 {
-		for _, attachment := range c.attachments {
+		for _, attachment := range c.attachments.Values() {
 			options = append(options, WithDocKey(attachment.doc.Key()))
 		}
 	}
@@ -455,7 +593,7 @@ func (c *Client) Sync(ctx context.Context, options ...SyncOptions) error {
 func (c *Client) Subscribe(
 	doc *document.Document,
 ) (<-chan WatchResponse, context.CancelFunc, error) {
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return nil, nil, ErrDocumentNotAttached
 	}
@@ -473,7 +611,7 @@ func (c *Client) Watch(ctx context.Context, doc *document.Document) (
 	*connect.ServerStreamForClient[api.WatchDocumentResponse],
 	error,
 ) {
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return nil, ErrDocumentNotAttached
 	}
@@ -496,61 +634,26 @@ func (c *Client) runWatchLoop(
 	ctx context.Context,
 	doc *document.Document,
 ) error {
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return ErrDocumentNotAttached
 	}
 
-	stream, err := c.Watch(ctx, doc)
+	stream, err := c.connectWatchStream(ctx, doc)
 	if err != nil {
 		return err
 	}
 
-	// NOTE(hackerwins): We need to receive the first response to initialize
-	// the watch stream. runWatchLoop should be blocked until the first response is
-	// received.
-	if !stream.Receive() {
-		return ErrInitializationNotReceived
-	}
-	if _, err := handleResponse(stream.Msg(), doc); err != nil {
-		return err
-	}
-	if err = stream.Err(); err != nil {
-		return err
-	}
-
 	rch := make(chan WatchResponse)
 	attachment.rch = rch
 
+	// NOTE: The channel is closed after all the goroutines sending to it are
+	// finished, so that they do not send to the closed channel.
+	wg := sync.WaitGroup{}
+	wg.Add(2)
 	go func() {
-		for stream.Receive() {
-			pbResp := stream.Msg()
-			resp, err := handleResponse(pbResp, doc)
-			if err != nil {
-				rch <- WatchResponse{Err: err}
-				ctx.Done()
-				close(rch)
-				return
-			}
-			if resp == nil || !attachment.isSubscribed.Load() {
-				continue
-			}
-
-			rch <- *resp
-		}
-		if err = stream.Err(); err != nil {
-			attachment.isSubscribed.Store(false)
-			rch <- WatchResponse{Err: err}
-			ctx.Done()
-			close(rch)
-
-			// If watch stream is disconnected, we re-establish the watch stream.
-			err = c.runWatchLoop(ctx, doc)
-			if err != nil {
-				return
-			}
-			return
-		}
+		defer wg.Done()
+		c.receiveWatchStream(ctx, attachment, stream, rch)
 	}()
 
 	// TODO(hackerwins): We need to revise the implementation of the watch
@@ -563,6 +666,7 @@ func (c *Client) runWatchLoop(
 	// TODO(hackerwins): We should ensure that the goroutine is closed when
 	// the stream is closed.
 	go func() {
+		defer wg.Done()
 		for {
 			select {
 			case e := <-doc.Events():
@@ -571,14 +675,26 @@ func (c *Client) runWatchLoop(
 					t = DocumentWatched
 				} else if e.Type == document.UnwatchedEvent {
 					t = DocumentUnwatched
+				} else if e.Type == document.ConnectionChangedEvent {
+					t = WatchResponseType(e.StreamConnection)
+				}
+
+				select {
+				case rch <- WatchResponse{Type: t, Presences: e.Presences}:
+				case <-ctx.Done():
+					return
 				}
-				rch <- WatchResponse{Type: t, Presences: e.Presences}
 			case <-ctx.Done():
 				return
 			}
 		}
 	}()
 
+	go func() {
+		wg.Wait()
+		close(rch)
+	}()
+
 	go func() {
 		for {
 			select {
@@ -593,6 +709,157 @@ func (c *Client) runWatchLoop(
 	return nil
 }
 
+// connectWatchStream opens the watch stream of the given document.
+func (c *Client) connectWatchStream(
+	ctx context.Context,
+	doc *document.Document,
+) (*connect.ServerStreamForClient[api.WatchDocumentResponse], error) {
+	stream, err := c.Watch(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE(hackerwins): We need to receive the first response to initialize
+	// the watch stream. runWatchLoop should be blocked until the first response is
+	// received.
+	if !stream.Receive() {
+		return nil, ErrInitializationNotReceived
+	}
+	if _, err := handleResponse(stream.Msg(), doc); err != nil {
+		return nil, err
+	}
+	if err = stream.Err(); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// receiveWatchStream delivers the responses of the given watch stream to the
+// channel. If the stream is disconnected, it reconnects the stream according
+// to the reconnect policy and requests to pull the changes missed while
+// disconnected. If the stream cannot be reconnected, the watch context is
+// canceled to close the channel.
+func (c *Client) receiveWatchStream(
+	ctx context.Context,
+	attachment *Attachment,
+	stream *connect.ServerStreamForClient[api.WatchDocumentResponse],
+	rch chan<- WatchResponse,
+) {
+	defer attachment.closeWatchStream()
+
+	for {
+		for stream.Receive() {
+			resp, err := handleResponse(stream.Msg(), attachment.doc)
+			if err != nil {
+				c.sendWatchResponse(ctx, attachment, rch, WatchResponse{Err: err})
+				return
+			}
+			if resp == nil {
+				continue
+			}
+			if resp.Type == DocumentChanged {
+				attachment.remoteChangeEventReceived.Store(true)
+			}
+
+			c.sendWatchResponse(ctx, attachment, rch, *resp)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		c.logger.Warn("watch stream disconnected",
+			zap.String("key", attachment.doc.Key().String()),
+			zap.Error(stream.Err()),
+		)
+		c.publishConnectionChanged(ctx, attachment, document.StreamDisconnected)
+
+		if stream = c.reconnectWatchStream(ctx, attachment, rch); stream == nil {
+			return
+		}
+	}
+}
+
+// reconnectWatchStream reconnects the watch stream of the given attachment
+// until it succeeds or the reconnect policy gives up. It returns nil if the
+// stream is not reconnected.
+func (c *Client) reconnectWatchStream(
+	ctx context.Context,
+	attachment *Attachment,
+	rch chan<- WatchResponse,
+) *connect.ServerStreamForClient[api.WatchDocumentResponse] {
+	policy := c.options.ReconnectPolicy
+	for attempt := 0; policy.canRetry(attempt); attempt++ {
+		select {
+		case <-gotime.After(policy.Backoff(attempt)):
+		case <-ctx.Done():
+			return nil
+		}
+
+		c.publishConnectionChanged(ctx, attachment, document.StreamConnecting)
+		stream, err := c.connectWatchStream(ctx, attachment.doc)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			c.logger.Warn("reconnect watch stream",
+				zap.String("key", attachment.doc.Key().String()),
+				zap.Int("attempt", attempt),
+				zap.Error(err),
+			)
+			c.publishConnectionChanged(ctx, attachment, document.StreamDisconnected)
+			continue
+		}
+		c.publishConnectionChanged(ctx, attachment, document.StreamConnected)
+
+		// NOTE: The changes of others made while disconnected are not notified
+		// through the stream. Instead of synchronizing the document here, which
+		// races with the user editing it, the sync loop is asked to pull them
+		// and the user is notified to synchronize in manual sync.
+		attachment.remoteChangeEventReceived.Store(true)
+		c.sendWatchResponse(ctx, attachment, rch, WatchResponse{Type: DocumentChanged})
+
+		return stream
+	}
+
+	return nil
+}
+
+// publishConnectionChanged publishes the state of the watch stream to the
+// event channel of the document if the document is subscribed. It is
+// delivered to the watch response channel as StreamConnecting, StreamConnected
+// or StreamDisconnected.
+func (c *Client) publishConnectionChanged(
+	ctx context.Context,
+	attachment *Attachment,
+	status document.StreamConnectionStatus,
+) {
+	if !attachment.isSubscribed.Load() {
+		return
+	}
+
+	attachment.doc.PublishConnectionChanged(ctx, status)
+}
+
+// sendWatchResponse sends the given response to the channel if the document
+// is subscribed.
+func (c *Client) sendWatchResponse(
+	ctx context.Context,
+	attachment *Attachment,
+	rch chan<- WatchResponse,
+	resp WatchResponse,
+) {
+	if !attachment.isSubscribed.Load() {
+		return
+	}
+
+	select {
+	case rch <- resp:
+	case <-ctx.Done():
+	}
+}
+
 func handleResponse(
 	pbResp *api.WatchDocumentResponse,
 	doc *document.Document,
@@ -687,21 +954,36 @@ func (c *Client) Key() string {
 
 // IsActive returns whether this client is active or not.
 func (c *Client) IsActive() bool {
-	return c.status == activated
+	return status(c.status.Load()) == activated
 }
 
 // pushPullChanges pushes the changes of the document to the server and pulls the changes from the server.
 func (c *Client) pushPullChanges(ctx context.Context, opt SyncOptions) error {
-	if c.status != activated {
+	if !c.IsActive() {
 		return ErrClientNotActivated
 	}
 
-	attachment, ok := c.attachments[opt.key]
+	attachment, ok := c.attachments.Get(opt.key)
 	if !ok {
 		return ErrDocumentNotAttached
 	}
 
-	pbChangePack, err := converter.ToChangePack(attachment.doc.CreateChangePack())
+	attachment.syncMu.Lock()
+	defer attachment.syncMu.Unlock()
+
+	// NOTE: The sync mode of the attachment takes precedence over the given
+	// one, so that the document attached in PullOnly mode never pushes.
+	mode := opt.mode
+	if m := attachment.syncMode(); m == types.SyncModePullOnly || m == types.SyncModePushOnly {
+		mode = m
+	}
+	if attachment.pullOnly {
+		mode = types.SyncModePullOnly
+	}
+
+	pbChangePack, err := converter.ToChangePack(
+		createChangePack(attachment.doc, mode == types.SyncModePullOnly),
+	)
 	if err != nil {
 		return err
 	}
@@ -712,7 +994,8 @@ func (c *Client) pushPullChanges(ctx context.Context, opt SyncOptions) error {
 			ClientId:   c.id.String(),
 			DocumentId: attachment.docID.String(),
 			ChangePack: pbChangePack,
-			PushOnly:   opt.mode == types.SyncModePushOnly,
+			PushOnly:   mode == types.SyncModePushOnly,
+			PullOnly:   mode == types.SyncModePullOnly,
 		},
 		), c.options.APIKey, opt.key.String()))
 	if err != nil {
@@ -728,19 +1011,22 @@ func (c *Client) pushPullChanges(ctx context.Context, opt SyncOptions) error {
 		return err
 	}
 	if attachment.doc.Status() == document.StatusRemoved {
-		delete(c.attachments, attachment.doc.Key())
+		c.deleteAttachment(attachment.doc.Key())
+		return c.deleteLocalState(attachment)
 	}
 
-	return nil
+	// NOTE: In push-only mode, the changes of others are not pulled, so the
+	// previous snapshot is kept to pull them again when restoring.
+	return c.persistLocalState(attachment, mode != types.SyncModePushOnly)
 }
 
 // Remove removes the given document.
 func (c *Client) Remove(ctx context.Context, doc *document.Document) error {
-	if c.status != activated {
+	if !c.IsActive() {
 		return ErrClientNotActivated
 	}
 
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return ErrDocumentNotAttached
 	}
@@ -772,18 +1058,26 @@ func (c *Client) Remove(ctx context.Context, doc *document.Document) error {
 		return err
 	}
 	if doc.Status() == document.StatusRemoved {
-		delete(c.attachments, doc.Key())
+		c.deleteAttachment(doc.Key())
+		return c.deleteLocalState(attachment)
 	}
 
 	return nil
 }
 
+// deleteAttachment deletes the attachment of the given document key.
+func (c *Client) deleteAttachment(k key.Key) {
+	c.attachments.Delete(k, func(_ *Attachment, exists bool) bool {
+		return exists
+	})
+}
+
 func (c *Client) broadcast(ctx context.Context, doc *document.Document, topic string, payload []byte) error {
-	if c.status != activated {
+	if !c.IsActive() {
 		return ErrClientNotActivated
 	}
 
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return ErrDocumentNotAttached
 	}
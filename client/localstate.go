@@ -0,0 +1,151 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/client/localstore"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+)
+
+// restoreLocalState restores the given document from the state persisted in
+// the local store. The document is restored only if it has not been edited,
+// otherwise the persisted state is overwritten after attaching.
+func (c *Client) restoreLocalState(doc *document.Document) error {
+	if c.options.LocalStore == nil {
+		return nil
+	}
+	if doc.HasLocalChanges() || !doc.Checkpoint().Equals(change.InitialCheckpoint) {
+		return nil
+	}
+
+	state, err := c.options.LocalStore.Get(doc.Key())
+	if errors.Is(err, localstore.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	pbPack := &api.ChangePack{}
+	if err := proto.Unmarshal(state, pbPack); err != nil {
+		return fmt.Errorf("unmarshal local state of %s: %w", doc.Key(), err)
+	}
+	pack, err := converter.FromChangePack(pbPack)
+	if err != nil {
+		return err
+	}
+
+	return doc.Restore(pack)
+}
+
+// persistLocalState persists the state of the given attachment to the local
+// store. The state consists of the snapshot of the document at the time it
+// was last synchronized, and the local changes made after that. If synced is
+// true, the snapshot is taken again from the document.
+func (c *Client) persistLocalState(attachment *Attachment, synced bool) error {
+	if c.options.LocalStore == nil {
+		return nil
+	}
+
+	// NOTE: The state is persisted by the local changes and by the
+	// synchronization concurrently, so it is serialized per attachment.
+	attachment.persistMu.Lock()
+	defer attachment.persistMu.Unlock()
+
+	doc := attachment.doc
+	pack, err := doc.CreateLocalStatePack(attachment.base == nil || synced)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The snapshot should not contain the local changes, because they
+	// are applied again on the snapshot when restoring. So the snapshot is
+	// taken only when all the local changes are sent to the server.
+	if attachment.base == nil || (synced && len(pack.Changes) == 0) {
+		attachment.base = change.NewPack(
+			pack.DocumentKey,
+			pack.Checkpoint,
+			nil,
+			pack.VersionVector,
+			pack.Snapshot,
+		)
+	}
+
+	base := attachment.base
+	pbPack, err := converter.ToChangePack(change.NewPack(
+		pack.DocumentKey,
+		change.NewCheckpoint(base.Checkpoint.ServerSeq, pack.Checkpoint.ClientSeq),
+		pack.Changes,
+		base.VersionVector,
+		base.Snapshot,
+	))
+	if err != nil {
+		return err
+	}
+
+	state, err := proto.Marshal(pbPack)
+	if err != nil {
+		return fmt.Errorf("marshal local state of %s: %w", doc.Key(), err)
+	}
+
+	return c.options.LocalStore.Put(doc.Key(), state)
+}
+
+// watchLocalChanges persists the state of the given attachment whenever the
+// document is changed locally, so that the changes are not lost even if the
+// client crashes before synchronizing them.
+func (c *Client) watchLocalChanges(attachment *Attachment) {
+	if c.options.LocalStore == nil {
+		return
+	}
+
+	attachment.unwatchLocalChanges = attachment.doc.Subscribe("$", func(event document.DocEvent) {
+		if event.Type != document.LocalChangeEvent {
+			return
+		}
+
+		if err := c.persistLocalState(attachment, false); err != nil {
+			c.logger.Error("persist local state", zap.String("key", attachment.doc.Key().String()), zap.Error(err))
+		}
+	})
+}
+
+// deleteLocalState deletes the state of the given attachment from the local
+// store, e.g. when the document is detached or removed.
+func (c *Client) deleteLocalState(attachment *Attachment) error {
+	if c.options.LocalStore == nil {
+		return nil
+	}
+
+	if attachment.unwatchLocalChanges != nil {
+		attachment.unwatchLocalChanges()
+	}
+
+	attachment.persistMu.Lock()
+	defer attachment.persistMu.Unlock()
+
+	return c.options.LocalStore.Delete(attachment.doc.Key())
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package localstore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// FileStore is a Store that keeps the state of each document in a file under
+// the directory.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore creates a new instance of FileStore. The directory is created
+// if it does not exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("create local store directory %q: %w", dir, err)
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// Get returns the state of the document of the given key.
+func (s *FileStore) Get(docKey key.Key) ([]byte, error) {
+	state, err := os.ReadFile(s.path(docKey))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read local state of %s: %w", docKey, err)
+	}
+
+	return state, nil
+}
+
+// Put stores the state of the document of the given key. The state is written
+// to a temporary file first and renamed, so that a crash while writing does
+// not corrupt the previous state.
+func (s *FileStore) Put(docKey key.Key, state []byte) error {
+	tmp, err := os.CreateTemp(s.dir, docKey.String()+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create local state of %s: %w", docKey, err)
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(state); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write local state of %s: %w", docKey, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("sync local state of %s: %w", docKey, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close local state of %s: %w", docKey, err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path(docKey)); err != nil {
+		return fmt.Errorf("rename local state of %s: %w", docKey, err)
+	}
+
+	return nil
+}
+
+// Delete deletes the state of the document of the given key.
+func (s *FileStore) Delete(docKey key.Key) error {
+	if err := os.Remove(s.path(docKey)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete local state of %s: %w", docKey, err)
+	}
+
+	return nil
+}
+
+// path returns the path of the file storing the state of the given document.
+func (s *FileStore) path(docKey key.Key) string {
+	return filepath.Join(s.dir, docKey.String()+".state")
+}
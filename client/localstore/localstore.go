@@ -0,0 +1,43 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package localstore provides the local storage of the client to persist the
+// state of the attached documents, so that the client can resume the documents
+// with the changes not yet sent to the server after restarting.
+package localstore
+
+import (
+	"errors"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// ErrNotFound is returned when the state of the document is not found.
+var ErrNotFound = errors.New("local state not found")
+
+// Store is a key-value storage that persists the encoded local state of the
+// documents by their keys.
+type Store interface {
+	// Get returns the state of the document of the given key. It returns
+	// ErrNotFound if the state does not exist.
+	Get(docKey key.Key) ([]byte, error)
+
+	// Put stores the state of the document of the given key.
+	Put(docKey key.Key, state []byte) error
+
+	// Delete deletes the state of the document of the given key.
+	Delete(docKey key.Key) error
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package localstore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/client/localstore"
+)
+
+func TestLocalStore(t *testing.T) {
+	fileStore, err := localstore.NewFileStore(t.TempDir())
+	assert.NoError(t, err)
+
+	for name, store := range map[string]localstore.Store{
+		"memory": localstore.NewMemoryStore(),
+		"file":   fileStore,
+	} {
+		t.Run(name+" store test", func(t *testing.T) {
+			_, err := store.Get("doc1")
+			assert.ErrorIs(t, err, localstore.ErrNotFound)
+
+			assert.NoError(t, store.Put("doc1", []byte("state1")))
+			assert.NoError(t, store.Put("doc2", []byte("state2")))
+			state, err := store.Get("doc1")
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("state1"), state)
+
+			assert.NoError(t, store.Put("doc1", []byte("state3")))
+			state, err = store.Get("doc1")
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("state3"), state)
+
+			assert.NoError(t, store.Delete("doc1"))
+			assert.NoError(t, store.Delete("doc1"))
+			_, err = store.Get("doc1")
+			assert.ErrorIs(t, err, localstore.ErrNotFound)
+
+			state, err = store.Get("doc2")
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("state2"), state)
+		})
+	}
+
+	t.Run("file store persistence test", func(t *testing.T) {
+		dir := t.TempDir()
+		s1, err := localstore.NewFileStore(dir)
+		assert.NoError(t, err)
+		assert.NoError(t, s1.Put("doc1", []byte("state1")))
+
+		s2, err := localstore.NewFileStore(dir)
+		assert.NoError(t, err)
+		state, err := s2.Get("doc1")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("state1"), state)
+	})
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package localstore
+
+import (
+	"sync"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// MemoryStore is a Store that keeps the states in memory. It is useful for
+// testing as the states are lost when the process exits.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	states map[key.Key][]byte
+}
+
+// NewMemoryStore creates a new instance of MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		states: make(map[key.Key][]byte),
+	}
+}
+
+// Get returns the state of the document of the given key.
+func (s *MemoryStore) Get(docKey key.Key) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	state, ok := s.states[docKey]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return append([]byte(nil), state...), nil
+}
+
+// Put stores the state of the document of the given key.
+func (s *MemoryStore) Put(docKey key.Key, state []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[docKey] = append([]byte(nil), state...)
+	return nil
+}
+
+// Delete deletes the state of the document of the given key.
+func (s *MemoryStore) Delete(docKey key.Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.states, docKey)
+	return nil
+}
@@ -17,9 +17,12 @@
 package client
 
 import (
+	gotime "time"
+
 	"go.uber.org/zap"
 
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client/localstore"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/yson"
@@ -50,6 +53,19 @@ type Options struct {
 
 	// MaxCallRecvMsgSize is the maximum message size in bytes the client can receive.
 	MaxCallRecvMsgSize int
+
+	// LocalStore is the storage to persist the local state of the attached
+	// documents. If it is nil, the documents are kept only in memory.
+	LocalStore localstore.Store
+
+	// ReconnectPolicy is the policy to reconnect the watch streams of the
+	// documents. The fields not set are filled with DefaultReconnectPolicy.
+	ReconnectPolicy ReconnectPolicy
+
+	// SyncLoopDuration is the interval of the sync loop which synchronizes
+	// the documents attached in realtime. If it is 0, the sync loop is not
+	// run and the documents should be synchronized with Sync.
+	SyncLoopDuration gotime.Duration
 }
 
 // WithKey configures the key of the client.
@@ -87,6 +103,26 @@ func WithMaxRecvMsgSize(maxRecvMsgSize int) Option {
 	return func(o *Options) { o.MaxCallRecvMsgSize = maxRecvMsgSize }
 }
 
+// WithLocalStore configures the storage to persist the local state of the
+// attached documents, so that the changes not yet sent to the server are
+// resumed when the documents are attached again after restarting.
+func WithLocalStore(store localstore.Store) Option {
+	return func(o *Options) { o.LocalStore = store }
+}
+
+// WithReconnectPolicy configures the policy to reconnect the watch streams of
+// the documents attached in realtime.
+func WithReconnectPolicy(policy ReconnectPolicy) Option {
+	return func(o *Options) { o.ReconnectPolicy = policy }
+}
+
+// WithSyncLoopDuration configures the interval of the sync loop. The local
+// changes made during the interval are pushed together, and the changes of
+// others are pulled when the watch stream notifies them.
+func WithSyncLoopDuration(duration gotime.Duration) Option {
+	return func(o *Options) { o.SyncLoopDuration = duration }
+}
+
 // AttachOption configures AttachOptions.
 type AttachOption func(*AttachOptions)
 
@@ -96,6 +132,8 @@ type AttachOptions struct {
 	Presence    innerpresence.Presence
 	InitialRoot yson.Object
 	IsRealtime  bool
+	SyncMode    types.SyncMode
+	IsReadOnly  bool
 }
 
 // WithPresence configures the presence of the client.
@@ -117,6 +155,21 @@ func WithRealtimeSync() AttachOption {
 	return func(o *AttachOptions) { o.IsRealtime = true }
 }
 
+// WithSyncMode configures the sync mode of the document. In PullOnly mode, the
+// server rejects the changes of the document, so the document is read-only like
+// WithReadOnly. In RealtimeSyncOff mode, the sync loop does not synchronize the
+// document.
+func WithSyncMode(mode types.SyncMode) AttachOption {
+	return func(o *AttachOptions) { o.SyncMode = mode }
+}
+
+// WithReadOnly configures the document to be attached in read-only mode. The
+// updates of the document fail locally, and the server rejects the changes of
+// the document. The presence and the initial root are not applied.
+func WithReadOnly() AttachOption {
+	return func(o *AttachOptions) { o.IsReadOnly = true }
+}
+
 // DetachOption configures DetachOptions.
 type DetachOption func(*DetachOptions)
 
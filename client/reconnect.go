@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"math"
+	"math/rand"
+	gotime "time"
+)
+
+// ReconnectPolicy represents how the client reconnects the watch stream of a
+// document when it is disconnected. The interval between the attempts grows
+// exponentially from InitialInterval up to MaxInterval.
+type ReconnectPolicy struct {
+	// InitialInterval is the interval before the first attempt.
+	InitialInterval gotime.Duration
+
+	// MaxInterval is the upper bound of the interval between the attempts.
+	MaxInterval gotime.Duration
+
+	// Multiplier is the factor by which the interval grows after each attempt.
+	Multiplier float64
+
+	// Jitter is the fraction of the interval to randomize, e.g. 0.2 means the
+	// interval is randomized within ±20%. It keeps the clients disconnected at
+	// the same time from reconnecting all at once. A negative value disables
+	// the randomization.
+	Jitter float64
+
+	// MaxRetries is the maximum number of the attempts. If it is 0, the client
+	// retries until the document is detached.
+	MaxRetries int
+}
+
+// DefaultReconnectPolicy returns the default reconnect policy.
+func DefaultReconnectPolicy() ReconnectPolicy {
+	return ReconnectPolicy{
+		InitialInterval: 500 * gotime.Millisecond,
+		MaxInterval:     30 * gotime.Second,
+		Multiplier:      2,
+		Jitter:          0.2,
+	}
+}
+
+// withDefaults returns the policy whose zero fields are filled with the values
+// of DefaultReconnectPolicy. MaxRetries is kept as it is, because 0 means
+// retrying without limit.
+func (p ReconnectPolicy) withDefaults() ReconnectPolicy {
+	defaults := DefaultReconnectPolicy()
+	if p.InitialInterval == 0 {
+		p.InitialInterval = defaults.InitialInterval
+	}
+	if p.MaxInterval == 0 {
+		p.MaxInterval = defaults.MaxInterval
+	}
+	if p.Multiplier == 0 {
+		p.Multiplier = defaults.Multiplier
+	}
+	if p.Jitter == 0 {
+		p.Jitter = defaults.Jitter
+	}
+
+	return p
+}
+
+// Backoff returns the interval to wait before the given attempt, starting
+// from 0.
+func (p ReconnectPolicy) Backoff(attempt int) gotime.Duration {
+	interval := float64(p.InitialInterval) * math.Pow(math.Max(p.Multiplier, 1), float64(attempt))
+	if p.MaxInterval > 0 && interval > float64(p.MaxInterval) {
+		interval = float64(p.MaxInterval)
+	}
+
+	if p.Jitter > 0 {
+		//nolint:gosec // The jitter does not need to be cryptographically secure.
+		interval += interval * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return gotime.Duration(interval)
+}
+
+// canRetry returns whether the given attempt, starting from 0, is allowed.
+func (p ReconnectPolicy) canRetry(attempt int) bool {
+	return p.MaxRetries <= 0 || attempt < p.MaxRetries
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconnectPolicyDefaults(t *testing.T) {
+	t.Run("zero policy test", func(t *testing.T) {
+		assert.Equal(t, DefaultReconnectPolicy(), ReconnectPolicy{}.withDefaults())
+	})
+
+	t.Run("partial policy test", func(t *testing.T) {
+		policy := ReconnectPolicy{
+			InitialInterval: 10 * gotime.Millisecond,
+			Jitter:          -1,
+			MaxRetries:      3,
+		}.withDefaults()
+
+		defaults := DefaultReconnectPolicy()
+		assert.Equal(t, 10*gotime.Millisecond, policy.InitialInterval)
+		assert.Equal(t, defaults.MaxInterval, policy.MaxInterval)
+		assert.Equal(t, defaults.Multiplier, policy.Multiplier)
+		assert.Equal(t, float64(-1), policy.Jitter)
+		assert.Equal(t, 3, policy.MaxRetries)
+		assert.Equal(t, 20*gotime.Millisecond, policy.Backoff(1))
+	})
+}
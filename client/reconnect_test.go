@@ -0,0 +1,61 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client_test
+
+import (
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/client"
+)
+
+func TestReconnectPolicy(t *testing.T) {
+	t.Run("exponential backoff test", func(t *testing.T) {
+		policy := client.ReconnectPolicy{
+			InitialInterval: ms(100),
+			MaxInterval:     ms(1000),
+			Multiplier:      2,
+		}
+
+		assert.Equal(t, ms(100), policy.Backoff(0))
+		assert.Equal(t, ms(200), policy.Backoff(1))
+		assert.Equal(t, ms(800), policy.Backoff(3))
+		assert.Equal(t, ms(1000), policy.Backoff(4))
+		assert.Equal(t, ms(1000), policy.Backoff(100))
+	})
+
+	t.Run("backoff with jitter test", func(t *testing.T) {
+		policy := client.ReconnectPolicy{
+			InitialInterval: ms(100),
+			Multiplier:      2,
+			Jitter:          0.5,
+		}
+
+		for i := 0; i < 100; i++ {
+			backoff := policy.Backoff(1)
+			assert.GreaterOrEqual(t, backoff, ms(100))
+			assert.LessOrEqual(t, backoff, ms(300))
+		}
+	})
+}
+
+// ms returns the duration of the given milliseconds.
+func ms(n int) gotime.Duration {
+	return gotime.Duration(n) * gotime.Millisecond
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	gotime "time"
+
+	"go.uber.org/zap"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+)
+
+// runSyncLoop synchronizes the given attachment every SyncLoopDuration until
+// the context is canceled. The document is synchronized only when it has
+// local changes or the watch stream notified the changes of others, so the
+// local changes made during the interval are pushed in a batch.
+func (c *Client) runSyncLoop(ctx context.Context, attachment *Attachment) {
+	ticker := gotime.NewTicker(c.options.SyncLoopDuration)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		if !attachment.needSync() {
+			continue
+		}
+
+		attachment.remoteChangeEventReceived.Store(false)
+		if err := c.pushPullChanges(ctx, WithDocKey(attachment.doc.Key())); err != nil {
+			// NOTE: The sync loop stops if the client is deactivated or the
+			// document is no longer attached, e.g. removed by others.
+			if ctx.Err() != nil ||
+				errors.Is(err, ErrClientNotActivated) ||
+				errors.Is(err, ErrDocumentNotAttached) {
+				return
+			}
+
+			// NOTE: The flag is restored to retry pulling in the next round.
+			attachment.remoteChangeEventReceived.Store(true)
+			c.logger.Warn("sync loop", zap.String("key", attachment.doc.Key().String()), zap.Error(err))
+		}
+	}
+}
+
+// needSync returns whether the sync loop should synchronize the document.
+func (a *Attachment) needSync() bool {
+	if a.isPaused.Load() {
+		return false
+	}
+
+	switch a.syncMode() {
+	case types.SyncModeRealtimeSyncOff:
+		return false
+	case types.SyncModePushOnly:
+		return a.doc.HasLocalChanges()
+	case types.SyncModePullOnly:
+		return a.remoteChangeEventReceived.Load()
+	default:
+		return a.remoteChangeEventReceived.Load() || a.doc.HasLocalChanges()
+	}
+}
+
+// ChangeSyncMode changes the sync mode of the given document. The changes of
+// others are pulled in the next round of the sync loop if the new mode pulls.
+// The document attached in PullOnly mode can not be changed to the mode that
+// pushes changes.
+func (c *Client) ChangeSyncMode(doc *document.Document, mode types.SyncMode) error {
+	attachment, ok := c.attachments.Get(doc.Key())
+	if !ok {
+		return ErrDocumentNotAttached
+	}
+
+	if attachment.pullOnly && (mode == types.SyncModePushPull || mode == types.SyncModePushOnly) {
+		return ErrDocumentPullOnly
+	}
+
+	attachment.remoteChangeEventReceived.Store(true)
+	attachment.setSyncMode(mode)
+	return nil
+}
+
+// Pause pauses the sync loop of the given document. The document stays
+// attached and its local changes are kept until the sync loop is resumed.
+func (c *Client) Pause(doc *document.Document) error {
+	attachment, ok := c.attachments.Get(doc.Key())
+	if !ok {
+		return ErrDocumentNotAttached
+	}
+
+	attachment.isPaused.Store(true)
+	return nil
+}
+
+// Resume resumes the sync loop of the given document. The changes of others
+// made while paused are pulled in the next round of the sync loop.
+func (c *Client) Resume(doc *document.Document) error {
+	attachment, ok := c.attachments.Get(doc.Key())
+	if !ok {
+		return ErrDocumentNotAttached
+	}
+
+	attachment.remoteChangeEventReceived.Store(true)
+	attachment.isPaused.Store(false)
+	return nil
+}
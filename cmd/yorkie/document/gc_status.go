@@ -0,0 +1,191 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/units"
+)
+
+var (
+	flagCollect        bool
+	flagStaleThreshold string
+)
+
+func newGCStatusCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "gc-status [project name] [document key]",
+		Short:   "Show the garbage collection status of the document",
+		Example: "yorkie document gc-status sample-project sample-document --collect --stale-threshold 1h",
+		PreRunE: config.Preload,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			projectName := args[0]
+			documentKey := key.Key(args[1])
+
+			rpcAddr := viper.GetString("rpcAddr")
+			auth, err := config.LoadAuth(rpcAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(rpcAddr, admin.WithToken(auth.Token), admin.WithInsecure(auth.Insecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				cli.Close()
+			}()
+
+			ctx := context.Background()
+			output := viper.GetString("output")
+			if !flagCollect {
+				status, err := cli.GetDocumentGCStatus(ctx, projectName, documentKey)
+				if err != nil {
+					return err
+				}
+				return printGCStatus(cmd, output, status)
+			}
+
+			result, err := cli.CollectDocumentGarbage(ctx, projectName, documentKey, flagStaleThreshold)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				cmd.Printf("detached %d stale client(s), compacted: %t\n", len(result.DetachedClients), result.Compacted)
+				return printGCStatus(cmd, output, result.Status)
+			}
+			return printGCResult(cmd, output, result)
+		},
+	}
+}
+
+func printGCStatus(cmd *cobra.Command, output string, status *types.DocumentGCStatus) error {
+	switch output {
+	case "":
+		tw := newTableWriter()
+		tw.AppendHeader(table.Row{
+			"KEY",
+			"GARBAGE",
+			"LIVE DATA",
+			"LIVE META",
+			"GC DATA",
+			"GC META",
+			"ATTACHED",
+			"MIN VERSION VECTOR",
+		})
+		tw.AppendRow(table.Row{
+			status.Key,
+			status.GarbageLen,
+			units.HumanSize(float64(status.DocSize.Live.Data)),
+			units.HumanSize(float64(status.DocSize.Live.Meta)),
+			units.HumanSize(float64(status.DocSize.GC.Data)),
+			units.HumanSize(float64(status.DocSize.GC.Meta)),
+			status.AttachedClients,
+			status.MinVersionVector.Marshal(),
+		})
+		cmd.Printf("%s\n", tw.Render())
+
+		if len(status.Blockers) == 0 {
+			return nil
+		}
+
+		tw = newTableWriter()
+		tw.AppendHeader(table.Row{
+			"BLOCKER",
+			"UPDATED AT",
+			"VERSION VECTOR",
+		})
+		for _, blocker := range status.Blockers {
+			tw.AppendRow(table.Row{
+				blocker.ClientID,
+				blocker.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+				blocker.VersionVector.Marshal(),
+			})
+		}
+		cmd.Printf("\n%s\n", tw.Render())
+		return nil
+	}
+
+	return printStructured(cmd, output, status)
+}
+
+func printGCResult(cmd *cobra.Command, output string, result *types.DocumentGCResult) error {
+	return printStructured(cmd, output, result)
+}
+
+func printStructured(cmd *cobra.Command, output string, v any) error {
+	switch output {
+	case "json":
+		jsonOutput, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal JSON: %w", err)
+		}
+		cmd.Println(string(jsonOutput))
+	case "yaml":
+		yamlOutput, err := yaml.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("marshal YAML: %w", err)
+		}
+		cmd.Println(string(yamlOutput))
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+
+	return nil
+}
+
+func newTableWriter() table.Writer {
+	tw := table.NewWriter()
+	tw.Style().Options.DrawBorder = false
+	tw.Style().Options.SeparateColumns = false
+	tw.Style().Options.SeparateFooter = false
+	tw.Style().Options.SeparateHeader = false
+	tw.Style().Options.SeparateRows = false
+	return tw
+}
+
+func init() {
+	cmd := newGCStatusCommand()
+	cmd.Flags().BoolVar(
+		&flagCollect,
+		"collect",
+		false,
+		"Detach the stale clients from the document and compact it if no clients remain attached",
+	)
+	cmd.Flags().StringVar(
+		&flagStaleThreshold,
+		"stale-threshold",
+		"",
+		"The duration after which the attached clients are considered stale (default: client deactivate threshold)",
+	)
+	SubCmd.AddCommand(cmd)
+}
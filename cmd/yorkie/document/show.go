@@ -0,0 +1,160 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	flagAt string
+)
+
+func newShowCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "show [project name] [document key]",
+		Short:   "Show the document at the given revision",
+		Example: "yorkie document show sample-project sample-document --at 42",
+		PreRunE: config.Preload,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			projectName := args[0]
+			documentKey := key.Key(args[1])
+
+			serverSeq, at, err := parseRevision(flagAt)
+			if err != nil {
+				return err
+			}
+
+			rpcAddr := viper.GetString("rpcAddr")
+			auth, err := config.LoadAuth(rpcAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(rpcAddr, admin.WithToken(auth.Token), admin.WithInsecure(auth.Insecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				cli.Close()
+			}()
+
+			ctx := context.Background()
+			revision, err := cli.GetDocumentAtRevision(ctx, projectName, documentKey, serverSeq, at)
+			if err != nil {
+				return err
+			}
+
+			output := viper.GetString("output")
+			if err := printRevision(cmd, output, revision); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+// parseRevision parses the given revision which is either a server sequence
+// or a time in RFC3339 format. If the revision is empty, a negative server
+// sequence is returned to show the latest revision.
+func parseRevision(revision string) (int64, time.Time, error) {
+	if revision == "" {
+		return -1, time.Time{}, nil
+	}
+
+	if serverSeq, err := strconv.ParseInt(revision, 10, 64); err == nil {
+		if serverSeq < 0 {
+			return 0, time.Time{}, fmt.Errorf("invalid server sequence: %d", serverSeq)
+		}
+		return serverSeq, time.Time{}, nil
+	}
+
+	at, err := time.Parse(time.RFC3339, revision)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("invalid revision %q: must be a server sequence or RFC3339 time", revision)
+	}
+
+	return 0, at, nil
+}
+
+func printRevision(cmd *cobra.Command, output string, revision *types.DocumentRevision) error {
+	switch output {
+	case "":
+		tw := table.NewWriter()
+		tw.Style().Options.DrawBorder = false
+		tw.Style().Options.SeparateColumns = false
+		tw.Style().Options.SeparateFooter = false
+		tw.Style().Options.SeparateHeader = false
+		tw.Style().Options.SeparateRows = false
+		tw.AppendHeader(table.Row{
+			"KEY",
+			"SEQ",
+			"ROOT",
+		})
+		tw.AppendRow(table.Row{
+			revision.Key,
+			revision.ServerSeq,
+			revision.Root,
+		})
+		cmd.Printf("%s\n", tw.Render())
+	case "json":
+		jsonOutput, err := json.MarshalIndent(revision, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal JSON: %w", err)
+		}
+		cmd.Println(string(jsonOutput))
+	case "yaml":
+		yamlOutput, err := yaml.Marshal(revision)
+		if err != nil {
+			return fmt.Errorf("marshal YAML: %w", err)
+		}
+		cmd.Println(string(yamlOutput))
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+
+	return nil
+}
+
+func init() {
+	cmd := newShowCommand()
+	cmd.Flags().StringVar(
+		&flagAt,
+		"at",
+		"",
+		"The revision to show, a server sequence or a time in RFC3339 format (default: latest)",
+	)
+	SubCmd.AddCommand(cmd)
+}
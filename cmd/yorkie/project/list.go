@@ -91,6 +91,8 @@ func printProjects(cmd *cobra.Command, output string, projects []*types.Project)
 			"CLIENT DEACTIVATE THRESHOLD",
 			"MAX SUBSCRIBERS PER DOCUMENT",
 			"MAX ATTACHMENTS PER DOCUMENT",
+			"MAX DOCUMENT SIZE",
+			"MAX CHANGE SIZE",
 			"CREATED AT",
 		})
 		for _, project := range projects {
@@ -105,6 +107,8 @@ func printProjects(cmd *cobra.Command, output string, projects []*types.Project)
 				project.ClientDeactivateThreshold,
 				project.MaxSubscribersPerDocument,
 				project.MaxAttachmentsPerDocument,
+				project.MaxDocumentSize,
+				project.MaxChangeSize,
 				units.HumanDuration(time.Now().UTC().Sub(project.CreatedAt)),
 			})
 		}
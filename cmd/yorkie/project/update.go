@@ -21,6 +21,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
@@ -44,6 +46,9 @@ var (
 	flagClientDeactivateThreshold string
 	flagMaxSubscribersPerDocument int
 	flagMaxAttachmentsPerDocument int
+	flagMaxDocumentSize           int
+	flagMaxChangeSize             int
+	flagSchemaFile                string
 )
 
 var allAuthWebhookMethods = []string{
@@ -138,6 +143,28 @@ func newUpdateCommand() *cobra.Command {
 				newMaxAttachmentsPerDocument = flagMaxAttachmentsPerDocument
 			}
 
+			newMaxDocumentSize := project.MaxDocumentSize
+			if cmd.Flags().Lookup("max-document-size").Changed { // allow zero
+				newMaxDocumentSize = flagMaxDocumentSize
+			}
+
+			newMaxChangeSize := project.MaxChangeSize
+			if cmd.Flags().Lookup("max-change-size").Changed { // allow zero
+				newMaxChangeSize = flagMaxChangeSize
+			}
+
+			newSchema := project.Schema
+			if cmd.Flags().Lookup("schema-file").Changed { // allow empty string
+				newSchema = ""
+				if flagSchemaFile != "" {
+					data, err := os.ReadFile(filepath.Clean(flagSchemaFile))
+					if err != nil {
+						return fmt.Errorf("read schema file: %w", err)
+					}
+					newSchema = string(data)
+				}
+			}
+
 			updatableProjectFields := &types.UpdatableProjectFields{
 				Name:                      &newName,
 				AuthWebhookURL:            &newAuthWebhookURL,
@@ -147,6 +174,9 @@ func newUpdateCommand() *cobra.Command {
 				ClientDeactivateThreshold: &newClientDeactivateThreshold,
 				MaxSubscribersPerDocument: &newMaxSubscribersPerDocument,
 				MaxAttachmentsPerDocument: &newMaxAttachmentsPerDocument,
+				MaxDocumentSize:           &newMaxDocumentSize,
+				MaxChangeSize:             &newMaxChangeSize,
+				Schema:                    &newSchema,
 			}
 
 			updated, err := cli.UpdateProject(ctx, id, updatableProjectFields)
@@ -305,5 +335,23 @@ func init() {
 		0,
 		"max attachments per document",
 	)
+	cmd.Flags().IntVar(
+		&flagMaxDocumentSize,
+		"max-document-size",
+		0,
+		"max size of a document in bytes (0 for no limit)",
+	)
+	cmd.Flags().IntVar(
+		&flagMaxChangeSize,
+		"max-change-size",
+		0,
+		"max size in bytes that a single change can add to a document (0 for no limit)",
+	)
+	cmd.Flags().StringVar(
+		&flagSchemaFile,
+		"schema-file",
+		"",
+		"path to the schema file of documents (empty to remove the schema)",
+	)
 	SubCmd.AddCommand(cmd)
 }
@@ -471,6 +471,12 @@ func init() {
 		server.DefaultProjectCacheTTL,
 		"TTL value to set when caching project info.",
 	)
+	cmd.Flags().IntVar(
+		&conf.Backend.DocumentCacheSize,
+		"document-cache-size",
+		server.DefaultDocumentCacheSize,
+		"The cache size of the documents built to validate the pushed changes.",
+	)
 	cmd.Flags().StringVar(
 		&conf.Backend.Hostname,
 		"hostname",
@@ -0,0 +1,689 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/yson"
+)
+
+var (
+	// ErrUnsupportedBindingType is returned when the given type cannot be
+	// bound to the document.
+	ErrUnsupportedBindingType = errors.New("unsupported binding type")
+
+	// ErrBindingTypeMismatch is returned when the element of the document
+	// does not match the type of the bound field.
+	ErrBindingTypeMismatch = errors.New("binding type mismatch")
+)
+
+// bindingTag is the struct tag that specifies the key of the bound field.
+const bindingTag = "yorkie"
+
+var (
+	textType    = reflect.TypeOf((*json.Text)(nil))
+	counterType = reflect.TypeOf((*json.Counter)(nil))
+	treeType    = reflect.TypeOf((*json.Tree)(nil))
+	timeType    = reflect.TypeOf(gotime.Time{})
+	bytesType   = reflect.TypeOf([]byte(nil))
+)
+
+// TypedDocument is a document whose root is bound to the struct T.
+//
+// The fields of T tagged with `yorkie:"key"` are bound to the members of the
+// root. The following types of fields are supported:
+//   - bool, string, signed integers, floats, []byte and time.Time as primitives
+//   - structs and maps with string keys as objects
+//   - slices as arrays
+//   - *json.Text, *json.Counter and *json.Tree as the proxies of the elements
+//   - pointers to the types above, which are nil if the member is absent
+//
+// The proxies are bound to the elements of the document, so they are edited
+// directly in UpdateTyped. To create a new element, assign a proxy created by
+// json.NewText, json.NewCounter or json.NewTree to the field.
+type TypedDocument[T any] struct {
+	*Document
+}
+
+// Bind binds the root of the given document to the struct T.
+func Bind[T any](doc *Document) (*TypedDocument[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("root should be a struct, got %s: %w", t, ErrUnsupportedBindingType)
+	}
+	if err := checkBindingType(t, make(map[reflect.Type]bool)); err != nil {
+		return nil, err
+	}
+
+	return &TypedDocument[T]{Document: doc}, nil
+}
+
+// Value returns the root of the document as T. The proxies of the returned
+// value are read-only; edit them in UpdateTyped instead.
+func (d *TypedDocument[T]) Value() (*T, error) {
+	// NOTE: The clone is created and decoded while holding the lock, so that
+	// it is not changed by the synchronization at the same time.
+	defer d.lock()()
+
+	obj, err := d.root()
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(T)
+	if err := decodeStruct(obj, "$", reflect.ValueOf(root).Elem()); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// UpdateTyped executes the given updater with the root of the document as T,
+// and applies the mutations of the root to the document with the minimal
+// operations.
+func (d *TypedDocument[T]) UpdateTyped(updater func(root *T) error, msgAndArgs ...interface{}) error {
+	return d.Update(func(root *json.Object, _ *presence.Presence) error {
+		prev, curr := new(T), new(T)
+		if err := decodeStruct(root, "$", reflect.ValueOf(prev).Elem()); err != nil {
+			return err
+		}
+		if err := decodeStruct(root, "$", reflect.ValueOf(curr).Elem()); err != nil {
+			return err
+		}
+
+		if err := updater(curr); err != nil {
+			return err
+		}
+
+		return diffStruct(root, "$", reflect.ValueOf(prev).Elem(), reflect.ValueOf(curr).Elem())
+	}, msgAndArgs...)
+}
+
+// field is a bound field of a struct.
+type field struct {
+	index int
+	key   string
+}
+
+// fieldsOf returns the bound fields of the given struct type.
+func fieldsOf(t reflect.Type) []field {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		key, _, _ := strings.Cut(f.Tag.Get(bindingTag), ",")
+		if !f.IsExported() || key == "" || key == "-" {
+			continue
+		}
+		fields = append(fields, field{index: i, key: key})
+	}
+
+	return fields
+}
+
+// checkBindingType checks whether the given type can be bound to the
+// document.
+func checkBindingType(t reflect.Type, visited map[reflect.Type]bool) error {
+	if visited[t] {
+		return nil
+	}
+	visited[t] = true
+
+	switch t {
+	case textType, counterType, treeType, timeType, bytesType:
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return nil
+	case reflect.Ptr, reflect.Slice:
+		return checkBindingType(t.Elem(), visited)
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return fmt.Errorf("map key of %s: %w", t, ErrUnsupportedBindingType)
+		}
+		return checkBindingType(t.Elem(), visited)
+	case reflect.Struct:
+		for _, f := range fieldsOf(t) {
+			if err := checkBindingType(t.Field(f.index).Type, visited); err != nil {
+				return fmt.Errorf("%s.%s: %w", t, t.Field(f.index).Name, err)
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%s: %w", t, ErrUnsupportedBindingType)
+}
+
+// slot is the place of an element in the document, which is a key of an
+// object or an index of an array.
+type slot interface {
+	// path returns the path of the slot from the root.
+	path() string
+
+	// get returns the element of the slot. It returns nil if it is absent.
+	get() crdt.Element
+
+	object() *json.Object
+	array() *json.Array
+	text() *json.Text
+	counter() *json.Counter
+	tree() *json.Tree
+
+	// set sets the given YSON to the slot.
+	set(value interface{})
+
+	// clear clears the slot. The member of an object is deleted, and the
+	// element of an array is replaced with null to keep the indexes.
+	clear()
+}
+
+type objectSlot struct {
+	obj    *json.Object
+	key    string
+	parent string
+}
+
+func (s objectSlot) path() string           { return s.parent + "." + s.key }
+func (s objectSlot) get() crdt.Element      { return s.obj.Get(s.key) }
+func (s objectSlot) object() *json.Object   { return s.obj.GetObject(s.key) }
+func (s objectSlot) array() *json.Array     { return s.obj.GetArray(s.key) }
+func (s objectSlot) text() *json.Text       { return s.obj.GetText(s.key) }
+func (s objectSlot) counter() *json.Counter { return s.obj.GetCounter(s.key) }
+func (s objectSlot) tree() *json.Tree       { return s.obj.GetTree(s.key) }
+func (s objectSlot) set(value interface{})  { s.obj.SetYSONElement(s.key, value) }
+func (s objectSlot) clear()                 { s.obj.Delete(s.key) }
+
+type arraySlot struct {
+	arr    *json.Array
+	index  int
+	parent string
+}
+
+func (s arraySlot) path() string           { return fmt.Sprintf("%s.%d", s.parent, s.index) }
+func (s arraySlot) get() crdt.Element      { return s.arr.Get(s.index) }
+func (s arraySlot) object() *json.Object   { return s.arr.GetObject(s.index) }
+func (s arraySlot) array() *json.Array     { return s.arr.GetArray(s.index) }
+func (s arraySlot) text() *json.Text       { return s.arr.GetText(s.index) }
+func (s arraySlot) counter() *json.Counter { return s.arr.GetCounter(s.index) }
+func (s arraySlot) tree() *json.Tree       { return s.arr.GetTree(s.index) }
+func (s arraySlot) clear()                 { s.set(nil) }
+
+func (s arraySlot) set(value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		s.arr.SetNull(s.index)
+	case bool:
+		s.arr.SetBool(s.index, v)
+	case int32:
+		s.arr.SetInteger(s.index, int(v))
+	case int64:
+		s.arr.SetLong(s.index, v)
+	case float64:
+		s.arr.SetDouble(s.index, v)
+	case string:
+		s.arr.SetString(s.index, v)
+	case []byte:
+		s.arr.SetBytes(s.index, v)
+	case gotime.Time:
+		s.arr.SetDate(s.index, v)
+	default:
+		s.arr.Splice(s.index, 1, v)
+	}
+}
+
+// decodeStruct decodes the given object into the given struct value.
+func decodeStruct(obj *json.Object, path string, v reflect.Value) error {
+	for _, f := range fieldsOf(v.Type()) {
+		if err := decode(objectSlot{obj: obj, key: f.key, parent: path}, v.Field(f.index)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decode decodes the element of the given slot into the given value. The
+// value is left as it is if the element is absent or null.
+func decode(s slot, v reflect.Value) error {
+	elem := s.get()
+	if elem == nil || isNull(elem) {
+		return nil
+	}
+
+	t := v.Type()
+	switch t {
+	case textType, counterType, treeType:
+		return decodeProxy(s, elem, v)
+	case timeType, bytesType:
+		return decodePrimitive(s.path(), elem, v)
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		v.Set(reflect.New(t.Elem()))
+		return decode(s, v.Elem())
+	case reflect.Struct:
+		if _, ok := elem.(*crdt.Object); !ok {
+			return mismatch(s.path(), elem, t)
+		}
+		return decodeStruct(s.object(), s.path(), v)
+	case reflect.Map:
+		if _, ok := elem.(*crdt.Object); !ok {
+			return mismatch(s.path(), elem, t)
+		}
+		obj := s.object()
+		m := reflect.MakeMap(t)
+		for key := range obj.Members() {
+			value := reflect.New(t.Elem()).Elem()
+			if err := decode(objectSlot{obj: obj, key: key, parent: s.path()}, value); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(key).Convert(t.Key()), value)
+		}
+		v.Set(m)
+		return nil
+	case reflect.Slice:
+		if _, ok := elem.(*crdt.Array); !ok {
+			return mismatch(s.path(), elem, t)
+		}
+		arr := s.array()
+		slice := reflect.MakeSlice(t, arr.Len(), arr.Len())
+		for i := 0; i < arr.Len(); i++ {
+			if err := decode(arraySlot{arr: arr, index: i, parent: s.path()}, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	}
+
+	return decodePrimitive(s.path(), elem, v)
+}
+
+// decodeProxy sets the proxy of the element of the given slot to the given
+// value.
+func decodeProxy(s slot, elem crdt.Element, v reflect.Value) error {
+	switch v.Type() {
+	case textType:
+		if _, ok := elem.(*crdt.Text); !ok {
+			return mismatch(s.path(), elem, v.Type())
+		}
+		v.Set(reflect.ValueOf(s.text()))
+	case counterType:
+		if _, ok := elem.(*crdt.Counter); !ok {
+			return mismatch(s.path(), elem, v.Type())
+		}
+		v.Set(reflect.ValueOf(s.counter()))
+	case treeType:
+		if _, ok := elem.(*crdt.Tree); !ok {
+			return mismatch(s.path(), elem, v.Type())
+		}
+		v.Set(reflect.ValueOf(s.tree()))
+	}
+
+	return nil
+}
+
+// decodePrimitive decodes the given primitive element into the given value.
+func decodePrimitive(path string, elem crdt.Element, v reflect.Value) error {
+	primitive, ok := elem.(*crdt.Primitive)
+	if !ok {
+		return mismatch(path, elem, v.Type())
+	}
+
+	switch value := primitive.Value().(type) {
+	case bool:
+		if v.Kind() == reflect.Bool {
+			v.SetBool(value)
+			return nil
+		}
+	case int32:
+		return decodeNumber(path, elem, int64(value), v)
+	case int64:
+		return decodeNumber(path, elem, value, v)
+	case float64:
+		if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+			v.SetFloat(value)
+			return nil
+		}
+	case string:
+		if v.Kind() == reflect.String {
+			v.SetString(value)
+			return nil
+		}
+	case []byte:
+		if v.Type() == bytesType {
+			v.SetBytes(value)
+			return nil
+		}
+	case gotime.Time:
+		if v.Type() == timeType {
+			v.Set(reflect.ValueOf(value))
+			return nil
+		}
+	}
+
+	return mismatch(path, elem, v.Type())
+}
+
+// decodeNumber decodes the given integer into the given numeric value.
+func decodeNumber(path string, elem crdt.Element, n int64, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.OverflowInt(n) {
+			return fmt.Errorf("%s: %d overflows %s: %w", path, n, v.Type(), ErrBindingTypeMismatch)
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(n))
+		return nil
+	}
+
+	return mismatch(path, elem, v.Type())
+}
+
+// diffStruct applies the differences between the given struct values to the
+// given object.
+func diffStruct(obj *json.Object, path string, prev, curr reflect.Value) error {
+	for _, f := range fieldsOf(curr.Type()) {
+		s := objectSlot{obj: obj, key: f.key, parent: path}
+		if err := diff(s, prev.Field(f.index), curr.Field(f.index)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diff applies the difference between the given values to the given slot.
+// The nested objects and arrays are updated in place if they exist in the
+// document, and the others are replaced with the new values.
+func diff(s slot, prev, curr reflect.Value) error {
+	t := curr.Type()
+	switch t {
+	case textType, counterType, treeType:
+		return diffProxy(s, prev, curr)
+	case timeType, bytesType:
+		return diffValue(s, prev, curr)
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		if curr.IsNil() {
+			if !prev.IsNil() {
+				s.clear()
+			}
+			return nil
+		}
+		if prev.IsNil() {
+			return replace(s, curr)
+		}
+		return diff(s, prev.Elem(), curr.Elem())
+	case reflect.Struct:
+		if _, ok := s.get().(*crdt.Object); !ok {
+			return diffValue(s, prev, curr)
+		}
+		return diffStruct(s.object(), s.path(), prev, curr)
+	case reflect.Map:
+		if _, ok := s.get().(*crdt.Object); !ok || curr.IsNil() {
+			return diffValue(s, prev, curr)
+		}
+		return diffMap(s.object(), s.path(), prev, curr)
+	case reflect.Slice:
+		if _, ok := s.get().(*crdt.Array); !ok || curr.IsNil() {
+			return diffValue(s, prev, curr)
+		}
+		return diffSlice(s.array(), s.path(), prev, curr)
+	}
+
+	return diffValue(s, prev, curr)
+}
+
+// diffValue replaces the element of the given slot with the current value if
+// it differs from the previous one.
+func diffValue(s slot, prev, curr reflect.Value) error {
+	if reflect.DeepEqual(prev.Interface(), curr.Interface()) {
+		return nil
+	}
+	if isNil(curr) {
+		s.clear()
+		return nil
+	}
+
+	return replace(s, curr)
+}
+
+// diffProxy applies the difference between the given proxies to the given
+// slot. The proxies are compared by their elements because their edits are
+// already applied to the document.
+func diffProxy(s slot, prev, curr reflect.Value) error {
+	if curr.IsNil() {
+		if !prev.IsNil() {
+			s.clear()
+		}
+		return nil
+	}
+
+	currElem := curr.Interface().(crdt.Element)
+	if !prev.IsNil() && originalOf(prev.Interface().(crdt.Element)) == originalOf(currElem) {
+		return nil
+	}
+
+	return replace(s, curr)
+}
+
+// diffMap applies the difference between the given maps to the given object.
+func diffMap(obj *json.Object, path string, prev, curr reflect.Value) error {
+	for _, key := range prev.MapKeys() {
+		if !curr.MapIndex(key).IsValid() {
+			obj.Delete(key.String())
+		}
+	}
+
+	for _, key := range curr.MapKeys() {
+		s := objectSlot{obj: obj, key: key.String(), parent: path}
+		prevValue := prev.MapIndex(key)
+		if !prevValue.IsValid() {
+			prevValue = reflect.Zero(curr.Type().Elem())
+		}
+		if err := diff(s, prevValue, curr.MapIndex(key)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffSlice applies the difference between the given slices to the given
+// array. The common prefix and suffix are kept, the elements in the middle
+// are updated in place, and the rest are inserted or deleted.
+func diffSlice(arr *json.Array, path string, prev, curr reflect.Value) error {
+	start := 0
+	for start < prev.Len() && start < curr.Len() &&
+		reflect.DeepEqual(prev.Index(start).Interface(), curr.Index(start).Interface()) {
+		start++
+	}
+
+	prevEnd, currEnd := prev.Len(), curr.Len()
+	for prevEnd > start && currEnd > start &&
+		reflect.DeepEqual(prev.Index(prevEnd-1).Interface(), curr.Index(currEnd-1).Interface()) {
+		prevEnd--
+		currEnd--
+	}
+
+	common := min(prevEnd, currEnd) - start
+	for i := start; i < start+common; i++ {
+		if err := diff(arraySlot{arr: arr, index: i, parent: path}, prev.Index(i), curr.Index(i)); err != nil {
+			return err
+		}
+	}
+
+	index := start + common
+	if prevEnd > currEnd {
+		arr.Splice(index, prevEnd-currEnd)
+		return nil
+	}
+
+	var values []interface{}
+	for i := index; i < currEnd; i++ {
+		value, err := toYSON(curr.Index(i))
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+	if len(values) > 0 {
+		arr.Splice(index, 0, values...)
+	}
+
+	return nil
+}
+
+// replace replaces the element of the given slot with the given value.
+func replace(s slot, v reflect.Value) error {
+	value, err := toYSON(v)
+	if err != nil {
+		return err
+	}
+
+	s.set(value)
+	return nil
+}
+
+// toYSON converts the given value into YSON. It returns nil for nil values.
+func toYSON(v reflect.Value) (interface{}, error) {
+	if isNil(v) {
+		return nil, nil
+	}
+
+	t := v.Type()
+	switch t {
+	case textType, counterType, treeType:
+		return json.ToYSON(v.Interface().(crdt.Element))
+	case timeType, bytesType:
+		return v.Interface(), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return toYSON(v.Elem())
+	case reflect.Struct:
+		obj := make(map[string]interface{})
+		for _, f := range fieldsOf(t) {
+			if isNil(v.Field(f.index)) {
+				continue
+			}
+			value, err := toYSON(v.Field(f.index))
+			if err != nil {
+				return nil, err
+			}
+			obj[f.key] = value
+		}
+		return yson.Object(obj), nil
+	case reflect.Map:
+		obj := make(map[string]interface{})
+		for _, key := range v.MapKeys() {
+			value, err := toYSON(v.MapIndex(key))
+			if err != nil {
+				return nil, err
+			}
+			obj[key.String()] = value
+		}
+		return yson.Object(obj), nil
+	case reflect.Slice:
+		arr := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			value, err := toYSON(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, value)
+		}
+		return yson.Array(arr), nil
+	}
+
+	return toPrimitive(v)
+}
+
+// toPrimitive converts the given value into the primitive value of YSON.
+func toPrimitive(v reflect.Value) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		n := v.Int()
+		if n < math.MinInt32 || n > math.MaxInt32 {
+			return n, nil
+		}
+		return int32(n), nil
+	case reflect.Int64:
+		return v.Int(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.String:
+		return v.String(), nil
+	}
+
+	return nil, fmt.Errorf("%s: %w", v.Type(), ErrUnsupportedBindingType)
+}
+
+// isNil returns whether the given value is nil.
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+// isNull returns whether the given element is a null primitive.
+func isNull(elem crdt.Element) bool {
+	primitive, ok := elem.(*crdt.Primitive)
+	return ok && primitive.ValueType() == crdt.Null
+}
+
+// originalOf returns the CRDT element of the given proxy.
+func originalOf(elem crdt.Element) crdt.Element {
+	switch p := elem.(type) {
+	case *json.Text:
+		return p.Text
+	case *json.Counter:
+		return p.Counter
+	case *json.Tree:
+		return p.Tree
+	}
+
+	return elem
+}
+
+// mismatch returns the error that the given element does not match the type.
+func mismatch(path string, elem crdt.Element, t reflect.Type) error {
+	return fmt.Errorf("%s: cannot bind %T to %s: %w", path, elem, t, ErrBindingTypeMismatch)
+}
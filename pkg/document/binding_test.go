@@ -0,0 +1,180 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+)
+
+type todo struct {
+	Text string `yorkie:"text"`
+	Done bool   `yorkie:"done"`
+}
+
+type board struct {
+	Title   string            `yorkie:"title"`
+	Content *json.Text        `yorkie:"content"`
+	Views   *json.Counter     `yorkie:"views"`
+	Todos   []todo            `yorkie:"todos"`
+	Tags    map[string]string `yorkie:"tags"`
+	Owner   *todo             `yorkie:"owner"`
+	Matrix  [][]int           `yorkie:"matrix"`
+	Local   string
+}
+
+func TestBinding(t *testing.T) {
+	t.Run("bind unsupported type test", func(t *testing.T) {
+		_, err := document.Bind[struct {
+			Count uint `yorkie:"count"`
+		}](document.New("d1"))
+		assert.ErrorIs(t, err, document.ErrUnsupportedBindingType)
+
+		_, err = document.Bind[[]int](document.New("d1"))
+		assert.ErrorIs(t, err, document.ErrUnsupportedBindingType)
+	})
+
+	t.Run("value test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "board")
+			root.SetNewText("content").Edit(0, 0, "hello")
+			root.SetNewCounter("views", crdt.IntegerCnt, 3)
+			root.SetNewArray("todos").AddNewObject().SetString("text", "a").SetBool("done", true)
+			root.SetNewObject("tags").SetString("k", "v")
+			root.SetNull("owner")
+			root.SetNewArray("matrix").AddNewArray().AddInteger(1, 2)
+			return nil
+		}))
+
+		b, err := document.Bind[board](doc)
+		assert.NoError(t, err)
+		value, err := b.Value()
+		assert.NoError(t, err)
+
+		assert.Equal(t, "board", value.Title)
+		assert.Equal(t, "hello", value.Content.String())
+		assert.Equal(t, int32(3), value.Views.Value())
+		assert.Equal(t, []todo{{Text: "a", Done: true}}, value.Todos)
+		assert.Equal(t, map[string]string{"k": "v"}, value.Tags)
+		assert.Nil(t, value.Owner)
+		assert.Equal(t, [][]int{{1, 2}}, value.Matrix)
+	})
+
+	t.Run("type mismatch test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("todos").AddInteger(1)
+			return nil
+		}))
+
+		b, err := document.Bind[board](doc)
+		assert.NoError(t, err)
+		_, err = b.Value()
+		assert.ErrorIs(t, err, document.ErrBindingTypeMismatch)
+		assert.ErrorContains(t, err, "$.todos.0")
+	})
+
+	t.Run("update typed test", func(t *testing.T) {
+		doc := document.New("d1")
+		b, err := document.Bind[board](doc)
+		assert.NoError(t, err)
+
+		assert.NoError(t, b.UpdateTyped(func(root *board) error {
+			root.Title = "board"
+			root.Content = json.NewText()
+			root.Views = json.NewCounter(0, crdt.IntegerCnt)
+			root.Todos = []todo{{Text: "a"}, {Text: "b"}}
+			root.Local = "ignored"
+			return nil
+		}))
+		assert.Equal(
+			t,
+			`{"content":[],"title":"board","todos":[{"done":false,"text":"a"},{"done":false,"text":"b"}],"views":0}`,
+			doc.Marshal(),
+		)
+
+		assert.NoError(t, b.UpdateTyped(func(root *board) error {
+			root.Content.Edit(0, 0, "hello")
+			root.Views.Increase(2)
+			root.Todos[1].Done = true
+			root.Todos = append(root.Todos, todo{Text: "c"})
+			root.Tags = map[string]string{"k": "v"}
+			root.Owner = &todo{Text: "me"}
+			return nil
+		}))
+		assert.Equal(t, `{"content":[{"val":"hello"}],"owner":{"done":false,"text":"me"},`+
+			`"tags":{"k":"v"},"title":"board","todos":[{"done":false,"text":"a"},`+
+			`{"done":true,"text":"b"},{"done":false,"text":"c"}],"views":2}`, doc.Marshal())
+
+		assert.NoError(t, b.UpdateTyped(func(root *board) error {
+			root.Todos = root.Todos[1:]
+			root.Owner = nil
+			delete(root.Tags, "k")
+			return nil
+		}))
+		assert.Equal(t, `{"content":[{"val":"hello"}],"tags":{},"title":"board",`+
+			`"todos":[{"done":true,"text":"b"},{"done":false,"text":"c"}],"views":2}`, doc.Marshal())
+	})
+
+	t.Run("minimal operations test", func(t *testing.T) {
+		doc := document.New("d1")
+		b, err := document.Bind[board](doc)
+		assert.NoError(t, err)
+		assert.NoError(t, b.UpdateTyped(func(root *board) error {
+			root.Title = "board"
+			root.Todos = []todo{{Text: "a"}, {Text: "b"}, {Text: "c"}}
+			return nil
+		}))
+
+		var infos []document.OpInfo
+		doc.Subscribe("$", func(e document.DocEvent) {
+			infos = append(infos, e.Operations...)
+		})
+
+		// 01. Nothing is changed.
+		assert.NoError(t, b.UpdateTyped(func(root *board) error {
+			return nil
+		}))
+		assert.Len(t, infos, 0)
+
+		// 02. Only the changed member of the element in the middle is set.
+		assert.NoError(t, b.UpdateTyped(func(root *board) error {
+			root.Todos[1].Done = true
+			return nil
+		}))
+		assert.Len(t, infos, 1)
+		assert.Equal(t, document.OpInfo{Type: document.OpSet, Path: "$.todos[1]", Key: "done"}, infos[0])
+
+		// 03. Only the removed element is deleted.
+		infos = nil
+		assert.NoError(t, b.UpdateTyped(func(root *board) error {
+			root.Todos = append(root.Todos[:1], root.Todos[2:]...)
+			return nil
+		}))
+		assert.Len(t, infos, 1)
+		assert.Equal(t, document.OpRemove, infos[0].Type)
+		assert.Equal(t, "$.todos", infos[0].Path)
+		assert.Equal(t, 1, infos[0].From)
+	})
+}
@@ -52,10 +52,38 @@ func New(id ID, message string, operations []operations.Operation, pc *innerpres
 
 // Execute applies this change to the given JSON root.
 func (c *Change) Execute(root *crdt.Root, presences *innerpresence.Map) error {
+	return c.ExecuteWithHook(root, presences, nil)
+}
+
+// ExecuteWithHook applies this change to the given JSON root like Execute.
+// The given hook is called right before each operation is executed, so that
+// the caller can capture the state which the operation is about to change.
+// The function returned by the hook, if any, is called right after the
+// operation is executed.
+func (c *Change) ExecuteWithHook(
+	root *crdt.Root,
+	presences *innerpresence.Map,
+	hook func(op operations.Operation) (func() error, error),
+) error {
 	for _, op := range c.operations {
+		var after func() error
+		if hook != nil {
+			var err error
+			if after, err = hook(op); err != nil {
+				return err
+			}
+		}
+
 		if err := op.Execute(root, c.ID().versionVector); err != nil {
 			return err
 		}
+		markUpdated(root, op)
+
+		if after != nil {
+			if err := after(); err != nil {
+				return err
+			}
+		}
 	}
 
 	if c.presenceChange != nil {
@@ -112,3 +140,19 @@ func (c *Change) PresenceChange() *innerpresence.Change {
 func (c *Change) AfterOrEqual(other *Change) bool {
 	return c.id.AfterOrEqual(other.id)
 }
+
+// markUpdated marks the elements updated by the given operation, so that the
+// root measures their sizes again.
+func markUpdated(root *crdt.Root, op operations.Operation) {
+	if root == nil {
+		return
+	}
+
+	root.MarkUpdated(op.ParentCreatedAt())
+
+	// NOTE: The operations that move or remove an element also update the
+	// metadata of the element itself.
+	if target, ok := op.(interface{ CreatedAt() *time.Ticket }); ok {
+		root.MarkUpdated(target.CreatedAt())
+	}
+}
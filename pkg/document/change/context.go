@@ -63,6 +63,12 @@ func (c *Context) NextID() ID {
 
 // ToChange creates a new change of this context.
 func (c *Context) ToChange() *Change {
+	// NOTE: The operations pushed to this context have been executed on the
+	// root by the proxies, so the elements updated by them are measured here.
+	for _, op := range c.operations {
+		markUpdated(c.root, op)
+	}
+
 	id := c.nextID
 
 	// NOTE(hackerwins): If this context was created only for presence change,
@@ -80,6 +86,11 @@ func (c *Context) HasChange() bool {
 	return len(c.operations) > 0 || c.presenceChange != nil
 }
 
+// ActorID returns the actor ID of the change of this context.
+func (c *Context) ActorID() time.ActorID {
+	return c.nextID.ActorID()
+}
+
 // IssueTimeTicket creates a time ticket to be used to create a new operation.
 func (c *Context) IssueTimeTicket() *time.Ticket {
 	c.delimiter++
@@ -91,9 +102,9 @@ func (c *Context) Push(op operations.Operation) {
 	c.operations = append(c.operations, op)
 }
 
-// RegisterElement registers the given element to the root.
-func (c *Context) RegisterElement(elem crdt.Element) {
-	c.root.RegisterElement(elem)
+// RegisterElement registers the given element and its parent to the root.
+func (c *Context) RegisterElement(parent crdt.Container, elem crdt.Element) {
+	c.root.RegisterElement(parent, elem)
 }
 
 // RegisterRemovedElementPair registers the given element pair to hash table.
@@ -111,6 +122,11 @@ func (c *Context) LastTimeTicket() *time.Ticket {
 	return c.nextID.NewTimeTicket(c.delimiter)
 }
 
+// PresenceChange returns the presence change of the user who made the change.
+func (c *Context) PresenceChange() *innerpresence.Change {
+	return c.presenceChange
+}
+
 // SetPresenceChange sets the presence change of the user who made the change.
 func (c *Context) SetPresenceChange(presenceChange innerpresence.Change) {
 	c.presenceChange = &presenceChange
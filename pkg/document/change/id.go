@@ -152,10 +152,10 @@ func (id ID) SetVersionVector(vector time.VersionVector) ID {
 	return NewID(id.clientSeq, id.serverSeq, id.lamport, id.actorID, vector)
 }
 
-// SetActor sets actorID.
+// SetActor sets actorID. The version of the previous actor in the version
+// vector is handed over to the given actor.
 func (id ID) SetActor(actor time.ActorID) ID {
-	// TODO(hackerwins): We need to update version vector as well.
-	return NewID(id.clientSeq, InitialServerSeq, id.lamport, actor, id.versionVector)
+	return NewID(id.clientSeq, InitialServerSeq, id.lamport, actor, id.versionVector.Rename(id.actorID, actor))
 }
 
 // SetServerSeq sets server sequence of this ID.
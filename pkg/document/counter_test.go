@@ -0,0 +1,251 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestCounterReset(t *testing.T) {
+	setup := func(t *testing.T) (*document.Document, *document.Document) {
+		d1, d2 := document.New("d1"), document.New("d1")
+		d1.SetActor(time.ActorID{1})
+		d2.SetActor(time.ActorID{2})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewCounter("cnt", crdt.LongCnt, 10).Increase(5)
+			return nil
+		}))
+		sync(t, d1, d2)
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Increase(3)
+			return nil
+		}))
+		sync(t, d2, d1)
+		return d1, d2
+	}
+
+	t.Run("reset test", func(t *testing.T) {
+		d1, d2 := setup(t)
+		assert.Equal(t, `{"cnt":18}`, d1.Marshal())
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Reset().Increase(2)
+			return nil
+		}))
+		assert.Equal(t, `{"cnt":2}`, d1.Marshal())
+
+		sync(t, d1, d2)
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("concurrent increase survives reset test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Reset()
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Increase(4)
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t, `{"cnt":4}`, d1.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("concurrent reset test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Reset()
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Increase(1).Reset()
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t, `{"cnt":0}`, d1.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("undo reset test", func(t *testing.T) {
+		d1, _ := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Reset()
+			return nil
+		}))
+		assert.Equal(t, `{"cnt":0}`, d1.Marshal())
+
+		assert.NoError(t, d1.History().Undo())
+		assert.Equal(t, `{"cnt":18}`, d1.Marshal())
+	})
+
+	t.Run("snapshot test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Reset().Increase(2)
+			return nil
+		}))
+		sync(t, d1, d2)
+
+		bytes, err := converter.ObjectToBytes(d1.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, d1.Marshal(), obj.Marshal())
+
+		// NOTE: The restored counter should keep the increases of each actor,
+		// so that the following reset has the same result.
+		restored, err := converter.BytesToCounter(mustCounterBytes(t, obj.Get("cnt").(*crdt.Counter)))
+		assert.NoError(t, err)
+		assert.Equal(t, obj.Get("cnt").(*crdt.Counter).Increases(), restored.Increases())
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Increase(7)
+			return nil
+		}))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Reset()
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+		assert.Equal(t, `{"cnt":7}`, d1.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+}
+
+func TestBoundedCounter(t *testing.T) {
+	setup := func(t *testing.T) (*document.Document, *document.Document) {
+		d1, d2 := document.New("d1"), document.New("d1")
+		d1.SetActor(time.ActorID{1})
+		d2.SetActor(time.ActorID{2})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewBoundedCounter("stock", crdt.IntegerCnt, 5, 0)
+			return nil
+		}))
+		sync(t, d1, d2)
+		return d1, d2
+	}
+
+	t.Run("rights test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			stock := root.GetCounter("stock")
+			assert.Equal(t, int64(5), stock.Rights())
+
+			stock.Increase(-2)
+			assert.Equal(t, int64(3), stock.Rights())
+			assert.Panics(t, func() { stock.Increase(-4) })
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			stock := root.GetCounter("stock")
+			assert.Equal(t, int64(0), stock.Rights())
+			assert.Panics(t, func() { stock.Increase(-1) })
+
+			// NOTE: The increases are the rights of the actor that increases.
+			stock.Increase(2).Increase(-1)
+			assert.Equal(t, int64(1), stock.Rights())
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t, `{"stock":4}`, d1.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("transfer test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			stock := root.GetCounter("stock")
+			stock.Transfer(d2.ActorID(), 3)
+			assert.Equal(t, int64(2), stock.Rights())
+			assert.Panics(t, func() { stock.Transfer(d2.ActorID(), 3) })
+			return nil
+		}))
+		sync(t, d1, d2)
+
+		// NOTE: Both actors decrease concurrently within their own rights, so
+		// the value never goes below the lower bound.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("stock").Increase(-2)
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			stock := root.GetCounter("stock")
+			assert.Equal(t, int64(3), stock.Rights())
+			stock.Increase(-3)
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t, `{"stock":0}`, d1.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("reset bounded counter test", func(t *testing.T) {
+		d1, _ := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			assert.Panics(t, func() { root.GetCounter("stock").Reset() })
+			return nil
+		}))
+	})
+
+	t.Run("snapshot test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("stock").Transfer(d2.ActorID(), 2).Increase(-1)
+			return nil
+		}))
+		sync(t, d1, d2)
+
+		restored, err := converter.BytesToCounter(mustCounterBytes(t, d2.Root().GetCounter("stock").Counter))
+		assert.NoError(t, err)
+		assert.True(t, restored.IsBounded())
+		assert.Equal(t, int64(0), restored.LowerBound())
+		assert.Equal(t, int64(2), restored.RightsOf(d1.ActorID()))
+		assert.Equal(t, int64(2), restored.RightsOf(d2.ActorID()))
+		assert.Equal(t, "4", restored.Marshal())
+	})
+}
+
+func mustCounterBytes(t *testing.T, counter *crdt.Counter) []byte {
+	bytes, err := converter.CounterToBytes(counter)
+	assert.NoError(t, err)
+	return bytes
+}
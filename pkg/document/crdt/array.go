@@ -62,6 +62,12 @@ func (a *Array) Get(idx int) (Element, error) {
 	return node.elem, nil
 }
 
+// IndexOf returns the index of the live element of the given creation time.
+// It returns -1 if the element is not found or removed.
+func (a *Array) IndexOf(createdAt *time.Ticket) int {
+	return a.elements.IndexOf(createdAt)
+}
+
 // FindPrevCreatedAt returns the creation time of the previous element of the
 // given element.
 func (a *Array) FindPrevCreatedAt(createdAt *time.Ticket) (*time.Ticket, error) {
@@ -183,6 +189,12 @@ func (a *Array) Remove(removedAt *time.Ticket) bool {
 	return false
 }
 
+// HeadCreatedAt returns the creation time of the dummy head element of the
+// array. It is used to insert or move an element to the front.
+func (a *Array) HeadCreatedAt() *time.Ticket {
+	return a.elements.HeadCreatedAt()
+}
+
 // LastCreatedAt returns the creation time of the last element.
 func (a *Array) LastCreatedAt() *time.Ticket {
 	return a.elements.LastCreatedAt()
@@ -20,13 +20,21 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"maps"
+	"sort"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/resource"
 )
 
-// ErrUnsupportedType is returned when the given type is not supported.
-var ErrUnsupportedType = errors.New("unsupported type")
+var (
+	// ErrUnsupportedType is returned when the given type is not supported.
+	ErrUnsupportedType = errors.New("unsupported type")
+
+	// ErrInsufficientRights is returned when the actor does not have enough
+	// rights to decrease or transfer in the bounded counter.
+	ErrInsufficientRights = errors.New("insufficient rights")
+)
 
 // CounterType represents any type that can be used as a counter.
 type CounterType int
@@ -50,13 +58,100 @@ func CounterValueFromBytes(counterType CounterType, value []byte) (interface{},
 	}
 }
 
+// CounterEntry is the cumulative sum of the increases of an actor. The actor
+// is identified by the actor of updatedAt, the time of the last increase
+// included in the sum.
+type CounterEntry struct {
+	sum       int64
+	updatedAt *time.Ticket
+}
+
+// NewCounterEntry creates a new instance of CounterEntry.
+func NewCounterEntry(sum int64, updatedAt *time.Ticket) *CounterEntry {
+	return &CounterEntry{
+		sum:       sum,
+		updatedAt: updatedAt,
+	}
+}
+
+// Sum returns the cumulative sum of this entry.
+func (e *CounterEntry) Sum() int64 {
+	return e.sum
+}
+
+// UpdatedAt returns the time of the last increase included in this entry.
+func (e *CounterEntry) UpdatedAt() *time.Ticket {
+	return e.updatedAt
+}
+
+// CounterTransfer is the cumulative amount of the rights transferred from an
+// actor to another in the bounded counter.
+type CounterTransfer struct {
+	from   time.ActorID
+	to     time.ActorID
+	amount int64
+}
+
+// NewCounterTransfer creates a new instance of CounterTransfer.
+func NewCounterTransfer(from, to time.ActorID, amount int64) *CounterTransfer {
+	return &CounterTransfer{
+		from:   from,
+		to:     to,
+		amount: amount,
+	}
+}
+
+// From returns the actor that transferred the rights.
+func (t *CounterTransfer) From() time.ActorID {
+	return t.from
+}
+
+// To returns the actor that received the rights.
+func (t *CounterTransfer) To() time.ActorID {
+	return t.to
+}
+
+// Amount returns the cumulative amount of the transferred rights.
+func (t *CounterTransfer) Amount() int64 {
+	return t.amount
+}
+
 // Counter represents changeable number data type.
+//
+// The value of the counter is the initial value and the sum of the increases
+// of each actor. A reset cancels the initial value and the increases that
+// were observed by the resetter, so the increases concurrent with the reset
+// remain.
+//
+// A bounded counter guarantees that its value never goes below the lower
+// bound. The difference between the initial value and the lower bound is
+// given to the creator as rights, and each actor can decrease the counter
+// or transfer the rights to others only within its own rights.
 type Counter struct {
 	valueType CounterType
 	value     interface{}
 	createdAt *time.Ticket
 	movedAt   *time.Ticket
 	removedAt *time.Ticket
+
+	// base is the initial value of this counter.
+	base int64
+
+	// increases is the cumulative increases of each actor.
+	increases map[time.ActorID]*CounterEntry
+
+	// resets is the increases of each actor that were observed by resets.
+	resets map[time.ActorID]*CounterEntry
+
+	// isReset is whether the initial value has been reset.
+	isReset bool
+
+	// bounded is whether this counter has the lower bound.
+	bounded    bool
+	lowerBound int64
+
+	// transfers is the cumulative amount of the transferred rights.
+	transfers map[time.ActorID]map[time.ActorID]int64
 }
 
 // NewCounter creates a new instance of Counter.
@@ -71,6 +166,7 @@ func NewCounter(valueType CounterType, value interface{}, createdAt *time.Ticket
 			valueType: IntegerCnt,
 			value:     intValue,
 			createdAt: createdAt,
+			base:      int64(intValue),
 		}, nil
 	case LongCnt:
 		longValue, err := castToLong(value)
@@ -81,12 +177,34 @@ func NewCounter(valueType CounterType, value interface{}, createdAt *time.Ticket
 			valueType: LongCnt,
 			value:     longValue,
 			createdAt: createdAt,
+			base:      longValue,
 		}, nil
 	default:
 		return nil, ErrUnsupportedType
 	}
 }
 
+// NewBoundedCounter creates a new instance of Counter whose value never goes
+// below the given lower bound.
+func NewBoundedCounter(
+	valueType CounterType,
+	value interface{},
+	lowerBound int64,
+	createdAt *time.Ticket,
+) (*Counter, error) {
+	counter, err := NewCounter(valueType, value, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	if counter.base < lowerBound {
+		return nil, fmt.Errorf("new bounded counter %d < %d: %w", counter.base, lowerBound, ErrInsufficientRights)
+	}
+
+	counter.bounded = true
+	counter.lowerBound = lowerBound
+	return counter, nil
+}
+
 // Bytes creates an array representing the value.
 func (p *Counter) Bytes() ([]byte, error) {
 	switch val := p.value.(type) {
@@ -140,6 +258,14 @@ func (p *Counter) Marshal() string {
 // DeepCopy copies itself deeply.
 func (p *Counter) DeepCopy() (Element, error) {
 	counter := *p
+	counter.increases = copyCounterEntries(p.increases)
+	counter.resets = copyCounterEntries(p.resets)
+	if p.transfers != nil {
+		counter.transfers = make(map[time.ActorID]map[time.ActorID]int64, len(p.transfers))
+		for from, amounts := range p.transfers {
+			counter.transfers[from] = maps.Clone(amounts)
+		}
+	}
 	return &counter, nil
 }
 
@@ -198,6 +324,7 @@ func (p *Counter) Increase(v *Primitive) (*Counter, error) {
 	if !p.IsNumericType() || !v.IsNumericType() {
 		return nil, ErrUnsupportedType
 	}
+	var delta int64
 	switch p.valueType {
 	case IntegerCnt:
 		intValue, err := castToInt(v.value)
@@ -205,19 +332,256 @@ func (p *Counter) Increase(v *Primitive) (*Counter, error) {
 			return nil, err
 		}
 		p.value = p.value.(int32) + intValue
+		delta = int64(intValue)
 	case LongCnt:
 		longValue, err := castToLong(v.value)
 		if err != nil {
 			return nil, err
 		}
 		p.value = p.value.(int64) + longValue
+		delta = longValue
 	default:
 		return nil, ErrUnsupportedType
 	}
 
+	// NOTE: The increase is identified by the creation time of the operand
+	// rather than the execution time of the operation, because the operand
+	// keeps the same ticket in every replica.
+	updatedAt := v.CreatedAt()
+	if p.increases == nil {
+		p.increases = make(map[time.ActorID]*CounterEntry)
+	}
+	entry, ok := p.increases[updatedAt.ActorID()]
+	if !ok {
+		entry = &CounterEntry{}
+		p.increases[updatedAt.ActorID()] = entry
+	}
+	entry.sum += delta
+	entry.updatedAt = updatedAt
+
 	return p, nil
 }
 
+// Increases returns the cumulative increases of each actor.
+func (p *Counter) Increases() []*CounterEntry {
+	return sortedCounterEntries(p.increases)
+}
+
+// Reset resets the initial value and the given increases of each actor that
+// were observed by the resetter. Resets are idempotent and commutative, so
+// the latest observed increases of each actor are kept.
+func (p *Counter) Reset(observed []*CounterEntry) error {
+	if p.bounded {
+		return fmt.Errorf("reset bounded counter: %w", ErrUnsupportedType)
+	}
+
+	p.isReset = true
+	for _, entry := range observed {
+		p.ApplyReset(entry)
+	}
+
+	return p.recompute()
+}
+
+// ApplyReset applies the given observed increase without recomputing the
+// value. It is used to restore the counter from the snapshot.
+func (p *Counter) ApplyReset(entry *CounterEntry) {
+	if p.resets == nil {
+		p.resets = make(map[time.ActorID]*CounterEntry)
+	}
+	actorID := entry.updatedAt.ActorID()
+	if prev, ok := p.resets[actorID]; ok && !entry.updatedAt.After(prev.updatedAt) {
+		return
+	}
+	p.resets[actorID] = NewCounterEntry(entry.sum, entry.updatedAt)
+}
+
+// Resets returns the increases of each actor that were observed by resets.
+func (p *Counter) Resets() []*CounterEntry {
+	return sortedCounterEntries(p.resets)
+}
+
+// IsReset returns whether the initial value of this counter has been reset.
+func (p *Counter) IsReset() bool {
+	return p.isReset
+}
+
+// Restore restores the state of this counter from the snapshot. The current
+// value of the counter is kept as the initial value if the counter has no
+// increases.
+func (p *Counter) Restore(increases, resets []*CounterEntry, isReset bool) error {
+	for _, entry := range increases {
+		if p.increases == nil {
+			p.increases = make(map[time.ActorID]*CounterEntry)
+		}
+		p.increases[entry.updatedAt.ActorID()] = NewCounterEntry(entry.sum, entry.updatedAt)
+	}
+	for _, entry := range resets {
+		p.ApplyReset(entry)
+	}
+	p.isReset = isReset
+
+	// NOTE: The initial value is derived from the value instead of being
+	// stored, so the snapshots without increases are restored as they were.
+	if !p.isReset {
+		p.base = p.valueAsLong() - p.increasedSince()
+	}
+	return p.recompute()
+}
+
+// IsBounded returns whether this counter has the lower bound.
+func (p *Counter) IsBounded() bool {
+	return p.bounded
+}
+
+// LowerBound returns the lower bound of this counter.
+func (p *Counter) LowerBound() int64 {
+	return p.lowerBound
+}
+
+// SetLowerBound sets the lower bound of this counter.
+func (p *Counter) SetLowerBound(lowerBound int64) {
+	p.bounded = true
+	p.lowerBound = lowerBound
+}
+
+// RightsOf returns the rights of the given actor in the bounded counter. The
+// rights are the amount that the actor can decrease or transfer.
+//
+// NOTE: The initial rights belong to the actor of the creation time of the
+// counter. Like other elements, it is not updated when the actor of the
+// document is changed, so the counter should be created after attaching.
+func (p *Counter) RightsOf(actorID time.ActorID) int64 {
+	if !p.bounded {
+		return 0
+	}
+
+	var rights int64
+	if p.createdAt.ActorID() == actorID {
+		rights += p.base - p.lowerBound
+	}
+	if entry, ok := p.increases[actorID]; ok {
+		rights += entry.sum
+	}
+	for from, amounts := range p.transfers {
+		if from == actorID {
+			for _, amount := range amounts {
+				rights -= amount
+			}
+		}
+		rights += amounts[actorID]
+	}
+	return rights
+}
+
+// Transfer transfers the given amount of rights from an actor to another.
+// The rights are not checked, because they are checked by the actor that
+// transfers them.
+func (p *Counter) Transfer(from, to time.ActorID, amount int64) error {
+	if !p.bounded {
+		return fmt.Errorf("transfer rights: %w", ErrUnsupportedType)
+	}
+
+	if p.transfers == nil {
+		p.transfers = make(map[time.ActorID]map[time.ActorID]int64)
+	}
+	if p.transfers[from] == nil {
+		p.transfers[from] = make(map[time.ActorID]int64)
+	}
+	p.transfers[from][to] += amount
+	return nil
+}
+
+// Transfers returns the cumulative amount of the transferred rights.
+func (p *Counter) Transfers() []*CounterTransfer {
+	var transfers []*CounterTransfer
+	for from, amounts := range p.transfers {
+		for to, amount := range amounts {
+			transfers = append(transfers, NewCounterTransfer(from, to, amount))
+		}
+	}
+	sort.Slice(transfers, func(i, j int) bool {
+		if cmp := transfers[i].from.Compare(transfers[j].from); cmp != 0 {
+			return cmp < 0
+		}
+		return transfers[i].to.Compare(transfers[j].to) < 0
+	})
+	return transfers
+}
+
+// IsPlain returns whether this counter only has the value, without the
+// increases, resets and lower bound.
+func (p *Counter) IsPlain() bool {
+	return len(p.increases) == 0 && len(p.resets) == 0 && !p.isReset && !p.bounded
+}
+
+// increasedSince returns the sum of the increases that were not reset.
+func (p *Counter) increasedSince() int64 {
+	var sum int64
+	for actorID, entry := range p.increases {
+		sum += entry.sum
+		if reset, ok := p.resets[actorID]; ok {
+			sum -= reset.sum
+		}
+	}
+	return sum
+}
+
+// recompute recomputes the value from the initial value and the increases.
+func (p *Counter) recompute() error {
+	value := p.increasedSince()
+	if !p.isReset {
+		value += p.base
+	}
+
+	switch p.valueType {
+	case IntegerCnt:
+		p.value = int32(value)
+	case LongCnt:
+		p.value = value
+	default:
+		return ErrUnsupportedType
+	}
+	return nil
+}
+
+// valueAsLong returns the value as int64.
+func (p *Counter) valueAsLong() int64 {
+	switch val := p.value.(type) {
+	case int32:
+		return int64(val)
+	case int64:
+		return val
+	default:
+		return 0
+	}
+}
+
+func copyCounterEntries(entries map[time.ActorID]*CounterEntry) map[time.ActorID]*CounterEntry {
+	if entries == nil {
+		return nil
+	}
+
+	copied := make(map[time.ActorID]*CounterEntry, len(entries))
+	for actorID, entry := range entries {
+		copied[actorID] = NewCounterEntry(entry.sum, entry.updatedAt)
+	}
+	return copied
+}
+
+// sortedCounterEntries returns the copies of the given entries sorted by the
+// actor, so that the entries are not changed by the following increases.
+func sortedCounterEntries(entries map[time.ActorID]*CounterEntry) []*CounterEntry {
+	var sorted []*CounterEntry
+	for _, entry := range entries {
+		sorted = append(sorted, NewCounterEntry(entry.sum, entry.updatedAt))
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].updatedAt.ActorID().Compare(sorted[j].updatedAt.ActorID()) < 0
+	})
+	return sorted
+}
+
 // IsNumericType checks for numeric types.
 func (p *Counter) IsNumericType() bool {
 	t := p.valueType
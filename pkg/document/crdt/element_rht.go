@@ -90,6 +90,15 @@ func (rht *ElementRHT) Get(key string) Element {
 	return nil
 }
 
+// KeyOf returns the key of the live element of the given creation time.
+func (rht *ElementRHT) KeyOf(createdAt *time.Ticket) (string, bool) {
+	node, ok := rht.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok || node.isRemoved() || rht.nodeMapByKey[node.key] != node {
+		return "", false
+	}
+	return node.key, true
+}
+
 // Has returns whether the element exists of the given key or not.
 func (rht *ElementRHT) Has(key string) bool {
 	if node, ok := rht.nodeMapByKey[key]; ok {
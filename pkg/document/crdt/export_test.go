@@ -0,0 +1,38 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import "github.com/yorkie-team/yorkie/pkg/resource"
+
+// DocSizeForTest returns the size of the document measured by traversing the
+// whole document. It is used to verify the size tracked incrementally.
+func (r *Root) DocSizeForTest() resource.DocSize {
+	var docSize resource.DocSize
+	for createdAt, element := range r.elementMap {
+		if _, exists := r.gcElementPairMap[createdAt]; exists {
+			docSize.GC.Add(element.DataSize())
+		} else {
+			docSize.Live.Add(element.DataSize())
+		}
+	}
+
+	for _, pair := range r.gcNodePairMap {
+		docSize.GC.Add(pair.Child.DataSize())
+	}
+
+	return docSize
+}
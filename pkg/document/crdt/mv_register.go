@@ -0,0 +1,279 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/resource"
+)
+
+// MVRegisterNode is a node of MVRegister. Each assignment creates a new node
+// that is identified by the creation time of the value.
+type MVRegisterNode struct {
+	value *Primitive
+}
+
+// Value returns the value of this node.
+func (n *MVRegisterNode) Value() *Primitive {
+	return n.value
+}
+
+// IDString returns the string representation of the ID of this node.
+func (n *MVRegisterNode) IDString() string {
+	return n.value.CreatedAt().Key()
+}
+
+// RemovedAt returns the removal time of this node.
+func (n *MVRegisterNode) RemovedAt() *time.Ticket {
+	return n.value.RemovedAt()
+}
+
+// DataSize returns the data usage of this node.
+func (n *MVRegisterNode) DataSize() resource.DataSize {
+	return n.value.DataSize()
+}
+
+// MVRegister is a multi-value register. An assignment overwrites only the
+// values that have been observed by the assigner, so the values assigned
+// concurrently are all kept as conflicts until one of them is assigned again.
+type MVRegister struct {
+	// nodes is the nodes of this register in the order of their creation time.
+	nodes              []*MVRegisterNode
+	nodeMapByCreatedAt map[string]*MVRegisterNode
+	createdAt          *time.Ticket
+	movedAt            *time.Ticket
+	removedAt          *time.Ticket
+}
+
+// NewMVRegister creates a new instance of MVRegister.
+func NewMVRegister(createdAt *time.Ticket) *MVRegister {
+	return &MVRegister{
+		nodeMapByCreatedAt: make(map[string]*MVRegisterNode),
+		createdAt:          createdAt,
+	}
+}
+
+// Assign assigns the given value to this register, overwriting the values of
+// the given creation times. It returns the pairs of the newly overwritten
+// nodes for garbage collection.
+func (r *MVRegister) Assign(
+	value *Primitive,
+	overwrites []*time.Ticket,
+	executedAt *time.Ticket,
+) []GCPair {
+	var pairs []GCPair
+	for _, createdAt := range overwrites {
+		node, ok := r.nodeMapByCreatedAt[createdAt.Key()]
+		if !ok {
+			continue
+		}
+
+		wasRemoved := node.RemovedAt() != nil
+		if node.value.Remove(executedAt) && !wasRemoved {
+			pairs = append(pairs, GCPair{
+				Parent: r,
+				Child:  node,
+			})
+		}
+	}
+
+	r.insert(value)
+	return pairs
+}
+
+// insert inserts the given value in the order of creation time. Inserting the
+// value of the same creation time again has no effect.
+func (r *MVRegister) insert(value *Primitive) {
+	if _, ok := r.nodeMapByCreatedAt[value.CreatedAt().Key()]; ok {
+		return
+	}
+
+	node := &MVRegisterNode{value: value}
+	idx := sort.Search(len(r.nodes), func(i int) bool {
+		return r.nodes[i].value.CreatedAt().After(value.CreatedAt())
+	})
+	r.nodes = append(r.nodes, nil)
+	copy(r.nodes[idx+1:], r.nodes[idx:])
+	r.nodes[idx] = node
+	r.nodeMapByCreatedAt[value.CreatedAt().Key()] = node
+}
+
+// Values returns the live values of this register in the order of their
+// creation time.
+func (r *MVRegister) Values() []*Primitive {
+	var values []*Primitive
+	for _, node := range r.nodes {
+		if node.RemovedAt() == nil {
+			values = append(values, node.value)
+		}
+	}
+	return values
+}
+
+// Value returns the value that was assigned last among the live values. It
+// returns nil if no value has been assigned.
+func (r *MVRegister) Value() *Primitive {
+	values := r.Values()
+	if len(values) == 0 {
+		return nil
+	}
+	return values[len(values)-1]
+}
+
+// Conflicts returns the values assigned concurrently. It returns nil if
+// there is no conflict.
+func (r *MVRegister) Conflicts() []*Primitive {
+	values := r.Values()
+	if len(values) < 2 {
+		return nil
+	}
+	return values
+}
+
+// Nodes returns the nodes of this register including overwritten nodes.
+func (r *MVRegister) Nodes() []*MVRegisterNode {
+	return r.nodes
+}
+
+// Purge physically purges the given node.
+func (r *MVRegister) Purge(child GCChild) error {
+	node, ok := child.(*MVRegisterNode)
+	if !ok {
+		return fmt.Errorf("purge %s: %w", child.IDString(), ErrChildNotFound)
+	}
+	if _, ok := r.nodeMapByCreatedAt[node.IDString()]; !ok {
+		return fmt.Errorf("purge %s: %w", child.IDString(), ErrChildNotFound)
+	}
+
+	for i, n := range r.nodes {
+		if n == node {
+			r.nodes = append(r.nodes[:i], r.nodes[i+1:]...)
+			break
+		}
+	}
+	delete(r.nodeMapByCreatedAt, node.IDString())
+
+	return nil
+}
+
+// GCPairs returns the pairs of GC.
+func (r *MVRegister) GCPairs() []GCPair {
+	var pairs []GCPair
+	for _, node := range r.nodes {
+		if node.RemovedAt() != nil {
+			pairs = append(pairs, GCPair{
+				Parent: r,
+				Child:  node,
+			})
+		}
+	}
+	return pairs
+}
+
+// Marshal returns the JSON encoding of the value that was assigned last.
+func (r *MVRegister) Marshal() string {
+	value := r.Value()
+	if value == nil {
+		return "null"
+	}
+	return value.Marshal()
+}
+
+// DeepCopy copies itself deeply.
+func (r *MVRegister) DeepCopy() (Element, error) {
+	register := NewMVRegister(r.createdAt)
+	for _, node := range r.nodes {
+		value, err := node.value.DeepCopy()
+		if err != nil {
+			return nil, err
+		}
+		register.insert(value.(*Primitive))
+	}
+	register.movedAt = r.movedAt
+	register.removedAt = r.removedAt
+	return register, nil
+}
+
+// CreatedAt returns the creation time of this register.
+func (r *MVRegister) CreatedAt() *time.Ticket {
+	return r.createdAt
+}
+
+// MovedAt returns the move time of this register.
+func (r *MVRegister) MovedAt() *time.Ticket {
+	return r.movedAt
+}
+
+// SetMovedAt sets the move time of this register.
+func (r *MVRegister) SetMovedAt(movedAt *time.Ticket) {
+	r.movedAt = movedAt
+}
+
+// RemovedAt returns the removal time of this register.
+func (r *MVRegister) RemovedAt() *time.Ticket {
+	return r.removedAt
+}
+
+// SetRemovedAt sets the removal time of this register.
+func (r *MVRegister) SetRemovedAt(removedAt *time.Ticket) {
+	r.removedAt = removedAt
+}
+
+// Remove removes this register.
+func (r *MVRegister) Remove(removedAt *time.Ticket) bool {
+	if (removedAt != nil && removedAt.After(r.createdAt)) &&
+		(r.removedAt == nil || removedAt.After(r.removedAt)) {
+		r.removedAt = removedAt
+		return true
+	}
+	return false
+}
+
+// MetaSize returns the size of the metadata of this register.
+func (r *MVRegister) MetaSize() int {
+	size := 0
+	if r.createdAt != nil {
+		size += time.TicketSize
+	}
+	if r.movedAt != nil {
+		size += time.TicketSize
+	}
+	if r.removedAt != nil {
+		size += time.TicketSize
+	}
+	return size
+}
+
+// DataSize returns the data usage of this register. The overwritten nodes
+// are counted as garbage separately.
+func (r *MVRegister) DataSize() resource.DataSize {
+	dataSize := resource.DataSize{
+		Data: 0,
+		Meta: r.MetaSize(),
+	}
+	for _, node := range r.nodes {
+		if node.RemovedAt() == nil {
+			size := node.DataSize()
+			dataSize.Data += size.Data
+			dataSize.Meta += size.Meta
+		}
+	}
+	return dataSize
+}
@@ -63,6 +63,11 @@ func (o *Object) Get(k string) Element {
 	return o.memberNodes.Get(k)
 }
 
+// KeyOf returns the key of the live member of the given creation time.
+func (o *Object) KeyOf(createdAt *time.Ticket) (string, bool) {
+	return o.memberNodes.KeyOf(createdAt)
+}
+
 // Has returns whether the element exists of the given key or not.
 func (o *Object) Has(k string) bool {
 	return o.memberNodes.Has(k)
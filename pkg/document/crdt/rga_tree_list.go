@@ -171,6 +171,11 @@ func (a *RGATreeList) Nodes() []*RGATreeListNode {
 	return nodes
 }
 
+// HeadCreatedAt returns the creation time of the dummy head.
+func (a *RGATreeList) HeadCreatedAt() *time.Ticket {
+	return a.dummyHead.CreatedAt()
+}
+
 // LastCreatedAt returns the creation time of last elements.
 func (a *RGATreeList) LastCreatedAt() *time.Ticket {
 	return a.last.CreatedAt()
@@ -208,6 +213,17 @@ func (a *RGATreeList) Get(idx int) (*RGATreeListNode, error) {
 	return node, nil
 }
 
+// IndexOf returns the index of the live element of the given creation time.
+// It returns -1 if the element is not found or removed.
+func (a *RGATreeList) IndexOf(createdAt *time.Ticket) int {
+	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok || node.isRemoved() {
+		return -1
+	}
+
+	return a.nodeMapByIndex.IndexOf(node.indexNode)
+}
+
 // DeleteByCreatedAt deletes the given element.
 func (a *RGATreeList) DeleteByCreatedAt(createdAt *time.Ticket, deletedAt *time.Ticket) (*RGATreeListNode, error) {
 	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
@@ -113,6 +113,33 @@ type RGATreeSplitNodePos struct {
 	relativeOffset int
 }
 
+// RGATreeSplitNodeIDStruct is the serializable form of RGATreeSplitNodeID.
+type RGATreeSplitNodeIDStruct struct {
+	CreatedAt time.TicketStruct `json:"createdAt"`
+	Offset    int               `json:"offset"`
+}
+
+// RGATreeSplitNodePosStruct is the serializable form of RGATreeSplitNodePos.
+// It can be stored in presence to share a position with other clients.
+type RGATreeSplitNodePosStruct struct {
+	ID             RGATreeSplitNodeIDStruct `json:"id"`
+	RelativeOffset int                      `json:"relativeOffset"`
+}
+
+// NewRGATreeSplitNodePosFromStruct creates a new instance of
+// RGATreeSplitNodePos from the given struct.
+func NewRGATreeSplitNodePosFromStruct(s RGATreeSplitNodePosStruct) (*RGATreeSplitNodePos, error) {
+	createdAt, err := time.NewTicketFromStruct(s.ID.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRGATreeSplitNodePos(
+		NewRGATreeSplitNodeID(createdAt, s.ID.Offset),
+		s.RelativeOffset,
+	), nil
+}
+
 // NewRGATreeSplitNodePos creates a new instance of RGATreeSplitNodePos.
 func NewRGATreeSplitNodePos(id *RGATreeSplitNodeID, offset int) *RGATreeSplitNodePos {
 	return &RGATreeSplitNodePos{id, offset}
@@ -138,6 +165,17 @@ func (pos *RGATreeSplitNodePos) RelativeOffset() int {
 	return pos.relativeOffset
 }
 
+// ToStruct returns the serializable form of this position.
+func (pos *RGATreeSplitNodePos) ToStruct() RGATreeSplitNodePosStruct {
+	return RGATreeSplitNodePosStruct{
+		ID: RGATreeSplitNodeIDStruct{
+			CreatedAt: pos.id.createdAt.ToStruct(),
+			Offset:    pos.id.offset,
+		},
+		RelativeOffset: pos.relativeOffset,
+	}
+}
+
 // Equal returns whether the given pos equals or not.
 func (pos *RGATreeSplitNodePos) Equal(other *RGATreeSplitNodePos) bool {
 	if !pos.id.Equal(other.id) {
@@ -353,6 +391,38 @@ func (s *RGATreeSplit[V]) findNodePos(index int) (*RGATreeSplitNodePos, error) {
 	}, nil
 }
 
+// findIndexesFromRange returns the indexes of the given range without
+// splitting nodes.
+func (s *RGATreeSplit[V]) findIndexesFromRange(from, to *RGATreeSplitNodePos) (int, int, error) {
+	fromIdx, err := s.posToIndex(from)
+	if err != nil {
+		return 0, 0, err
+	}
+	toIdx, err := s.posToIndex(to)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return fromIdx, toIdx, nil
+}
+
+// posToIndex returns the index of the given position. If the position is in
+// a removed node, it returns the index of the start of the node.
+func (s *RGATreeSplit[V]) posToIndex(pos *RGATreeSplitNodePos) (int, error) {
+	absoluteID := pos.getAbsoluteID()
+	node, err := s.findFloorNodePreferToLeft(absoluteID)
+	if err != nil {
+		return 0, err
+	}
+
+	index := s.treeByIndex.IndexOf(node.indexNode)
+	if node.removedAt == nil {
+		index += absoluteID.offset - node.id.offset
+	}
+
+	return index, nil
+}
+
 func (s *RGATreeSplit[V]) findNodeWithSplit(
 	pos *RGATreeSplitNodePos,
 	updatedAt *time.Ticket,
@@ -20,10 +20,24 @@
 package crdt
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/resource"
 )
 
+var (
+	// ErrInvalidPath is returned when the given path is invalid.
+	ErrInvalidPath = errors.New("invalid path")
+
+	// ErrElementNotFound is returned when the element of the given path or
+	// creation time is not found.
+	ErrElementNotFound = errors.New("element not found")
+)
+
 // ElementPair represents pair that has a parent element and child element.
 type ElementPair struct {
 	parent Container
@@ -36,23 +50,42 @@ type ElementPair struct {
 //
 // Every element has a unique time ticket at creation, which allows us to find
 // a particular element.
+//
+// The parent of every element is also kept to compute the path of an element,
+// e.g. `$.todos[0].title`, without traversing the whole document.
 type Root struct {
 	object           *Object
 	elementMap       map[string]Element
+	parentMap        map[string]Container
 	gcElementPairMap map[string]ElementPair
 	gcNodePairMap    map[string]GCPair
+
+	// treeMap is the map of the trees, whose logs of moves are compacted
+	// when garbage collecting.
+	treeMap map[string]*Tree
+
+	// docSize is the size of the document. It is tracked incrementally with
+	// the sizes of the elements and the GC nodes, which are measured again
+	// whenever they are updated.
+	docSize      resource.DocSize
+	elementSizes map[string]resource.DataSize
+	nodeSizes    map[string]resource.DataSize
 }
 
 // NewRoot creates a new instance of Root.
 func NewRoot(root *Object) *Root {
 	r := &Root{
 		elementMap:       make(map[string]Element),
+		parentMap:        make(map[string]Container),
 		gcElementPairMap: make(map[string]ElementPair),
 		gcNodePairMap:    make(map[string]GCPair),
+		treeMap:          make(map[string]*Tree),
+		elementSizes:     make(map[string]resource.DataSize),
+		nodeSizes:        make(map[string]resource.DataSize),
 	}
 
 	r.object = root
-	r.RegisterElement(root)
+	r.RegisterElement(nil, root)
 
 	root.Descendants(func(elem Element, parent Container) bool {
 		if elem.RemovedAt() != nil {
@@ -68,6 +101,14 @@ func NewRoot(root *Object) *Root {
 			for _, pair := range e.GCPairs() {
 				r.RegisterGCPair(pair)
 			}
+		case *Set:
+			for _, pair := range e.GCPairs() {
+				r.RegisterGCPair(pair)
+			}
+		case *MVRegister:
+			for _, pair := range e.GCPairs() {
+				r.RegisterGCPair(pair)
+			}
 		}
 		return false
 	})
@@ -85,29 +126,181 @@ func (r *Root) FindByCreatedAt(createdAt *time.Ticket) Element {
 	return r.elementMap[createdAt.Key()]
 }
 
-// RegisterElement registers the given element to hash table.
-func (r *Root) RegisterElement(element Element) {
+// PathOf returns the path of the live element of the given creation time,
+// e.g. `$.todos[0].title`.
+func (r *Root) PathOf(createdAt *time.Ticket) (string, error) {
+	var subPaths []string
+	for current := createdAt; current.Compare(r.object.CreatedAt()) != 0; {
+		parent, ok := r.parentMap[current.Key()]
+		if !ok {
+			return "", fmt.Errorf("path of %s: %w", createdAt.Key(), ErrElementNotFound)
+		}
+
+		switch parent := parent.(type) {
+		case *Object:
+			key, ok := parent.KeyOf(current)
+			if !ok {
+				return "", fmt.Errorf("path of %s: %w", createdAt.Key(), ErrElementNotFound)
+			}
+			subPaths = append(subPaths, "."+key)
+		case *Array:
+			idx := parent.IndexOf(current)
+			if idx < 0 {
+				return "", fmt.Errorf("path of %s: %w", createdAt.Key(), ErrElementNotFound)
+			}
+			subPaths = append(subPaths, "["+strconv.Itoa(idx)+"]")
+		default:
+			return "", fmt.Errorf("path of %s: %w", createdAt.Key(), ErrElementNotFound)
+		}
+
+		current = parent.CreatedAt()
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString("$")
+	for i := len(subPaths) - 1; i >= 0; i-- {
+		sb.WriteString(subPaths[i])
+	}
+	return sb.String(), nil
+}
+
+// FindByPath returns the live element of the given path, e.g.
+// `$.todos[0].title`. The index of an array can also be written as a key,
+// e.g. `$.todos.0.title`.
+func (r *Root) FindByPath(path string) (Element, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("%s: %w", path, ErrInvalidPath)
+	}
+
+	var elem Element = r.object
+	rest := path[1:]
+	for len(rest) > 0 {
+		var segment string
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			segment, rest = rest[1:end+1], rest[end+1:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("%s: %w", path, ErrInvalidPath)
+			}
+			segment, rest = rest[1:end], rest[end+1:]
+			if _, ok := elem.(*Array); !ok {
+				return nil, fmt.Errorf("%s: %w", path, ErrElementNotFound)
+			}
+		default:
+			return nil, fmt.Errorf("%s: %w", path, ErrInvalidPath)
+		}
+
+		switch e := elem.(type) {
+		case *Object:
+			elem = e.Get(segment)
+		case *Array:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= e.Len() {
+				return nil, fmt.Errorf("%s: %w", path, ErrElementNotFound)
+			}
+			if elem, err = e.Get(idx); err != nil {
+				return nil, err
+			}
+		default:
+			elem = nil
+		}
+
+		if elem == nil {
+			return nil, fmt.Errorf("%s: %w", path, ErrElementNotFound)
+		}
+	}
+
+	return elem, nil
+}
+
+// RegisterElement registers the given element and its parent to hash tables.
+func (r *Root) RegisterElement(parent Container, element Element) {
 	r.elementMap[element.CreatedAt().Key()] = element
+	if parent != nil {
+		r.parentMap[element.CreatedAt().Key()] = parent
+	}
+	if tree, ok := element.(*Tree); ok {
+		r.treeMap[tree.CreatedAt().Key()] = tree
+	}
+	r.measureElement(element)
 
 	switch element := element.(type) {
 	case Container:
 		{
 			element.Descendants(func(elem Element, parent Container) bool {
 				r.elementMap[elem.CreatedAt().Key()] = elem
+				r.parentMap[elem.CreatedAt().Key()] = parent
+				if tree, ok := elem.(*Tree); ok {
+					r.treeMap[tree.CreatedAt().Key()] = tree
+				}
+				r.measureElement(elem)
 				return false
 			})
 		}
 	}
 }
 
+// MarkUpdated marks the element of the given creation time as updated and
+// measures its size again. It should be called after the element is updated.
+func (r *Root) MarkUpdated(createdAt *time.Ticket) {
+	if createdAt == nil {
+		return
+	}
+
+	if elem, ok := r.elementMap[createdAt.Key()]; ok {
+		r.measureElement(elem)
+	}
+}
+
+// measureElement measures the size of the given element again and applies
+// the difference to the size of the document.
+func (r *Root) measureElement(elem Element) {
+	key := elem.CreatedAt().Key()
+	r.unmeasureElement(key)
+
+	size := elem.DataSize()
+	r.elementSizes[key] = size
+	if _, ok := r.gcElementPairMap[key]; ok {
+		r.docSize.GC.Add(size)
+	} else {
+		r.docSize.Live.Add(size)
+	}
+}
+
+// unmeasureElement removes the size of the element of the given key from the
+// size of the document. It should be called before the element is moved
+// between the live and the garbage.
+func (r *Root) unmeasureElement(key string) {
+	size, ok := r.elementSizes[key]
+	if !ok {
+		return
+	}
+
+	if _, ok := r.gcElementPairMap[key]; ok {
+		r.docSize.GC.Sub(size)
+	} else {
+		r.docSize.Live.Sub(size)
+	}
+	delete(r.elementSizes, key)
+}
+
 // deregisterElement deregister the given element from hash tables.
 func (r *Root) deregisterElement(element Element) int {
 	count := 0
 
 	deregisterElementInternal := func(elem Element) {
 		createdAt := elem.CreatedAt().Key()
+		r.unmeasureElement(createdAt)
 		delete(r.elementMap, createdAt)
+		delete(r.parentMap, createdAt)
 		delete(r.gcElementPairMap, createdAt)
+		delete(r.treeMap, createdAt)
 		count++
 	}
 
@@ -128,42 +321,23 @@ func (r *Root) deregisterElement(element Element) int {
 
 // RegisterRemovedElementPair register the given element pair to hash table.
 func (r *Root) RegisterRemovedElementPair(parent Container, elem Element) {
-	r.gcElementPairMap[elem.CreatedAt().Key()] = ElementPair{
+	key := elem.CreatedAt().Key()
+	_, registered := r.elementSizes[key]
+	r.unmeasureElement(key)
+
+	r.gcElementPairMap[key] = ElementPair{
 		parent,
 		elem,
 	}
-}
 
-func (r *Root) DocSize() resource.DocSize {
-	docSize := resource.DocSize{
-		Live: resource.DataSize{
-			Data: 0,
-			Meta: 0,
-		},
-		GC: resource.DataSize{
-			Data: 0,
-			Meta: 0,
-		},
-	}
-
-	for createdAt, element := range r.elementMap {
-		elementSize := element.DataSize()
-		if _, exists := r.gcElementPairMap[createdAt]; exists {
-			docSize.GC.Data += elementSize.Data
-			docSize.GC.Meta += elementSize.Meta
-		} else {
-			docSize.Live.Data += elementSize.Data
-			docSize.Live.Meta += elementSize.Meta
-		}
-	}
-
-	for _, pair := range r.gcNodePairMap {
-		size := pair.Child.DataSize()
-		docSize.GC.Data += size.Data
-		docSize.GC.Meta += size.Meta
+	if registered {
+		r.measureElement(elem)
 	}
+}
 
-	return docSize
+// DocSize returns the size of the document.
+func (r *Root) DocSize() resource.DocSize {
+	return r.docSize
 }
 
 // DeepCopy copies itself deeply.
@@ -179,6 +353,12 @@ func (r *Root) DeepCopy() (*Root, error) {
 func (r *Root) GarbageCollect(vector time.VersionVector) (int, error) {
 	count := 0
 
+	// NOTE: The logs of moves are compacted before purging the nodes, because
+	// the moves in the logs may refer to the nodes to be purged.
+	for _, tree := range r.treeMap {
+		tree.CompactMoves(vector)
+	}
+
 	for _, pair := range r.gcElementPairMap {
 		if vector.EqualToOrAfter(pair.elem.RemovedAt()) {
 			if err := pair.parent.Purge(pair.elem); err != nil {
@@ -195,7 +375,11 @@ func (r *Root) GarbageCollect(vector time.VersionVector) (int, error) {
 				return 0, err
 			}
 
+			r.unmeasureNode(pair.Child.IDString())
 			delete(r.gcNodePairMap, pair.Child.IDString())
+			if parent, ok := pair.Parent.(Element); ok {
+				r.MarkUpdated(parent.CreatedAt())
+			}
 			count++
 		}
 	}
@@ -237,9 +421,23 @@ func (r *Root) RegisterGCPair(pair GCPair) {
 	// NOTE(hackerwins): If the child is already registered, it means that the
 	// child should be removed from the cache.
 	if _, ok := r.gcNodePairMap[pair.Child.IDString()]; ok {
+		r.unmeasureNode(pair.Child.IDString())
 		delete(r.gcNodePairMap, pair.Child.IDString())
 		return
 	}
 
 	r.gcNodePairMap[pair.Child.IDString()] = pair
+
+	size := pair.Child.DataSize()
+	r.nodeSizes[pair.Child.IDString()] = size
+	r.docSize.GC.Add(size)
+}
+
+// unmeasureNode removes the size of the GC node of the given ID from the size
+// of the document.
+func (r *Root) unmeasureNode(id string) {
+	if size, ok := r.nodeSizes[id]; ok {
+		r.docSize.GC.Sub(size)
+		delete(r.nodeSizes, id)
+	}
 }
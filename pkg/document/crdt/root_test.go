@@ -21,7 +21,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -254,4 +257,139 @@ func TestRoot(t *testing.T) {
 		assert.Equal(t, 1, n)
 		assert.Equal(t, 0, root.GarbageLen())
 	})
+
+	t.Run("path of element test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		root.Object().Set("todos", obj)
+		root.RegisterElement(root.Object(), obj)
+
+		array := crdt.NewArray(crdt.NewRGATreeList(), ctx.IssueTimeTicket())
+		obj.Set("list", array)
+		root.RegisterElement(obj, array)
+
+		var primitives []crdt.Element
+		for _, v := range []int{0, 1, 2} {
+			primitive, err := crdt.NewPrimitive(v, ctx.IssueTimeTicket())
+			assert.NoError(t, err)
+			assert.NoError(t, array.Add(primitive))
+			root.RegisterElement(array, primitive)
+			primitives = append(primitives, primitive)
+		}
+
+		path, err := root.PathOf(root.Object().CreatedAt())
+		assert.NoError(t, err)
+		assert.Equal(t, "$", path)
+		path, err = root.PathOf(array.CreatedAt())
+		assert.NoError(t, err)
+		assert.Equal(t, "$.todos.list", path)
+		path, err = root.PathOf(primitives[2].CreatedAt())
+		assert.NoError(t, err)
+		assert.Equal(t, "$.todos.list[2]", path)
+
+		// 01. The path follows the index of the element after deletion.
+		_, err = array.DeleteByCreatedAt(primitives[1].CreatedAt(), ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		path, err = root.PathOf(primitives[2].CreatedAt())
+		assert.NoError(t, err)
+		assert.Equal(t, "$.todos.list[1]", path)
+		_, err = root.PathOf(primitives[1].CreatedAt())
+		assert.ErrorIs(t, err, crdt.ErrElementNotFound)
+
+		// 02. The elements under the removed element have no path.
+		root.Object().Delete("todos", ctx.IssueTimeTicket())
+		_, err = root.PathOf(primitives[0].CreatedAt())
+		assert.ErrorIs(t, err, crdt.ErrElementNotFound)
+	})
+
+	t.Run("find by path test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		array := crdt.NewArray(crdt.NewRGATreeList(), ctx.IssueTimeTicket())
+		root.Object().Set("list", array)
+		root.RegisterElement(root.Object(), array)
+
+		obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		assert.NoError(t, array.Add(obj))
+		root.RegisterElement(array, obj)
+
+		primitive, err := crdt.NewPrimitive("buy milk", ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		obj.Set("title", primitive)
+		root.RegisterElement(obj, primitive)
+
+		elem, err := root.FindByPath("$")
+		assert.NoError(t, err)
+		assert.Equal(t, root.Object(), elem)
+		elem, err = root.FindByPath("$.list[0].title")
+		assert.NoError(t, err)
+		assert.Equal(t, primitive, elem)
+		elem, err = root.FindByPath("$.list.0")
+		assert.NoError(t, err)
+		assert.Equal(t, obj, elem)
+
+		for _, path := range []string{"$.list[1]", "$.none", "$.list[0].title.x", "$[0]"} {
+			_, err = root.FindByPath(path)
+			assert.ErrorIs(t, err, crdt.ErrElementNotFound, path)
+		}
+		for _, path := range []string{"", "list", "$.list[0", "$x"} {
+			_, err = root.FindByPath(path)
+			assert.ErrorIs(t, err, crdt.ErrInvalidPath, path)
+		}
+	})
+
+	t.Run("incremental document size test", func(t *testing.T) {
+		root, replica := helper.TestRoot(), helper.TestRoot()
+
+		// NOTE: The size tracked incrementally should be the same as the size
+		// measured by traversing the whole document, both for the root updated
+		// by the proxies and for the replica that executes the changes.
+		id := change.InitialID()
+		update := func(updater func(obj *json.Object)) {
+			ctx := change.NewContext(id, "", root)
+			updater(json.NewObject(ctx, root.Object()))
+			c := ctx.ToChange()
+			id = ctx.NextID()
+			assert.Equal(t, root.DocSizeForTest(), root.DocSize())
+
+			assert.NoError(t, c.Execute(replica, nil))
+			assert.Equal(t, replica.DocSizeForTest(), replica.DocSize())
+		}
+
+		update(func(obj *json.Object) {
+			obj.SetNewObject("obj").SetString("k1", "v1").SetInteger("k2", 2)
+			obj.SetNewArray("arr").AddString("a", "b", "c")
+			obj.SetNewText("text").Edit(0, 0, "hello world")
+			obj.SetNewCounter("cnt", crdt.IntegerCnt, 0)
+			obj.SetNewTree("tree", json.TreeNode{
+				Type:     "r",
+				Children: []json.TreeNode{{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "ab"}}}},
+			})
+		})
+		update(func(obj *json.Object) {
+			obj.GetObject("obj").SetString("k1", "overwritten")
+			obj.GetArray("arr").MoveFront(obj.GetArray("arr").Get(2).CreatedAt())
+			obj.GetArray("arr").SetString(1, "set")
+			obj.GetText("text").Edit(0, 5, "hi", map[string]string{"b": "1"})
+			obj.GetText("text").Style(0, 2, map[string]string{"i": "1"})
+			obj.GetCounter("cnt").Increase(10)
+			obj.GetTree("tree").Edit(1, 2, nil, 0)
+		})
+		update(func(obj *json.Object) {
+			obj.Delete("obj")
+			obj.GetArray("arr").Delete(0)
+			obj.GetText("text").Edit(0, 3, "")
+		})
+
+		vector := helper.MaxVersionVector(time.InitialActorID)
+		for _, r := range []*crdt.Root{root, replica} {
+			count, err := r.GarbageCollect(vector)
+			assert.NoError(t, err)
+			assert.Positive(t, count)
+			assert.Equal(t, r.DocSizeForTest(), r.DocSize())
+		}
+	})
 }
@@ -0,0 +1,299 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/resource"
+)
+
+// SetNode is a node of Set. Each addition of a value creates a new node that
+// is identified by the creation time of the value, so a value added
+// concurrently with its removal is not removed.
+type SetNode struct {
+	value *Primitive
+}
+
+// Value returns the value of this node.
+func (n *SetNode) Value() *Primitive {
+	return n.value
+}
+
+// IDString returns the string representation of the ID of this node.
+func (n *SetNode) IDString() string {
+	return n.value.CreatedAt().Key()
+}
+
+// RemovedAt returns the removal time of this node.
+func (n *SetNode) RemovedAt() *time.Ticket {
+	return n.value.RemovedAt()
+}
+
+// DataSize returns the data usage of this node.
+func (n *SetNode) DataSize() resource.DataSize {
+	return n.value.DataSize()
+}
+
+// Set represents a set of primitive values with observed-remove semantics.
+// Removing a value removes only the additions of the value that have been
+// observed, so the concurrent addition of the value wins over the removal.
+type Set struct {
+	// nodes is the nodes of this set in the order of their creation time.
+	nodes              []*SetNode
+	nodeMapByCreatedAt map[string]*SetNode
+	createdAt          *time.Ticket
+	movedAt            *time.Ticket
+	removedAt          *time.Ticket
+}
+
+// NewSet creates a new instance of Set.
+func NewSet(createdAt *time.Ticket) *Set {
+	return &Set{
+		nodeMapByCreatedAt: make(map[string]*SetNode),
+		createdAt:          createdAt,
+	}
+}
+
+// Add adds the given value to this set. Adding the value of the same creation
+// time again has no effect.
+func (s *Set) Add(value *Primitive) *SetNode {
+	if node, ok := s.nodeMapByCreatedAt[value.CreatedAt().Key()]; ok {
+		return node
+	}
+
+	node := &SetNode{value: value}
+	idx := sort.Search(len(s.nodes), func(i int) bool {
+		return s.nodes[i].value.CreatedAt().After(value.CreatedAt())
+	})
+	s.nodes = append(s.nodes, nil)
+	copy(s.nodes[idx+1:], s.nodes[idx:])
+	s.nodes[idx] = node
+	s.nodeMapByCreatedAt[value.CreatedAt().Key()] = node
+
+	return node
+}
+
+// Delete removes the values of the given creation times from this set. It
+// returns the pairs of the newly removed nodes for garbage collection. The
+// values that have already been purged are skipped.
+func (s *Set) Delete(createdAts []*time.Ticket, executedAt *time.Ticket) []GCPair {
+	var pairs []GCPair
+	for _, createdAt := range createdAts {
+		node, ok := s.nodeMapByCreatedAt[createdAt.Key()]
+		if !ok {
+			continue
+		}
+
+		// NOTE: The latest removal time is kept so that replicas converge even
+		// if the value is removed concurrently. The node is registered for
+		// garbage collection only once.
+		wasRemoved := node.RemovedAt() != nil
+		if node.value.Remove(executedAt) && !wasRemoved {
+			pairs = append(pairs, GCPair{
+				Parent: s,
+				Child:  node,
+			})
+		}
+	}
+
+	return pairs
+}
+
+// Has returns whether this set has the live value equal to the given value.
+func (s *Set) Has(value *Primitive) bool {
+	return len(s.NodesOf(value)) > 0
+}
+
+// NodesOf returns the live nodes of the value equal to the given value.
+func (s *Set) NodesOf(value *Primitive) []*SetNode {
+	var nodes []*SetNode
+	for _, node := range s.nodes {
+		if node.RemovedAt() == nil && isSameValue(node.value, value) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// Elements returns the distinct live values of this set in the order of
+// their first addition.
+func (s *Set) Elements() []*Primitive {
+	var elements []*Primitive
+	for _, node := range s.nodes {
+		if node.RemovedAt() != nil {
+			continue
+		}
+
+		duplicated := false
+		for _, elem := range elements {
+			if isSameValue(elem, node.value) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			elements = append(elements, node.value)
+		}
+	}
+	return elements
+}
+
+// Len returns the number of the distinct live values of this set.
+func (s *Set) Len() int {
+	return len(s.Elements())
+}
+
+// Nodes returns the nodes of this set including removed nodes.
+func (s *Set) Nodes() []*SetNode {
+	return s.nodes
+}
+
+// Purge physically purges the given node.
+func (s *Set) Purge(child GCChild) error {
+	node, ok := child.(*SetNode)
+	if !ok {
+		return fmt.Errorf("purge %s: %w", child.IDString(), ErrChildNotFound)
+	}
+	if _, ok := s.nodeMapByCreatedAt[node.IDString()]; !ok {
+		return fmt.Errorf("purge %s: %w", child.IDString(), ErrChildNotFound)
+	}
+
+	for i, n := range s.nodes {
+		if n == node {
+			s.nodes = append(s.nodes[:i], s.nodes[i+1:]...)
+			break
+		}
+	}
+	delete(s.nodeMapByCreatedAt, node.IDString())
+
+	return nil
+}
+
+// GCPairs returns the pairs of GC.
+func (s *Set) GCPairs() []GCPair {
+	var pairs []GCPair
+	for _, node := range s.nodes {
+		if node.RemovedAt() != nil {
+			pairs = append(pairs, GCPair{
+				Parent: s,
+				Child:  node,
+			})
+		}
+	}
+	return pairs
+}
+
+// Marshal returns the JSON encoding of this set.
+func (s *Set) Marshal() string {
+	var values []string
+	for _, elem := range s.Elements() {
+		values = append(values, elem.Marshal())
+	}
+	return fmt.Sprintf("[%s]", strings.Join(values, ","))
+}
+
+// DeepCopy copies itself deeply.
+func (s *Set) DeepCopy() (Element, error) {
+	set := NewSet(s.createdAt)
+	for _, node := range s.nodes {
+		value, err := node.value.DeepCopy()
+		if err != nil {
+			return nil, err
+		}
+		set.Add(value.(*Primitive))
+	}
+	set.movedAt = s.movedAt
+	set.removedAt = s.removedAt
+	return set, nil
+}
+
+// CreatedAt returns the creation time of this set.
+func (s *Set) CreatedAt() *time.Ticket {
+	return s.createdAt
+}
+
+// MovedAt returns the move time of this set.
+func (s *Set) MovedAt() *time.Ticket {
+	return s.movedAt
+}
+
+// SetMovedAt sets the move time of this set.
+func (s *Set) SetMovedAt(movedAt *time.Ticket) {
+	s.movedAt = movedAt
+}
+
+// RemovedAt returns the removal time of this set.
+func (s *Set) RemovedAt() *time.Ticket {
+	return s.removedAt
+}
+
+// SetRemovedAt sets the removal time of this set.
+func (s *Set) SetRemovedAt(removedAt *time.Ticket) {
+	s.removedAt = removedAt
+}
+
+// Remove removes this set.
+func (s *Set) Remove(removedAt *time.Ticket) bool {
+	if (removedAt != nil && removedAt.After(s.createdAt)) &&
+		(s.removedAt == nil || removedAt.After(s.removedAt)) {
+		s.removedAt = removedAt
+		return true
+	}
+	return false
+}
+
+// MetaSize returns the size of the metadata of this set.
+func (s *Set) MetaSize() int {
+	size := 0
+	if s.createdAt != nil {
+		size += time.TicketSize
+	}
+	if s.movedAt != nil {
+		size += time.TicketSize
+	}
+	if s.removedAt != nil {
+		size += time.TicketSize
+	}
+	return size
+}
+
+// DataSize returns the data usage of this set. The removed nodes are counted
+// as garbage separately.
+func (s *Set) DataSize() resource.DataSize {
+	dataSize := resource.DataSize{
+		Data: 0,
+		Meta: s.MetaSize(),
+	}
+	for _, node := range s.nodes {
+		if node.RemovedAt() == nil {
+			size := node.DataSize()
+			dataSize.Data += size.Data
+			dataSize.Meta += size.Meta
+		}
+	}
+	return dataSize
+}
+
+// isSameValue returns whether the given primitives have the same value.
+func isSameValue(a, b *Primitive) bool {
+	return a.ValueType() == b.ValueType() && bytes.Equal(a.Bytes(), b.Bytes())
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestSet(t *testing.T) {
+	t.Run("add and delete test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		newPrimitive := func(value interface{}) *crdt.Primitive {
+			primitive, err := crdt.NewPrimitive(value, ctx.IssueTimeTicket())
+			assert.NoError(t, err)
+			return primitive
+		}
+
+		set := crdt.NewSet(ctx.IssueTimeTicket())
+		a1 := set.Add(newPrimitive("a"))
+		set.Add(newPrimitive("b"))
+		a2 := set.Add(newPrimitive("a"))
+		set.Add(newPrimitive(1))
+		assert.Equal(t, `["a","b",1]`, set.Marshal())
+		assert.Equal(t, 3, set.Len())
+
+		// 01. Adding the value of the same creation time again has no effect.
+		assert.Equal(t, a1, set.Add(a1.Value()))
+		assert.Len(t, set.Nodes(), 4)
+
+		// 02. The value remains until all of its additions are deleted.
+		pairs := set.Delete([]*time.Ticket{a1.Value().CreatedAt()}, ctx.IssueTimeTicket())
+		assert.Len(t, pairs, 1)
+		assert.True(t, set.Has(newPrimitive("a")))
+		assert.Equal(t, `["b","a",1]`, set.Marshal())
+
+		pairs = set.Delete([]*time.Ticket{a2.Value().CreatedAt()}, ctx.IssueTimeTicket())
+		assert.Len(t, pairs, 1)
+		assert.False(t, set.Has(newPrimitive("a")))
+		assert.Equal(t, `["b",1]`, set.Marshal())
+
+		// 03. Deleting the deleted value again is not registered for GC twice.
+		pairs = set.Delete([]*time.Ticket{a2.Value().CreatedAt()}, ctx.IssueTimeTicket())
+		assert.Len(t, pairs, 0)
+		assert.Len(t, set.GCPairs(), 2)
+
+		// 04. Purging removes the nodes physically.
+		for _, pair := range set.GCPairs() {
+			assert.NoError(t, set.Purge(pair.Child))
+		}
+		assert.Len(t, set.Nodes(), 2)
+		assert.ErrorIs(t, set.Purge(a1), crdt.ErrChildNotFound)
+	})
+}
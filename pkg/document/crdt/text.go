@@ -102,6 +102,12 @@ func (t *TextValue) DataSize() resource.DataSize {
 	return dataSize
 }
 
+// substring returns the value between the given offsets in UTF-16 code units.
+func (t *TextValue) substring(from, to int) string {
+	encoded := utf16.Encode([]rune(t.value))
+	return string(utf16.Decode(encoded[from:to]))
+}
+
 // Split splits this value by the given offset.
 func (t *TextValue) Split(offset int) RGATreeSplitValue {
 	value := t.value
@@ -159,6 +165,7 @@ func InitialTextNode() *RGATreeSplitNode[*TextValue] {
 // Text is an extended data type for the contents of a text editor.
 type Text struct {
 	rgaTreeSplit *RGATreeSplit[*TextValue]
+	marks        []*TextMark
 	createdAt    *time.Ticket
 	movedAt      *time.Ticket
 	removedAt    *time.Ticket
@@ -218,6 +225,12 @@ func (t *Text) DataSize() resource.DataSize {
 		}
 	}
 
+	for _, mark := range t.marks {
+		size := mark.DataSize()
+		dataSize.Data += size.Data
+		dataSize.Meta += size.Meta
+	}
+
 	return resource.DataSize{
 		Data: dataSize.Data,
 		Meta: dataSize.Meta + t.MetaSize(),
@@ -228,6 +241,19 @@ func (t *Text) DataSize() resource.DataSize {
 func (t *Text) Marshal() string {
 	var values []string
 
+	// NOTE: If there are marks, the text is split by the marks and the
+	// attributes of the marks are rendered with the attributes of the nodes.
+	if len(t.marks) > 0 {
+		for _, span := range t.Spans() {
+			attrs := NewRHT()
+			for key, value := range span.Attributes {
+				attrs.Set(key, value, time.InitialTicket)
+			}
+			values = append(values, NewTextValue(span.Value, attrs).Marshal())
+		}
+		return fmt.Sprintf("[%s]", strings.Join(values, ","))
+	}
+
 	node := t.rgaTreeSplit.initialHead.next
 	for node != nil {
 		if node.createdAt().Compare(t.createdAt) != 0 && node.removedAt == nil {
@@ -256,7 +282,13 @@ func (t *Text) DeepCopy() (Element, error) {
 		}
 	}
 
-	return NewText(rgaTreeSplit, t.createdAt), nil
+	text := NewText(rgaTreeSplit, t.createdAt)
+	for _, mark := range t.marks {
+		copied := *mark
+		text.marks = append(text.marks, &copied)
+	}
+
+	return text, nil
 }
 
 // GCPairs returns the pairs of GC.
@@ -265,7 +297,7 @@ func (t *Text) GCPairs() []GCPair {
 	for _, node := range t.Nodes() {
 		if node.removedAt != nil {
 			pairs = append(pairs, GCPair{
-				Parent: t.rgaTreeSplit,
+				Parent: t,
 				Child:  node,
 			})
 		}
@@ -318,6 +350,25 @@ func (t *Text) CreateRange(from, to int) (*RGATreeSplitNodePos, *RGATreeSplitNod
 	return t.rgaTreeSplit.createRange(from, to)
 }
 
+// IndexToPos returns the position of the given integer offset. The position
+// refers to the character on the left of the offset, so that it stays anchored
+// even if the text is edited concurrently.
+func (t *Text) IndexToPos(index int) (*RGATreeSplitNodePos, error) {
+	return t.rgaTreeSplit.findNodePos(index)
+}
+
+// PosToIndex returns the integer offset of the given position. If the
+// character of the position has been removed, it returns the offset where the
+// character was.
+func (t *Text) PosToIndex(pos *RGATreeSplitNodePos) (int, error) {
+	return t.rgaTreeSplit.posToIndex(pos)
+}
+
+// FindIndexesFromRange returns the integer offsets of the given range.
+func (t *Text) FindIndexesFromRange(from, to *RGATreeSplitNodePos) (int, int, error) {
+	return t.rgaTreeSplit.findIndexesFromRange(from, to)
+}
+
 // Edit edits the given range with the given content and attributes.
 func (t *Text) Edit(
 	from,
@@ -332,13 +383,32 @@ func (t *Text) Edit(
 		val.attrs.Set(key, value, executedAt)
 	}
 
-	return t.rgaTreeSplit.edit(
+	pos, pairs, err := t.rgaTreeSplit.edit(
 		from,
 		to,
 		val,
 		executedAt,
 		versionVector,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range pairs {
+		pairs[i].Parent = t
+	}
+	return pos, pairs, nil
+}
+
+// Purge physically purges the given node from this Text. The nodes that
+// anchor marks are kept as tombstones, so that the marks keep their
+// boundaries.
+func (t *Text) Purge(child GCChild) error {
+	if node, ok := child.(*RGATreeSplitNode[*TextValue]); ok && t.isMarkAnchor(node) {
+		return nil
+	}
+
+	return t.rgaTreeSplit.Purge(child)
 }
 
 // Style applies the given attributes of the given range.
@@ -349,6 +419,64 @@ func (t *Text) Style(
 	executedAt *time.Ticket,
 	versionVector time.VersionVector,
 ) ([]GCPair, error) {
+	toBeStyled, err := t.findNodesToStyle(from, to, executedAt, versionVector)
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs []GCPair
+	for _, node := range toBeStyled {
+		val := node.value
+		for key, value := range attributes {
+			if rhtNode := val.attrs.Set(key, value, executedAt); rhtNode != nil {
+				pairs = append(pairs, GCPair{
+					Parent: node.Value(),
+					Child:  rhtNode,
+				})
+			}
+		}
+	}
+
+	return pairs, nil
+}
+
+// RemoveStyle removes the given attributes of the given range.
+func (t *Text) RemoveStyle(
+	from,
+	to *RGATreeSplitNodePos,
+	attributesToRemove []string,
+	executedAt *time.Ticket,
+	versionVector time.VersionVector,
+) ([]GCPair, error) {
+	toBeStyled, err := t.findNodesToStyle(from, to, executedAt, versionVector)
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs []GCPair
+	for _, node := range toBeStyled {
+		val := node.value
+		for _, key := range attributesToRemove {
+			for _, rhtNode := range val.attrs.Remove(key, executedAt) {
+				pairs = append(pairs, GCPair{
+					Parent: node.Value(),
+					Child:  rhtNode,
+				})
+			}
+		}
+	}
+
+	return pairs, nil
+}
+
+// findNodesToStyle splits nodes with the given range and returns the nodes
+// that can be styled.
+func (t *Text) findNodesToStyle(
+	from,
+	to *RGATreeSplitNodePos,
+	executedAt *time.Ticket,
+	versionVector time.VersionVector,
+) ([]*RGATreeSplitNode[*TextValue], error) {
 	// 01. Split nodes with from and to
 	_, toRight, err := t.rgaTreeSplit.findNodeWithSplit(to, executedAt)
 	if err != nil {
@@ -359,7 +487,7 @@ func (t *Text) Style(
 		return nil, err
 	}
 
-	// 02. style nodes between from and to
+	// 02. Find nodes between from and to
 	nodes := t.rgaTreeSplit.findBetween(fromRight, toRight)
 	isVersionVectorEmpty := len(versionVector) == 0
 
@@ -387,20 +515,85 @@ func (t *Text) Style(
 		}
 	}
 
-	var pairs []GCPair
-	for _, node := range toBeStyled {
-		val := node.value
-		for key, value := range attributes {
-			if rhtNode := val.attrs.Set(key, value, executedAt); rhtNode != nil {
-				pairs = append(pairs, GCPair{
-					Parent: node.Value(),
-					Child:  rhtNode,
-				})
-			}
+	return toBeStyled, nil
+}
+
+// TextFragment is a live fragment of Text between two positions. It is used
+// to restore the content and the attributes of the range.
+type TextFragment struct {
+	// From is the position right before the fragment.
+	From *RGATreeSplitNodePos
+
+	// To is the position right after the fragment.
+	To *RGATreeSplitNodePos
+
+	// Value is the content of the fragment.
+	Value string
+
+	// Attributes is the attributes of the fragment.
+	Attributes map[string]string
+}
+
+// Fragments splits nodes with the given range and returns the position right
+// before the range and the live fragments in the range.
+func (t *Text) Fragments(
+	from,
+	to *RGATreeSplitNodePos,
+	executedAt *time.Ticket,
+) (*RGATreeSplitNodePos, []TextFragment, error) {
+	_, toRight, err := t.rgaTreeSplit.findNodeWithSplit(to, executedAt)
+	if err != nil {
+		return nil, nil, err
+	}
+	fromLeft, fromRight, err := t.rgaTreeSplit.findNodeWithSplit(from, executedAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fragments []TextFragment
+	for _, node := range t.rgaTreeSplit.findBetween(fromRight, toRight) {
+		if node.removedAt != nil {
+			continue
 		}
+
+		fragments = append(fragments, TextFragment{
+			From:       NewRGATreeSplitNodePos(node.prev.id, node.prev.contentLen()),
+			To:         NewRGATreeSplitNodePos(node.id, node.contentLen()),
+			Value:      node.value.value,
+			Attributes: node.value.attrs.Elements(),
+		})
 	}
 
-	return pairs, nil
+	return NewRGATreeSplitNodePos(fromLeft.id, fromLeft.contentLen()), fragments, nil
+}
+
+// InsertedFragments returns the live fragments of this Text inserted at the
+// given time. Adjacent nodes of the insertion are merged into one fragment,
+// while the ones separated by other nodes are returned separately.
+func (t *Text) InsertedFragments(createdAt *time.Ticket) []TextFragment {
+	var fragments []TextFragment
+	var last *RGATreeSplitNode[*TextValue]
+	for node := t.rgaTreeSplit.initialHead.next; node != nil; node = node.next {
+		if node.removedAt != nil || node.id.createdAt.Compare(createdAt) != 0 {
+			continue
+		}
+
+		if last != nil && last.next == node {
+			fragment := &fragments[len(fragments)-1]
+			fragment.To = NewRGATreeSplitNodePos(node.id, node.contentLen())
+			fragment.Value += node.value.value
+		} else {
+			fragments = append(fragments, TextFragment{
+				From:       NewRGATreeSplitNodePos(node.prev.id, node.prev.contentLen()),
+				To:         NewRGATreeSplitNodePos(node.id, node.contentLen()),
+				Value:      node.value.value,
+				Attributes: node.value.attrs.Elements(),
+			})
+		}
+		last = node
+	}
+
+	return fragments
 }
 
 // Nodes returns the internal nodes of this Text.
@@ -0,0 +1,476 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/resource"
+)
+
+// ErrEmptyMarkRange is returned when a mark is applied to an empty range.
+var ErrEmptyMarkRange = errors.New("mark range should not be empty")
+
+// MarkExpand represents whether a mark expands to the text inserted at its
+// boundaries.
+type MarkExpand int
+
+const (
+	// MarkExpandNone means that the mark does not expand to the text inserted
+	// at its boundaries, e.g. links.
+	MarkExpandNone MarkExpand = iota
+
+	// MarkExpandBefore means that the mark expands to the text inserted at
+	// its start.
+	MarkExpandBefore
+
+	// MarkExpandAfter means that the mark expands to the text inserted at its
+	// end, e.g. bold.
+	MarkExpandAfter
+
+	// MarkExpandBoth means that the mark expands to the text inserted at both
+	// of its boundaries.
+	MarkExpandBoth
+)
+
+// TextMarkAnchor is a boundary of a mark. It is attached to the gap right
+// before or right after a character, regardless of whether the character
+// has been removed.
+type TextMarkAnchor struct {
+	// id is the ID of the character.
+	id *RGATreeSplitNodeID
+
+	// after represents whether the anchor is after the character.
+	after bool
+}
+
+// NewTextMarkAnchor creates a new instance of TextMarkAnchor.
+func NewTextMarkAnchor(id *RGATreeSplitNodeID, after bool) *TextMarkAnchor {
+	return &TextMarkAnchor{id: id, after: after}
+}
+
+// ID returns the ID of the character of this anchor.
+func (a *TextMarkAnchor) ID() *RGATreeSplitNodeID {
+	return a.id
+}
+
+// After returns whether this anchor is after the character.
+func (a *TextMarkAnchor) After() bool {
+	return a.after
+}
+
+// TextMark is a mark applied to a range of Text. Unlike the attributes set by
+// Style, a mark is anchored to the boundaries of the range, so the text
+// inserted at the boundaries concurrently has the same mark in all replicas.
+type TextMark struct {
+	// id is the time the mark was applied. Later marks win over earlier marks
+	// of the same key.
+	id *time.Ticket
+
+	key   string
+	value string
+
+	expand MarkExpand
+
+	// mergeable represents whether the mark is merged with other marks of the
+	// same key, like bold. Non-mergeable marks such as comments are kept
+	// separately by their IDs even if they overlap.
+	mergeable bool
+
+	// removed represents whether the mark removes the earlier marks of the
+	// same key in its range.
+	removed bool
+
+	// start and end are the boundaries of the mark. nil means the start or
+	// the end of the text.
+	start *TextMarkAnchor
+	end   *TextMarkAnchor
+}
+
+// NewTextMark creates a new instance of TextMark.
+func NewTextMark(
+	id *time.Ticket,
+	key, value string,
+	expand MarkExpand,
+	mergeable, removed bool,
+	start, end *TextMarkAnchor,
+) *TextMark {
+	return &TextMark{
+		id:        id,
+		key:       key,
+		value:     value,
+		expand:    expand,
+		mergeable: mergeable,
+		removed:   removed,
+		start:     start,
+		end:       end,
+	}
+}
+
+// ID returns the ID of this mark.
+func (m *TextMark) ID() *time.Ticket {
+	return m.id
+}
+
+// Key returns the key of this mark.
+func (m *TextMark) Key() string {
+	return m.key
+}
+
+// Value returns the value of this mark.
+func (m *TextMark) Value() string {
+	return m.value
+}
+
+// Expand returns the expand behavior of this mark.
+func (m *TextMark) Expand() MarkExpand {
+	return m.expand
+}
+
+// Mergeable returns whether this mark is merged with the other marks of the
+// same key.
+func (m *TextMark) Mergeable() bool {
+	return m.mergeable
+}
+
+// Removed returns whether this mark removes the earlier marks of the same key.
+func (m *TextMark) Removed() bool {
+	return m.removed
+}
+
+// Start returns the start anchor of this mark.
+func (m *TextMark) Start() *TextMarkAnchor {
+	return m.start
+}
+
+// End returns the end anchor of this mark.
+func (m *TextMark) End() *TextMarkAnchor {
+	return m.end
+}
+
+// AttributeKey returns the key of the attribute that this mark renders to.
+// Non-mergeable marks have their IDs in the key to be kept separately.
+func (m *TextMark) AttributeKey() string {
+	if m.mergeable {
+		return m.key
+	}
+	return m.key + ":" + m.id.Key()
+}
+
+// withID returns a copy of this mark with the given ID.
+func (m *TextMark) withID(id *time.Ticket) *TextMark {
+	mark := *m
+	mark.id = id
+	return &mark
+}
+
+// DataSize returns the data usage of this mark.
+func (m *TextMark) DataSize() resource.DataSize {
+	return resource.DataSize{
+		Data: len(m.key)*2 + len(m.value)*2,
+		Meta: time.TicketSize * 3,
+	}
+}
+
+// CreateMarkAnchors returns the anchors of a mark that covers the given range
+// with the given expand behavior.
+func (t *Text) CreateMarkAnchors(from, to int, expand MarkExpand) (*TextMarkAnchor, *TextMarkAnchor, error) {
+	if from >= to {
+		return nil, nil, fmt.Errorf("%d, %d: %w", from, to, ErrEmptyMarkRange)
+	}
+
+	var start, end *TextMarkAnchor
+	if expand == MarkExpandBefore || expand == MarkExpandBoth {
+		// NOTE: The text inserted at the start is placed right after the
+		// character before the range, so the mark starts after it.
+		if from > 0 {
+			id, err := t.charIDLeftOf(from)
+			if err != nil {
+				return nil, nil, err
+			}
+			start = NewTextMarkAnchor(id, true)
+		}
+	} else {
+		id, err := t.charIDLeftOf(from + 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		start = NewTextMarkAnchor(id, false)
+	}
+
+	if expand == MarkExpandAfter || expand == MarkExpandBoth {
+		if to < t.rgaTreeSplit.treeByIndex.Len() {
+			id, err := t.charIDLeftOf(to + 1)
+			if err != nil {
+				return nil, nil, err
+			}
+			end = NewTextMarkAnchor(id, false)
+		}
+	} else {
+		id, err := t.charIDLeftOf(to)
+		if err != nil {
+			return nil, nil, err
+		}
+		end = NewTextMarkAnchor(id, true)
+	}
+
+	return start, end, nil
+}
+
+// charIDLeftOf returns the ID of the live character on the left of the given
+// index.
+func (t *Text) charIDLeftOf(index int) (*RGATreeSplitNodeID, error) {
+	pos, err := t.rgaTreeSplit.findNodePos(index)
+	if err != nil {
+		return nil, err
+	}
+
+	id := pos.ID()
+	return NewRGATreeSplitNodeID(id.CreatedAt(), id.Offset()+pos.RelativeOffset()-1), nil
+}
+
+// Mark applies the given mark to this Text with the given ID. Applying the
+// same mark again has no effect.
+func (t *Text) Mark(mark *TextMark, executedAt *time.Ticket) *TextMark {
+	idx, found := slices.BinarySearchFunc(t.marks, executedAt, func(m *TextMark, id *time.Ticket) int {
+		return m.id.Compare(id)
+	})
+	if found {
+		return t.marks[idx]
+	}
+
+	mark = mark.withID(executedAt)
+	t.marks = slices.Insert(t.marks, idx, mark)
+	return mark
+}
+
+// Marks returns the marks applied to this Text in the order of their IDs.
+func (t *Text) Marks() []*TextMark {
+	return t.marks
+}
+
+// MarkRange returns the indexes of the live characters covered by the given
+// mark. If the mark covers no live characters, from equals to.
+func (t *Text) MarkRange(mark *TextMark) (int, int) {
+	from, to := -1, 0
+	index := 0
+	t.forEachMarkedPiece(func(node *RGATreeSplitNode[*TextValue], start, end int, marks []*TextMark) {
+		if node.removedAt != nil {
+			return
+		}
+		if slices.Contains(marks, mark) {
+			if from < 0 {
+				from = index
+			}
+			to = index + end - start
+		}
+		index += end - start
+	})
+
+	if from < 0 {
+		return 0, 0
+	}
+	return from, to
+}
+
+// TextSpan is a live fragment of Text that has the same attributes and
+// marks.
+type TextSpan struct {
+	// Value is the content of the span.
+	Value string
+
+	// Attributes is the attributes of the span, including the attributes of
+	// the marks.
+	Attributes map[string]string
+
+	// Marks is the effective marks of the span.
+	Marks []*TextMark
+}
+
+// Spans returns the live fragments of this Text split by their attributes
+// and marks.
+func (t *Text) Spans() []TextSpan {
+	var spans []TextSpan
+	t.forEachMarkedPiece(func(node *RGATreeSplitNode[*TextValue], start, end int, marks []*TextMark) {
+		if node.removedAt != nil {
+			return
+		}
+
+		attrs := node.value.attrs.Elements()
+		effective := effectiveMarks(marks)
+		for _, mark := range effective {
+			attrs[mark.AttributeKey()] = mark.value
+		}
+
+		value := node.value.substring(start, end)
+		last := len(spans) - 1
+		if last >= 0 && maps.Equal(spans[last].Attributes, attrs) && slices.Equal(spans[last].Marks, effective) {
+			spans[last].Value += value
+			return
+		}
+
+		spans = append(spans, TextSpan{
+			Value:      value,
+			Attributes: attrs,
+			Marks:      effective,
+		})
+	})
+	return spans
+}
+
+// forEachMarkedPiece calls the given callback for each piece of the nodes,
+// including removed nodes, that is covered by the same marks. start and end
+// are the offsets of the piece in the node.
+func (t *Text) forEachMarkedPiece(
+	callback func(node *RGATreeSplitNode[*TextValue], start, end int, marks []*TextMark),
+) {
+	ranges := t.markRanges()
+
+	ord := 0
+	for node := t.rgaTreeSplit.initialHead.next; node != nil; node = node.next {
+		length := node.contentLen()
+		cuts := []int{0, length}
+		for _, r := range ranges {
+			for _, cut := range r {
+				if ord < cut && cut < ord+length {
+					cuts = append(cuts, cut-ord)
+				}
+			}
+		}
+		sort.Ints(cuts)
+		cuts = slices.Compact(cuts)
+
+		for i := 0; i+1 < len(cuts); i++ {
+			start, end := cuts[i], cuts[i+1]
+			var marks []*TextMark
+			for j, r := range ranges {
+				if r[0] <= ord+start && ord+end <= r[1] {
+					marks = append(marks, t.marks[j])
+				}
+			}
+			callback(node, start, end, marks)
+		}
+		ord += length
+	}
+}
+
+// markRanges returns the ranges of the characters covered by the marks.
+// Characters are numbered in the order of all nodes including removed nodes,
+// and each range is [from, to).
+func (t *Text) markRanges() [][2]int {
+	if len(t.marks) == 0 {
+		return nil
+	}
+
+	ords := make(map[*TextMarkAnchor]int)
+	ord := 0
+	for node := t.rgaTreeSplit.initialHead.next; node != nil; node = node.next {
+		for _, mark := range t.marks {
+			for _, anchor := range []*TextMarkAnchor{mark.start, mark.end} {
+				if anchor != nil && anchor.id.hasSameCreatedAt(node.id) &&
+					node.id.offset <= anchor.id.offset && anchor.id.offset < node.id.offset+node.contentLen() {
+					ords[anchor] = ord + anchor.id.offset - node.id.offset
+				}
+			}
+		}
+		ord += node.contentLen()
+	}
+
+	ranges := make([][2]int, len(t.marks))
+	for i, mark := range t.marks {
+		// NOTE: The gap before the character k is 2k and the gap after it is
+		// 2k+1. The character k is covered if start <= 2k and 2k+1 <= end.
+		start, end := math.MinInt, math.MaxInt
+		if mark.start != nil {
+			start = gapOf(ords, mark.start)
+		}
+		if mark.end != nil {
+			end = gapOf(ords, mark.end)
+		}
+
+		from, to := 0, ord
+		if start != math.MinInt {
+			from = (start + 1) / 2
+		}
+		if end != math.MaxInt {
+			to = (end + 1) / 2
+		}
+		ranges[i] = [2]int{from, max(from, to)}
+	}
+	return ranges
+}
+
+func gapOf(ords map[*TextMarkAnchor]int, anchor *TextMarkAnchor) int {
+	gap := ords[anchor] * 2
+	if anchor.after {
+		gap++
+	}
+	return gap
+}
+
+// effectiveMarks returns the marks that take effect among the given marks
+// covering the same characters. The given marks should be sorted by their
+// IDs. For each key, a removing mark cancels the earlier marks, and a
+// mergeable mark overrides the earlier mergeable marks.
+func effectiveMarks(marks []*TextMark) []*TextMark {
+	var keys []string
+	marksByKey := make(map[string][]*TextMark)
+	for _, mark := range marks {
+		current, ok := marksByKey[mark.key]
+		if !ok {
+			keys = append(keys, mark.key)
+		}
+
+		switch {
+		case mark.removed:
+			current = nil
+		case mark.mergeable:
+			current = slices.DeleteFunc(current, func(m *TextMark) bool { return m.mergeable })
+			current = append(current, mark)
+		default:
+			current = append(current, mark)
+		}
+		marksByKey[mark.key] = current
+	}
+
+	sort.Strings(keys)
+	var effective []*TextMark
+	for _, key := range keys {
+		effective = append(effective, marksByKey[key]...)
+	}
+	return effective
+}
+
+// isMarkAnchor returns whether the given node has a character that anchors
+// a mark.
+func (t *Text) isMarkAnchor(node *RGATreeSplitNode[*TextValue]) bool {
+	for _, mark := range t.marks {
+		for _, anchor := range []*TextMarkAnchor{mark.start, mark.end} {
+			if anchor != nil && anchor.id.hasSameCreatedAt(node.id) &&
+				node.id.offset <= anchor.id.offset && anchor.id.offset < node.id.offset+node.contentLen() {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -88,4 +88,42 @@ func TestText(t *testing.T) {
 			text.Marshal(),
 		)
 	})
+	t.Run("index to pos and pos to index test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos, _ := text.CreateRange(0, 0)
+		_, _, err := text.Edit(fromPos, toPos, "Hello World", nil, ctx.IssueTimeTicket(), nil)
+		assert.NoError(t, err)
+
+		pos, err := text.IndexToPos(6)
+		assert.NoError(t, err)
+		idx, err := text.PosToIndex(pos)
+		assert.NoError(t, err)
+		assert.Equal(t, 6, idx)
+
+		// 01. The position should be restored from its serializable form.
+		restored, err := crdt.NewRGATreeSplitNodePosFromStruct(pos.ToStruct())
+		assert.NoError(t, err)
+		assert.True(t, pos.Equal(restored))
+
+		// 02. The position should follow the character after inserting text
+		// in front of it.
+		fromPos, toPos, _ = text.CreateRange(0, 0)
+		_, _, err = text.Edit(fromPos, toPos, "Oh, ", nil, ctx.IssueTimeTicket(), nil)
+		assert.NoError(t, err)
+		idx, err = text.PosToIndex(pos)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, idx)
+
+		// 03. The position should fall back to the removed range.
+		fromPos, toPos, _ = text.CreateRange(7, 13)
+		_, _, err = text.Edit(fromPos, toPos, "", nil, ctx.IssueTimeTicket(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "Oh, Helld", text.String())
+		idx, err = text.PosToIndex(pos)
+		assert.NoError(t, err)
+		assert.Equal(t, 7, idx)
+	})
 }
@@ -34,6 +34,10 @@ import (
 var (
 	// ErrNodeNotFound is returned when the node is not found.
 	ErrNodeNotFound = errors.New("node not found")
+
+	// ErrNodeNotMovable is returned when the node cannot be moved, e.g. the
+	// root or a text node.
+	ErrNodeNotMovable = errors.New("node not movable")
 )
 
 // TreeNodeForTest is a TreeNode for test.
@@ -138,6 +142,10 @@ type TreeNode struct {
 	id        *TreeNodeID
 	removedAt *time.Ticket
 
+	// movedAt is the time when the node was moved last. It is used instead of
+	// the creation time to order the node among its concurrent siblings.
+	movedAt *time.Ticket
+
 	InsPrevID *TreeNodeID
 	InsNextID *TreeNodeID
 
@@ -195,6 +203,26 @@ func (n *TreeNode) SetRemovedAt(ticket *time.Ticket) {
 	n.removedAt = ticket
 }
 
+// MovedAt returns the time when this node was moved last.
+func (n *TreeNode) MovedAt() *time.Ticket {
+	return n.movedAt
+}
+
+// SetMovedAt sets the time when this node was moved last.
+func (n *TreeNode) SetMovedAt(ticket *time.Ticket) {
+	n.movedAt = ticket
+}
+
+// positionedAt returns the time when this node was placed at the current
+// position.
+func (n *TreeNode) positionedAt() *time.Ticket {
+	if n.movedAt != nil {
+		return n.movedAt
+	}
+
+	return n.id.CreatedAt
+}
+
 // IsRemoved returns whether the Node is removed or not.
 func (n *TreeNode) IsRemoved() bool {
 	return n.removedAt != nil
@@ -392,6 +420,16 @@ func (n *TreeNode) remove(removedAt *time.Ticket) bool {
 	return false
 }
 
+// isDetached returns whether the node or one of its ancestors is removed.
+func (n *TreeNode) isDetached() bool {
+	for node := n.Index; node != nil; node = node.Parent {
+		if node.Value.IsRemoved() {
+			return true
+		}
+	}
+	return false
+}
+
 func (n *TreeNode) canDelete(removedAt *time.Ticket, clientLamportAtChange int64) bool {
 	nodeExisted := n.id.CreatedAt.Lamport() <= clientLamportAtChange
 	if nodeExisted &&
@@ -427,6 +465,7 @@ func (n *TreeNode) DeepCopy() (*TreeNode, error) {
 	clone := NewTreeNode(n.id, n.Type(), attrs, n.Value)
 	clone.Index.Length = n.Index.Length
 	clone.removedAt = n.removedAt
+	clone.movedAt = n.movedAt
 	clone.InsPrevID = n.InsPrevID
 	clone.InsNextID = n.InsNextID
 
@@ -535,6 +574,30 @@ type Tree struct {
 	createdAt *time.Ticket
 	movedAt   *time.Ticket
 	removedAt *time.Ticket
+
+	// moves is the log of the moves applied to this tree in the order of
+	// their execution time. It is used to undo and redo the moves when a
+	// concurrent move executed earlier arrives later. The moves seen by all
+	// replicas are removed from the log by CompactMoves.
+	moves []*TreeMoveRecord
+
+	// schema is the schema that the nodes of this tree should conform to.
+	schema *TreeSchema
+}
+
+// TreeMoveRecord is a move of a node recorded in the log of the tree.
+type TreeMoveRecord struct {
+	Target  *TreeNodeID
+	To      *TreePos
+	MovedAt *time.Ticket
+
+	// Applied is false if the move has been skipped to prevent a cycle.
+	Applied bool
+
+	// PrevTo and PrevMovedAt are the position and the move time of the target
+	// before the move, which are used to undo the move.
+	PrevTo      *TreePos
+	PrevMovedAt *time.Ticket
 }
 
 // NewTree creates a new instance of Tree.
@@ -652,7 +715,14 @@ func (t *Tree) DeepCopy() (Element, error) {
 		return nil, err
 	}
 
-	return NewTree(node, t.createdAt), nil
+	tree := NewTree(node, t.createdAt)
+	tree.schema = t.schema
+	for _, move := range t.moves {
+		m := *move
+		tree.moves = append(tree.moves, &m)
+	}
+
+	return tree, nil
 }
 
 // GCPairs returns the pairs of GC.
@@ -792,8 +862,36 @@ func (t *Tree) FindPos(offset int) (*TreePos, error) {
 	}, nil
 }
 
+// SetSchema sets the schema that the nodes of this tree should conform to.
+func (t *Tree) SetSchema(schema *TreeSchema) {
+	t.schema = schema
+}
+
+// Schema returns the schema of this tree.
+func (t *Tree) Schema() *TreeSchema {
+	return t.schema
+}
+
+// Validate validates the nodes of this tree against its schema. If the tree
+// does not have a schema, it returns nil.
+func (t *Tree) Validate() error {
+	if t.schema == nil {
+		return nil
+	}
+
+	if t.schema.Root != "" && t.Root().Type() != t.schema.Root {
+		return fmt.Errorf("root type %q: %w", t.Root().Type(), ErrTreeSchemaViolation)
+	}
+
+	return t.schema.Validate(t.Root())
+}
+
 // Edit edits the tree with the given range and content.
 // If the content is undefined, the range will be removed.
+//
+// If the tree has a schema, the local edit inserting the contents that do not
+// conform to the schema is rejected. The remote edits are applied regardless
+// of the schema to keep the replicas convergent.
 func (t *Tree) Edit(
 	from, to *TreePos,
 	contents []*TreeNode,
@@ -801,6 +899,55 @@ func (t *Tree) Edit(
 	editedAt *time.Ticket,
 	issueTimeTicket func() *time.Ticket,
 	versionVector time.VersionVector,
+) ([]GCPair, error) {
+	if t.schema != nil && len(versionVector) == 0 {
+		if err := t.validateEdit(from, contents); err != nil {
+			return nil, err
+		}
+	}
+
+	var pairs []GCPair
+	err := t.withoutConcurrentMoves(versionVector, func() error {
+		var err error
+		pairs, err = t.edit(from, to, contents, splitLevel, editedAt, issueTimeTicket, versionVector)
+		return err
+	})
+	return pairs, err
+}
+
+// validateEdit validates whether the given contents can be inserted at the
+// given position.
+//
+// NOTE: The nodes merged by deleting the boundary of element nodes are not
+// validated here, they are flagged by Validate after the edit.
+func (t *Tree) validateEdit(from *TreePos, contents []*TreeNode) error {
+	parent, left := t.ToTreeNodes(from)
+	if parent == nil || left == nil {
+		return fmt.Errorf("%p: %w", from, ErrNodeNotFound)
+	}
+	if left != parent && left.Index.Parent != nil {
+		parent = left.Index.Parent.Value
+	}
+
+	for _, content := range contents {
+		if err := t.schema.ValidateChild(parent.Type(), content.Type()); err != nil {
+			return err
+		}
+		if err := t.schema.Validate(content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *Tree) edit(
+	from, to *TreePos,
+	contents []*TreeNode,
+	splitLevel int,
+	editedAt *time.Ticket,
+	issueTimeTicket func() *time.Ticket,
+	versionVector time.VersionVector,
 ) ([]GCPair, error) {
 	// 01. find nodes from the given range and split nodes.
 	fromParent, fromLeft, err := t.FindTreeNodesWithSplitText(from, editedAt)
@@ -989,6 +1136,14 @@ func (t *Tree) split(
 func (t *Tree) traverseInPosRange(fromParent, fromLeft, toParent, toLeft *TreeNode,
 	callback func(token index.TreeToken[*TreeNode], ended bool),
 ) error {
+	// NOTE: If the range is inside a removed subtree, indexes cannot address
+	// it, so the nodes are traversed structurally. Alive nodes can be there
+	// when they were moved into the subtree by concurrent moves.
+	if fromParent.isDetached() || toParent.isDetached() {
+		t.traverseInPosRangeWithRemoved(fromParent, fromLeft, toParent, toLeft, callback)
+		return nil
+	}
+
 	fromIdx, err := t.ToIndex(fromParent, fromLeft)
 	if err != nil {
 		return err
@@ -1001,6 +1156,58 @@ func (t *Tree) traverseInPosRange(fromParent, fromLeft, toParent, toLeft *TreeNo
 	return t.IndexTree.TokensBetween(fromIdx, toIdx, callback)
 }
 
+// traverseInPosRangeWithRemoved traverses the tokens of alive nodes between
+// the given positions, including the positions inside removed nodes.
+func (t *Tree) traverseInPosRangeWithRemoved(fromParent, fromLeft, toParent, toLeft *TreeNode,
+	callback func(token index.TreeToken[*TreeNode], ended bool),
+) {
+	var tokens []index.TreeToken[*TreeNode]
+	inRange, done := false, false
+	mark := func(parent, left *TreeNode) {
+		if parent == fromParent && left == fromLeft {
+			inRange = true
+		}
+		if parent == toParent && left == toLeft {
+			inRange, done = false, true
+		}
+	}
+	emit := func(node *TreeNode, tokenType index.TokenType) {
+		if inRange && !node.IsRemoved() {
+			tokens = append(tokens, index.TreeToken[*TreeNode]{Node: node, TokenType: tokenType})
+		}
+	}
+
+	var traverse func(node *TreeNode)
+	traverse = func(node *TreeNode) {
+		if node.IsText() {
+			emit(node, index.Text)
+			return
+		}
+
+		emit(node, index.Start)
+		mark(node, node)
+		for _, child := range node.Index.Children(true) {
+			if done {
+				return
+			}
+			traverse(child.Value)
+			mark(node, child.Value)
+		}
+		emit(node, index.End)
+	}
+	traverse(t.Root())
+
+	ended := make(map[*TreeNode]bool)
+	for _, token := range tokens {
+		if token.TokenType == index.End {
+			ended[token.Node] = true
+		}
+	}
+	for _, token := range tokens {
+		callback(token, token.TokenType == index.End || ended[token.Node] && token.TokenType == index.Start)
+	}
+}
+
 // StyleByIndex applies the given attributes of the given range.
 // This method uses indexes instead of a pair of TreePos for testing.
 func (t *Tree) StyleByIndex(
@@ -1028,6 +1235,21 @@ func (t *Tree) Style(
 	attrs map[string]string,
 	editedAt *time.Ticket,
 	versionVector time.VersionVector,
+) ([]GCPair, error) {
+	var pairs []GCPair
+	err := t.withoutConcurrentMoves(versionVector, func() error {
+		var err error
+		pairs, err = t.style(from, to, attrs, editedAt, versionVector)
+		return err
+	})
+	return pairs, err
+}
+
+func (t *Tree) style(
+	from, to *TreePos,
+	attrs map[string]string,
+	editedAt *time.Ticket,
+	versionVector time.VersionVector,
 ) ([]GCPair, error) {
 	fromParent, fromLeft, err := t.FindTreeNodesWithSplitText(from, editedAt)
 	if err != nil {
@@ -1039,6 +1261,13 @@ func (t *Tree) Style(
 	}
 
 	isVersionVectorEmpty := len(versionVector) == 0
+	if t.schema != nil && isVersionVectorEmpty {
+		if err := t.validateInRange(fromParent, fromLeft, toParent, toLeft, func(node *TreeNode) error {
+			return t.schema.validateAttributes(node.Type(), sortedKeys(attrs))
+		}); err != nil {
+			return nil, err
+		}
+	}
 
 	var pairs []GCPair
 	if err = t.traverseInPosRange(fromParent, fromLeft, toParent, toLeft, func(token index.TreeToken[*TreeNode], _ bool) {
@@ -1083,6 +1312,22 @@ func (t *Tree) RemoveStyle(
 	attrs []string,
 	editedAt *time.Ticket,
 	versionVector time.VersionVector,
+) ([]GCPair, error) {
+	var pairs []GCPair
+	err := t.withoutConcurrentMoves(versionVector, func() error {
+		var err error
+		pairs, err = t.removeStyle(from, to, attrs, editedAt, versionVector)
+		return err
+	})
+	return pairs, err
+}
+
+func (t *Tree) removeStyle(
+	from *TreePos,
+	to *TreePos,
+	attrs []string,
+	editedAt *time.Ticket,
+	versionVector time.VersionVector,
 ) ([]GCPair, error) {
 	fromParent, fromLeft, err := t.FindTreeNodesWithSplitText(from, editedAt)
 	if err != nil {
@@ -1094,6 +1339,13 @@ func (t *Tree) RemoveStyle(
 	}
 
 	isVersionVectorEmpty := len(versionVector) == 0
+	if t.schema != nil && isVersionVectorEmpty {
+		if err := t.validateInRange(fromParent, fromLeft, toParent, toLeft, func(node *TreeNode) error {
+			return t.schema.validateRemoveAttributes(node.Type(), attrs)
+		}); err != nil {
+			return nil, err
+		}
+	}
 
 	var pairs []GCPair
 	if err = t.traverseInPosRange(fromParent, fromLeft, toParent, toLeft, func(token index.TreeToken[*TreeNode], _ bool) {
@@ -1132,6 +1384,272 @@ func (t *Tree) RemoveStyle(
 	return pairs, nil
 }
 
+// validateInRange calls the given validator for the element nodes in the
+// given range and returns the first error.
+func (t *Tree) validateInRange(
+	fromParent, fromLeft, toParent, toLeft *TreeNode,
+	validator func(node *TreeNode) error,
+) error {
+	var validationErr error
+	if err := t.traverseInPosRange(fromParent, fromLeft, toParent, toLeft, func(token index.TreeToken[*TreeNode], _ bool) {
+		if validationErr != nil || token.Node.IsText() {
+			return
+		}
+		validationErr = validator(token.Node)
+	}); err != nil {
+		return err
+	}
+
+	return validationErr
+}
+
+// Move moves the node of the given ID to the given position. Moves are
+// applied in the order of their execution time regardless of the order of
+// arrival: the moves executed after the given move are undone, the given move
+// is applied, and then the undone moves are redone. A move that would make
+// the node a descendant of itself is skipped, so replicas converge to the
+// same tree without cycles.
+func (t *Tree) Move(target *TreeNodeID, to *TreePos, movedAt *time.Ticket) error {
+	idx := len(t.moves)
+	for idx > 0 && t.moves[idx-1].MovedAt.After(movedAt) {
+		idx--
+	}
+
+	moves := slices.Clone(t.moves[idx:])
+	if err := t.undoMovesFrom(idx); err != nil {
+		return err
+	}
+
+	move := &TreeMoveRecord{Target: target, To: to, MovedAt: movedAt}
+	return t.redoMoves(append([]*TreeMoveRecord{move}, moves...))
+}
+
+// Moves returns the log of the moves applied to this tree.
+func (t *Tree) Moves() []*TreeMoveRecord {
+	return t.moves
+}
+
+// SetMoves sets the log of the moves applied to this tree. It is used to
+// restore the tree from a snapshot.
+func (t *Tree) SetMoves(moves []*TreeMoveRecord) {
+	t.moves = moves
+}
+
+// CompactMoves removes the moves seen by all replicas from the log, and
+// returns the number of the removed moves. A move that arrives later is
+// always executed after the moves seen by its editor, so the removed moves
+// never need to be undone.
+func (t *Tree) CompactMoves(minVersionVector time.VersionVector) int {
+	idx := 0
+	for idx < len(t.moves) && minVersionVector.EqualToOrAfter(t.moves[idx].MovedAt) {
+		idx++
+	}
+	if idx == 0 {
+		return 0
+	}
+
+	t.moves = slices.Clone(t.moves[idx:])
+	return idx
+}
+
+// withoutConcurrentMoves executes the given function in the tree where the
+// moves that have not been seen by the editor of the given version vector are
+// undone, and redoes them afterwards. It lets the range of a remote edit be
+// resolved in the tree that the editor has seen, e.g. a range that contained
+// a node moved away concurrently still covers the node.
+func (t *Tree) withoutConcurrentMoves(versionVector time.VersionVector, fn func() error) error {
+	if len(versionVector) == 0 {
+		return fn()
+	}
+
+	isSeen := func(move *TreeMoveRecord) bool {
+		lamport, ok := versionVector.Get(move.MovedAt.ActorID())
+		return ok && move.MovedAt.Lamport() <= lamport
+	}
+
+	idx := slices.IndexFunc(t.moves, func(move *TreeMoveRecord) bool {
+		return !isSeen(move)
+	})
+	if idx == -1 {
+		return fn()
+	}
+
+	// 01. Undo the moves from the first unseen one and redo the seen ones.
+	moves := slices.Clone(t.moves[idx:])
+	if err := t.undoMovesFrom(idx); err != nil {
+		return err
+	}
+	if err := t.redoMoves(slices.DeleteFunc(slices.Clone(moves), func(move *TreeMoveRecord) bool {
+		return !isSeen(move)
+	})); err != nil {
+		return err
+	}
+
+	// 02. Execute the given function and restore all the moves.
+	if err := fn(); err != nil {
+		return err
+	}
+	if err := t.undoMovesFrom(idx); err != nil {
+		return err
+	}
+	return t.redoMoves(moves)
+}
+
+// undoMovesFrom undoes the moves in the log from the given index.
+func (t *Tree) undoMovesFrom(idx int) error {
+	for i := len(t.moves) - 1; i >= idx; i-- {
+		if err := t.undoMove(t.moves[i]); err != nil {
+			return err
+		}
+	}
+	t.moves = t.moves[:idx]
+	return nil
+}
+
+// redoMoves applies the given moves and appends them to the log.
+func (t *Tree) redoMoves(moves []*TreeMoveRecord) error {
+	for _, move := range moves {
+		if err := t.applyMove(move); err != nil {
+			return err
+		}
+		t.moves = append(t.moves, move)
+	}
+	return nil
+}
+
+// applyMove applies the given move to the tree and records the previous
+// position of the target for undo.
+func (t *Tree) applyMove(move *TreeMoveRecord) error {
+	node, err := t.findMovableNode(move.Target)
+	if err != nil {
+		return err
+	}
+
+	parentNode, leftNode := t.ToTreeNodes(move.To)
+	if parentNode == nil || leftNode == nil {
+		return fmt.Errorf("%p: %w", move.To, ErrNodeNotFound)
+	}
+	realParentNode := parentNode
+	if leftNode != parentNode && leftNode.Index.Parent != nil {
+		realParentNode = leftNode.Index.Parent.Value
+	}
+
+	// NOTE: Skip the move if the target is moved next to itself or into its
+	// descendants, which would make a cycle.
+	move.Applied = false
+	if leftNode == node || node.Index.IsAncestorOf(realParentNode.Index) || node == realParentNode {
+		return nil
+	}
+
+	move.PrevTo = t.posOfNode(node)
+	move.PrevMovedAt = node.movedAt
+	if err := node.Index.Parent.DetachChild(node.Index); err != nil {
+		return err
+	}
+
+	parent, left, err := t.FindTreeNodesWithSplitText(move.To, move.MovedAt)
+	if err != nil {
+		return err
+	}
+	if err := t.attach(parent, left, node); err != nil {
+		return err
+	}
+
+	node.movedAt = move.MovedAt
+	move.Applied = true
+	return nil
+}
+
+// undoMove restores the target of the given move to its previous position.
+func (t *Tree) undoMove(move *TreeMoveRecord) error {
+	if !move.Applied {
+		return nil
+	}
+
+	node, err := t.findMovableNode(move.Target)
+	if err != nil {
+		return err
+	}
+	if err := node.Index.Parent.DetachChild(node.Index); err != nil {
+		return err
+	}
+
+	// NOTE: The node is restored right after its previous left sibling
+	// without skipping concurrent siblings, because the previous position is
+	// the exact position in the tree before the move.
+	parent, left, err := t.findTreeNodesWithSplitText(move.PrevTo)
+	if err != nil {
+		return err
+	}
+	if err := t.attach(parent, left, node); err != nil {
+		return err
+	}
+
+	node.movedAt = move.PrevMovedAt
+	return nil
+}
+
+// findMovableNode returns the element node of the given ID.
+func (t *Tree) findMovableNode(id *TreeNodeID) (*TreeNode, error) {
+	node := t.findFloorNode(id)
+	if node == nil || !node.id.Equals(id) {
+		return nil, fmt.Errorf("%s: %w", id.toIDString(), ErrNodeNotFound)
+	}
+	if node == t.Root() || node.IsText() || node.Index.Parent == nil {
+		return nil, fmt.Errorf("%s: %w", id.toIDString(), ErrNodeNotMovable)
+	}
+
+	return node, nil
+}
+
+// posOfNode returns the position of the given node in the tree.
+func (t *Tree) posOfNode(node *TreeNode) *TreePos {
+	parent := node.Index.Parent.Value
+	offset := parent.Index.OffsetOfChild(node.Index)
+	if offset == 0 {
+		return NewTreePos(parent.id, parent.id)
+	}
+
+	left := parent.Index.Children(true)[offset-1].Value
+	leftID := left.id
+	if left.IsText() {
+		leftID = NewTreeNodeID(left.id.CreatedAt, left.id.Offset+left.Length())
+	}
+
+	return NewTreePos(parent.id, leftID)
+}
+
+// attach inserts the given node after the left node in the parent node.
+func (t *Tree) attach(parent, left, node *TreeNode) error {
+	offset := 0
+	if left != parent {
+		offset = parent.Index.OffsetOfChild(left.Index) + 1
+	}
+
+	return parent.Index.AttachChild(node.Index, offset)
+}
+
+// PosOfNode returns the position of the given node, which can be used to move
+// the node back to the current position.
+func (t *Tree) PosOfNode(id *TreeNodeID) (*TreePos, error) {
+	node, err := t.findMovableNode(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.posOfNode(node), nil
+}
+
+// IndexOfNode returns the index of the start of the given node in the tree.
+func (t *Tree) IndexOfNode(id *TreeNodeID) (int, error) {
+	pos, err := t.PosOfNode(id)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.posToIndex(pos)
+}
+
 // FindTreeNodesWithSplitText finds TreeNode of the given crdt.TreePos and
 // splits the text node if the position is in the middle of the text node.
 // crdt.TreePos is a position in the CRDT perspective. This is different
@@ -1140,6 +1658,35 @@ func (t *Tree) RemoveStyle(
 func (t *Tree) FindTreeNodesWithSplitText(pos *TreePos, editedAt *time.Ticket) (
 	*TreeNode, *TreeNode, error,
 ) {
+	realParentNode, leftNode, err := t.findTreeNodesWithSplitText(pos)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 04. Find the appropriate left node. If some nodes are inserted or moved
+	// at the same position concurrently, then we need to find the appropriate
+	// left node. This is similar to RGA.
+	idx := 0
+	if leftNode != realParentNode {
+		idx = realParentNode.Index.OffsetOfChild(leftNode.Index) + 1
+	}
+
+	parentChildren := realParentNode.Index.Children(true)
+	for i := idx; i < len(parentChildren); i++ {
+		next := parentChildren[i].Value
+		if !next.positionedAt().After(editedAt) {
+			break
+		}
+		leftNode = next
+	}
+
+	return realParentNode, leftNode, nil
+}
+
+// findTreeNodesWithSplitText finds the exact parent and left sibling nodes of
+// the given position in the current tree, and splits the text node if the
+// position is in the middle of it.
+func (t *Tree) findTreeNodesWithSplitText(pos *TreePos) (*TreeNode, *TreeNode, error) {
 	// 01. Find the parent and left sibling nodes of the given position.
 	parentNode, leftNode := t.ToTreeNodes(pos)
 	if parentNode == nil || leftNode == nil {
@@ -1162,23 +1709,6 @@ func (t *Tree) FindTreeNodesWithSplitText(pos *TreePos, editedAt *time.Ticket) (
 		}
 	}
 
-	// 04. Find the appropriate left node. If some nodes are inserted at the
-	// same position concurrently, then we need to find the appropriate left
-	// node. This is similar to RGA.
-	idx := 0
-	if !isLeftMost {
-		idx = realParentNode.Index.OffsetOfChild(leftNode.Index) + 1
-	}
-
-	parentChildren := realParentNode.Index.Children(true)
-	for i := idx; i < len(parentChildren); i++ {
-		next := parentChildren[i].Value
-		if !next.id.CreatedAt.After(editedAt) {
-			break
-		}
-		leftNode = next
-	}
-
 	return realParentNode, leftNode, nil
 }
 
@@ -1254,6 +1784,48 @@ func (t *Tree) ToIndex(parentNode, leftSiblingNode *TreeNode) (int, error) {
 	return idx, nil
 }
 
+// FindIndexesFromRange returns the indexes of the given range without
+// splitting nodes.
+func (t *Tree) FindIndexesFromRange(from, to *TreePos) (int, int, error) {
+	fromIdx, err := t.posToIndex(from)
+	if err != nil {
+		return 0, 0, err
+	}
+	toIdx, err := t.posToIndex(to)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return fromIdx, toIdx, nil
+}
+
+// posToIndex converts the given position to the index of the tree.
+func (t *Tree) posToIndex(pos *TreePos) (int, error) {
+	parentNode, leftNode := t.ToTreeNodes(pos)
+	if parentNode == nil || leftNode == nil {
+		return 0, fmt.Errorf("%p: %w", pos, ErrNodeNotFound)
+	}
+
+	isLeftMost := parentNode == leftNode
+	if leftNode.Index.Parent != nil && !isLeftMost {
+		parentNode = leftNode.Index.Parent.Value
+	}
+
+	idx, err := t.ToIndex(parentNode, leftNode)
+	if err != nil {
+		return 0, err
+	}
+
+	// NOTE: If the position is in the middle of a text node, ToIndex returns
+	// the index of the end of the node, so we need to subtract the length of
+	// the rest of the node.
+	if !isLeftMost && leftNode.IsText() && !leftNode.IsRemoved() {
+		idx -= leftNode.Len() - (pos.LeftSiblingID.Offset - leftNode.id.Offset)
+	}
+
+	return idx, nil
+}
+
 // ToPath returns path from given CRDTTreePos
 func (t *Tree) ToPath(parentNode, leftSiblingNode *TreeNode) ([]int, error) {
 	treePos, err := t.toTreePos(parentNode, leftSiblingNode)
@@ -1323,6 +1895,21 @@ func (t *Tree) PathToPos(path []int) (*TreePos, error) {
 	return pos, nil
 }
 
+// PathToNode returns the node of the given path. Each element of the path is
+// the offset of the node among the children of its parent.
+func (t *Tree) PathToNode(path []int) (*TreeNode, error) {
+	node := t.IndexTree.Root()
+	for _, offset := range path {
+		children := node.Children()
+		if offset < 0 || offset >= len(children) {
+			return nil, fmt.Errorf("path %v: %w", path, ErrNodeNotFound)
+		}
+		node = children[offset]
+	}
+
+	return node.Value, nil
+}
+
 // ToTreeNodeForTest returns the JSON of this tree for debugging.
 func ToTreeNodeForTest(node *TreeNode) TreeNodeForTest {
 	if node.IsText() {
@@ -0,0 +1,202 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/index"
+)
+
+var (
+	// ErrTreeSchemaViolation is returned when the tree does not conform to
+	// its schema.
+	ErrTreeSchemaViolation = errors.New("tree schema violation")
+)
+
+// TreeNodeSpec describes a type of element node in the TreeSchema.
+type TreeNodeSpec struct {
+	// Content is the types of nodes that can be children of this node. A node
+	// without content is a leaf node.
+	Content []string
+
+	// Mark represents whether this node is an inline mark such as strong or
+	// link. Marks are represented as element nodes that wrap their text in
+	// Tree, and as marks of text nodes in ProseMirror.
+	Mark bool
+
+	// TextOnly represents whether this node can only contain text nodes,
+	// regardless of Content.
+	TextOnly bool
+
+	// RequiredAttributes is the attributes that this node must have.
+	RequiredAttributes []string
+
+	// Attributes is the attributes that this node can have in addition to
+	// the required ones. If it is nil, any attributes are allowed.
+	Attributes []string
+}
+
+// TreeSchema describes the node types of a Tree and how they can be nested.
+type TreeSchema struct {
+	// Root is the type of the root node.
+	Root string
+
+	// Nodes is the specs of element nodes by their type.
+	Nodes map[string]TreeNodeSpec
+}
+
+// Validate validates the given node and its descendants against this schema.
+// The removed nodes are ignored.
+func (s *TreeSchema) Validate(node *TreeNode) error {
+	if node.IsText() {
+		return nil
+	}
+
+	if err := s.validateNode(node); err != nil {
+		return err
+	}
+
+	for _, child := range node.Children() {
+		if err := s.Validate(child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateNode validates the type, the attributes and the types of the
+// children of the given element node.
+func (s *TreeSchema) validateNode(node *TreeNode) error {
+	spec, err := s.specOf(node.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, attr := range spec.RequiredAttributes {
+		if node.Attrs == nil || !node.Attrs.Has(attr) {
+			return fmt.Errorf(
+				"%q requires attribute %q: %w",
+				node.Type(), attr, ErrTreeSchemaViolation,
+			)
+		}
+	}
+
+	if node.Attrs != nil {
+		if err := s.validateAttributes(node.Type(), sortedKeys(node.Attrs.Elements())); err != nil {
+			return err
+		}
+	}
+
+	for _, child := range node.Children() {
+		if err := s.ValidateChild(node.Type(), child.Type()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateChild validates whether the node of the given parent type can
+// contain the node of the given child type.
+func (s *TreeSchema) ValidateChild(parentType, childType string) error {
+	spec, err := s.specOf(parentType)
+	if err != nil {
+		return err
+	}
+
+	if spec.TextOnly && childType != index.TextNodeType {
+		return fmt.Errorf(
+			"%q can only contain text, not %q: %w",
+			parentType, childType, ErrTreeSchemaViolation,
+		)
+	}
+	if !spec.TextOnly && !slices.Contains(spec.Content, childType) {
+		return fmt.Errorf(
+			"%q cannot contain %q: %w",
+			parentType, childType, ErrTreeSchemaViolation,
+		)
+	}
+
+	return nil
+}
+
+// validateAttributes validates whether the node of the given type can have
+// the given attributes.
+func (s *TreeSchema) validateAttributes(nodeType string, attrs []string) error {
+	spec, err := s.specOf(nodeType)
+	if err != nil {
+		return err
+	}
+	if spec.Attributes == nil {
+		return nil
+	}
+
+	for _, attr := range attrs {
+		if !slices.Contains(spec.Attributes, attr) && !slices.Contains(spec.RequiredAttributes, attr) {
+			return fmt.Errorf(
+				"%q cannot have attribute %q: %w",
+				nodeType, attr, ErrTreeSchemaViolation,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateRemoveAttributes validates whether the given attributes can be
+// removed from the node of the given type.
+func (s *TreeSchema) validateRemoveAttributes(nodeType string, attrs []string) error {
+	spec, err := s.specOf(nodeType)
+	if err != nil {
+		return err
+	}
+
+	for _, attr := range attrs {
+		if slices.Contains(spec.RequiredAttributes, attr) {
+			return fmt.Errorf(
+				"%q requires attribute %q: %w",
+				nodeType, attr, ErrTreeSchemaViolation,
+			)
+		}
+	}
+
+	return nil
+}
+
+// specOf returns the spec of the given node type.
+func (s *TreeSchema) specOf(nodeType string) (TreeNodeSpec, error) {
+	spec, ok := s.Nodes[nodeType]
+	if !ok {
+		return TreeNodeSpec{}, fmt.Errorf("unknown node type %q: %w", nodeType, ErrTreeSchemaViolation)
+	}
+
+	return spec, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
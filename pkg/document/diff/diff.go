@@ -0,0 +1,657 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package diff provides the structural differences between two revisions of
+// a document. The elements of the revisions are matched by their creation
+// time, so the differences are typed by the kind of the element.
+package diff
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+)
+
+// Type represents the type of the difference.
+type Type string
+
+const (
+	// TypeSet means that a value is set to the key of an object. Old is empty
+	// if the key is newly added.
+	TypeSet Type = "set"
+
+	// TypeDelete means that the key of an object is deleted.
+	TypeDelete Type = "delete"
+
+	// TypeInsert means that an element is inserted to an array. To is the
+	// index of the element in the new revision.
+	TypeInsert Type = "insert"
+
+	// TypeRemove means that an element is removed from an array. From is the
+	// index of the element in the old revision.
+	TypeRemove Type = "remove"
+
+	// TypeMove means that an element of an array is moved. From is the index
+	// in the old revision and To is the index in the new revision.
+	TypeMove Type = "move"
+
+	// TypeEdit means that the range [From, To) of a text in the old revision
+	// is replaced with New.
+	TypeEdit Type = "edit"
+
+	// TypeStyle means that the style of the range [From, To) of a text in the
+	// new revision is changed.
+	TypeStyle Type = "style"
+
+	// TypeTreeEdit means that the range [From, To) of a tree in the old
+	// revision is replaced with New.
+	TypeTreeEdit Type = "tree-edit"
+
+	// TypeTreeStyle means that the attributes of the node in the range
+	// [From, To) of a tree in the new revision are changed.
+	TypeTreeStyle Type = "tree-style"
+
+	// TypeIncrease means that the value of a counter is increased by Delta.
+	TypeIncrease Type = "increase"
+
+	// TypeSetAdd means that New is added to a set.
+	TypeSetAdd Type = "set-add"
+
+	// TypeSetRemove means that Old is removed from a set.
+	TypeSetRemove Type = "set-remove"
+)
+
+// Diff represents a difference of an element between two revisions.
+type Diff struct {
+	// Type is the type of the difference.
+	Type Type
+
+	// Path is the path of the element from the root, e.g. `$.todos[0]`.
+	Path string
+
+	// From and To are the indexes of the difference. Their meaning depends on
+	// the type of the difference.
+	From int
+	To   int
+
+	// Old and New are the JSON encoded values before and after the
+	// difference. For text and tree, they are the removed and inserted
+	// contents.
+	Old string
+	New string
+
+	// Attributes and AttributesToRemove are the changed attributes of the
+	// style differences.
+	Attributes         map[string]string
+	AttributesToRemove []string
+
+	// Delta is the JSON encoded amount of the counter increase.
+	Delta string
+}
+
+// Between returns the differences from the given old root to the new root.
+// The roots should be revisions of the same document.
+func Between(from, to *crdt.Root) []*Diff {
+	d := &differ{}
+	d.object("$", from.Object(), to.Object())
+	return d.diffs
+}
+
+// BetweenTexts returns the differences from the given old text to the new
+// text. The texts should be revisions of the same text.
+func BetweenTexts(from, to *crdt.Text) []*Diff {
+	d := &differ{}
+	d.text("$", from, to)
+	return d.diffs
+}
+
+// differ accumulates the differences while traversing the revisions.
+type differ struct {
+	diffs []*Diff
+}
+
+func (d *differ) add(diff *Diff) {
+	d.diffs = append(d.diffs, diff)
+}
+
+// element compares the elements of the same creation time. The element is
+// regarded as set if its type or value is changed, e.g. by setting a value
+// to an index of an array, which keeps the creation time.
+func (d *differ) element(path string, from, to crdt.Element) {
+	switch from := from.(type) {
+	case *crdt.Object:
+		if to, ok := to.(*crdt.Object); ok {
+			d.object(path, from, to)
+			return
+		}
+	case *crdt.Array:
+		if to, ok := to.(*crdt.Array); ok {
+			d.array(path, from, to)
+			return
+		}
+	case *crdt.Text:
+		if to, ok := to.(*crdt.Text); ok {
+			d.text(path, from, to)
+			return
+		}
+	case *crdt.Tree:
+		if to, ok := to.(*crdt.Tree); ok {
+			d.tree(path, from, to)
+			return
+		}
+	case *crdt.Counter:
+		if to, ok := to.(*crdt.Counter); ok {
+			d.counter(path, from, to)
+			return
+		}
+	case *crdt.Set:
+		if to, ok := to.(*crdt.Set); ok {
+			d.set(path, from, to)
+			return
+		}
+	case *crdt.Primitive:
+		if to, ok := to.(*crdt.Primitive); ok && isSamePrimitive(from, to) {
+			return
+		}
+	case *crdt.MVRegister:
+		if to, ok := to.(*crdt.MVRegister); ok && from.Marshal() == to.Marshal() {
+			return
+		}
+	}
+
+	d.add(&Diff{
+		Type: TypeSet,
+		Path: path,
+		Old:  from.Marshal(),
+		New:  to.Marshal(),
+	})
+}
+
+func isSamePrimitive(from, to *crdt.Primitive) bool {
+	return from.ValueType() == to.ValueType() && bytes.Equal(from.Bytes(), to.Bytes())
+}
+
+func (d *differ) object(path string, from, to *crdt.Object) {
+	fromMembers, toMembers := from.Members(), to.Members()
+
+	keys := make([]string, 0, len(fromMembers)+len(toMembers))
+	for key := range fromMembers {
+		keys = append(keys, key)
+	}
+	for key := range toMembers {
+		if _, ok := fromMembers[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		childPath := path + "." + key
+		fromElem, toElem := fromMembers[key], toMembers[key]
+		if toElem == nil {
+			d.add(&Diff{Type: TypeDelete, Path: childPath, Old: fromElem.Marshal()})
+		} else if fromElem == nil {
+			d.add(&Diff{Type: TypeSet, Path: childPath, New: toElem.Marshal()})
+		} else if fromElem.CreatedAt().Compare(toElem.CreatedAt()) != 0 {
+			d.add(&Diff{
+				Type: TypeSet,
+				Path: childPath,
+				Old:  fromElem.Marshal(),
+				New:  toElem.Marshal(),
+			})
+		} else {
+			d.element(childPath, fromElem, toElem)
+		}
+	}
+}
+
+func (d *differ) array(path string, from, to *crdt.Array) {
+	fromElems, toElems := from.Elements(), to.Elements()
+
+	toIndexes := make(map[string]int, len(toElems))
+	for i, elem := range toElems {
+		toIndexes[elem.CreatedAt().Key()] = i
+	}
+	fromIndexes := make(map[string]int, len(fromElems))
+	for i, elem := range fromElems {
+		fromIndexes[elem.CreatedAt().Key()] = i
+	}
+
+	// 01. Find the elements that keep their relative order. The others are
+	// regarded as moved.
+	var commons [][2]int
+	for i, elem := range fromElems {
+		if j, ok := toIndexes[elem.CreatedAt().Key()]; ok {
+			commons = append(commons, [2]int{i, j})
+		}
+	}
+	kept := longestIncreasing(commons)
+
+	for i, elem := range fromElems {
+		if _, ok := toIndexes[elem.CreatedAt().Key()]; !ok {
+			d.add(&Diff{Type: TypeRemove, Path: path, From: i, Old: elem.Marshal()})
+		}
+	}
+	for j, elem := range toElems {
+		if _, ok := fromIndexes[elem.CreatedAt().Key()]; !ok {
+			d.add(&Diff{Type: TypeInsert, Path: path, To: j, New: elem.Marshal()})
+		}
+	}
+
+	// 02. Compare the elements that exist in both revisions.
+	for k, common := range commons {
+		if !kept[k] {
+			d.add(&Diff{Type: TypeMove, Path: path, From: common[0], To: common[1]})
+		}
+		d.element(
+			path+"["+strconv.Itoa(common[1])+"]",
+			fromElems[common[0]],
+			toElems[common[1]],
+		)
+	}
+}
+
+func (d *differ) set(path string, from, to *crdt.Set) {
+	for _, value := range from.Elements() {
+		if !to.Has(value) {
+			d.add(&Diff{Type: TypeSetRemove, Path: path, Old: value.Marshal()})
+		}
+	}
+	for _, value := range to.Elements() {
+		if !from.Has(value) {
+			d.add(&Diff{Type: TypeSetAdd, Path: path, New: value.Marshal()})
+		}
+	}
+}
+
+func (d *differ) counter(path string, from, to *crdt.Counter) {
+	if from.Marshal() == to.Marshal() {
+		return
+	}
+
+	var delta string
+	switch fromValue := from.Value().(type) {
+	case int32:
+		if toValue, ok := to.Value().(int32); ok {
+			delta = strconv.FormatInt(int64(toValue-fromValue), 10)
+		}
+	case int64:
+		if toValue, ok := to.Value().(int64); ok {
+			delta = strconv.FormatInt(toValue-fromValue, 10)
+		}
+	}
+
+	d.add(&Diff{
+		Type:  TypeIncrease,
+		Path:  path,
+		Old:   from.Marshal(),
+		New:   to.Marshal(),
+		Delta: delta,
+	})
+}
+
+// textUnit is a UTF-16 code unit of a text with its identity.
+type textUnit struct {
+	key   string
+	unit  uint16
+	attrs map[string]string
+}
+
+func textUnits(text *crdt.Text) []textUnit {
+	var units []textUnit
+	for _, node := range text.Nodes() {
+		if node.RemovedAt() != nil {
+			continue
+		}
+
+		id := node.ID()
+		attrs := node.Value().Attrs().Elements()
+		for i, unit := range utf16.Encode([]rune(node.Value().Value())) {
+			units = append(units, textUnit{
+				key:   id.CreatedAt().Key() + ":" + strconv.Itoa(id.Offset()+i),
+				unit:  unit,
+				attrs: attrs,
+			})
+		}
+	}
+	return units
+}
+
+func (d *differ) text(path string, from, to *crdt.Text) {
+	fromUnits, toUnits := textUnits(from), textUnits(to)
+	fromKeys := make([]string, len(fromUnits))
+	for i, unit := range fromUnits {
+		fromKeys[i] = unit.key
+	}
+	toKeys := make([]string, len(toUnits))
+	for i, unit := range toUnits {
+		toKeys[i] = unit.key
+	}
+
+	contentOf := func(units []textUnit) string {
+		encoded := make([]uint16, len(units))
+		for i, unit := range units {
+			encoded[i] = unit.unit
+		}
+		return string(utf16.Decode(encoded))
+	}
+
+	styles := &styleGroup{path: path}
+	merge(fromKeys, toKeys, func(fromStart, fromEnd, toStart, toEnd int) {
+		styles.flush(d)
+		d.add(&Diff{
+			Type: TypeEdit,
+			Path: path,
+			From: fromStart,
+			To:   fromEnd,
+			Old:  contentOf(fromUnits[fromStart:fromEnd]),
+			New:  contentOf(toUnits[toStart:toEnd]),
+		})
+	}, func(i, j int) {
+		styles.push(d, j, j+1, fromUnits[i].attrs, toUnits[j].attrs)
+	})
+	styles.flush(d)
+}
+
+// treeToken is a token of the children of a tree node. A text node is split
+// into UTF-16 code units so that the split of the node is not regarded as a
+// difference.
+type treeToken struct {
+	key     string
+	index   int
+	size    int
+	content string
+	node    *crdt.TreeNode
+}
+
+func treeTokens(parent *crdt.TreeNode, base int) []treeToken {
+	var tokens []treeToken
+	index := base
+	for _, child := range parent.Children() {
+		if child.IsText() {
+			id := child.ID()
+			for i, unit := range utf16.Encode([]rune(child.Value)) {
+				tokens = append(tokens, treeToken{
+					key:     "t" + id.CreatedAt.Key() + ":" + strconv.Itoa(id.Offset+i),
+					index:   index + i,
+					size:    1,
+					content: string(utf16.Decode([]uint16{unit})),
+				})
+			}
+			index += child.Index.PaddedLength()
+			continue
+		}
+
+		tokens = append(tokens, treeToken{
+			key:     "e" + child.IDString(),
+			index:   index,
+			size:    child.Index.PaddedLength(),
+			content: crdt.ToXML(child),
+			node:    child,
+		})
+		index += child.Index.PaddedLength()
+	}
+	return tokens
+}
+
+func (d *differ) tree(path string, from, to *crdt.Tree) {
+	fromRoot, toRoot := from.Root(), to.Root()
+	if fromRoot.IDString() != toRoot.IDString() {
+		d.add(&Diff{
+			Type: TypeSet,
+			Path: path,
+			Old:  from.Marshal(),
+			New:  to.Marshal(),
+		})
+		return
+	}
+
+	d.treeNode(path, fromRoot, toRoot, 0, 0)
+}
+
+func (d *differ) treeNode(
+	path string,
+	fromParent, toParent *crdt.TreeNode,
+	fromBase, toBase int,
+) {
+	fromTokens, toTokens := treeTokens(fromParent, fromBase), treeTokens(toParent, toBase)
+	fromKeys := make([]string, len(fromTokens))
+	for i, token := range fromTokens {
+		fromKeys[i] = token.key
+	}
+	toKeys := make([]string, len(toTokens))
+	for i, token := range toTokens {
+		toKeys[i] = token.key
+	}
+
+	contentOf := func(tokens []treeToken) string {
+		var sb strings.Builder
+		for _, token := range tokens {
+			sb.WriteString(token.content)
+		}
+		return sb.String()
+	}
+	indexOf := func(tokens []treeToken, i, base int) int {
+		if i < len(tokens) {
+			return tokens[i].index
+		}
+		if len(tokens) > 0 {
+			return tokens[len(tokens)-1].index + tokens[len(tokens)-1].size
+		}
+		return base
+	}
+
+	merge(fromKeys, toKeys, func(fromStart, fromEnd, toStart, toEnd int) {
+		d.add(&Diff{
+			Type: TypeTreeEdit,
+			Path: path,
+			From: indexOf(fromTokens, fromStart, fromBase),
+			To:   indexOf(fromTokens, fromEnd, fromBase),
+			Old:  contentOf(fromTokens[fromStart:fromEnd]),
+			New:  contentOf(toTokens[toStart:toEnd]),
+		})
+	}, func(i, j int) {
+		fromNode, toNode := fromTokens[i].node, toTokens[j].node
+		if fromNode == nil || toNode == nil {
+			return
+		}
+
+		attributes, attributesToRemove := styleOf(attrsOf(fromNode), attrsOf(toNode))
+		if attributes != nil || attributesToRemove != nil {
+			d.add(&Diff{
+				Type:               TypeTreeStyle,
+				Path:               path,
+				From:               toTokens[j].index,
+				To:                 toTokens[j].index + 1,
+				Attributes:         attributes,
+				AttributesToRemove: attributesToRemove,
+			})
+		}
+		d.treeNode(path, fromNode, toNode, fromTokens[i].index+1, toTokens[j].index+1)
+	})
+}
+
+func attrsOf(node *crdt.TreeNode) map[string]string {
+	if node.Attrs == nil {
+		return nil
+	}
+	return node.Attrs.Elements()
+}
+
+// styleOf returns the attributes that are set or removed from the given old
+// attributes to the new attributes. It returns nils if there is no change.
+func styleOf(fromAttrs, toAttrs map[string]string) (map[string]string, []string) {
+	var attributes map[string]string
+	var attributesToRemove []string
+	for key, value := range toAttrs {
+		if prev, ok := fromAttrs[key]; !ok || prev != value {
+			if attributes == nil {
+				attributes = make(map[string]string)
+			}
+			attributes[key] = value
+		}
+	}
+	for key := range fromAttrs {
+		if _, ok := toAttrs[key]; !ok {
+			attributesToRemove = append(attributesToRemove, key)
+		}
+	}
+	sort.Strings(attributesToRemove)
+
+	return attributes, attributesToRemove
+}
+
+// styleGroup groups the adjacent ranges of a text that have the same style
+// difference.
+type styleGroup struct {
+	path string
+
+	pending   *Diff
+	signature string
+}
+
+func (g *styleGroup) push(d *differ, from, to int, fromAttrs, toAttrs map[string]string) {
+	attributes, attributesToRemove := styleOf(fromAttrs, toAttrs)
+	if attributes == nil && attributesToRemove == nil {
+		g.flush(d)
+		return
+	}
+
+	signature := signatureOf(attributes, attributesToRemove)
+	if g.pending != nil && g.pending.To == from && g.signature == signature {
+		g.pending.To = to
+		return
+	}
+
+	g.flush(d)
+	g.pending = &Diff{
+		Type:               TypeStyle,
+		Path:               g.path,
+		From:               from,
+		To:                 to,
+		Attributes:         attributes,
+		AttributesToRemove: attributesToRemove,
+	}
+	g.signature = signature
+}
+
+func (g *styleGroup) flush(d *differ) {
+	if g.pending != nil {
+		d.add(g.pending)
+		g.pending = nil
+		g.signature = ""
+	}
+}
+
+func signatureOf(attributes map[string]string, attributesToRemove []string) string {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, key := range keys {
+		sb.WriteString(strconv.Quote(key) + "=" + strconv.Quote(attributes[key]) + ";")
+	}
+	for _, key := range attributesToRemove {
+		sb.WriteString(strconv.Quote(key) + ";")
+	}
+	return sb.String()
+}
+
+// merge walks the given sequences of keys whose common keys are in the same
+// order. The edit callback is called with the ranges that only exist in one
+// of the sequences, and the common callback is called with the indexes of
+// each common key.
+func merge(
+	from, to []string,
+	edit func(fromStart, fromEnd, toStart, toEnd int),
+	common func(i, j int),
+) {
+	inFrom := make(map[string]bool, len(from))
+	for _, key := range from {
+		inFrom[key] = true
+	}
+	inTo := make(map[string]bool, len(to))
+	for _, key := range to {
+		inTo[key] = true
+	}
+
+	i, j := 0, 0
+	for i < len(from) || j < len(to) {
+		fromStart, toStart := i, j
+		for i < len(from) && !inTo[from[i]] {
+			i++
+		}
+		for j < len(to) && !inFrom[to[j]] {
+			j++
+		}
+		if fromStart != i || toStart != j {
+			edit(fromStart, i, toStart, j)
+			continue
+		}
+
+		// NOTE: The common keys are always in the same order because the
+		// elements are not moved. This is a fallback for the unexpected case.
+		if from[i] != to[j] {
+			edit(i, i+1, j, j+1)
+		} else {
+			common(i, j)
+		}
+		i++
+		j++
+	}
+}
+
+// longestIncreasing returns whether each pair is in the longest subsequence
+// whose second values are increasing. The pairs are sorted by the first
+// values.
+func longestIncreasing(pairs [][2]int) []bool {
+	kept := make([]bool, len(pairs))
+	if len(pairs) == 0 {
+		return kept
+	}
+
+	// tails[k] is the index of the pair that ends the increasing subsequence
+	// of length k+1 with the smallest second value.
+	var tails []int
+	prevs := make([]int, len(pairs))
+	for i, pair := range pairs {
+		k := sort.Search(len(tails), func(k int) bool {
+			return pairs[tails[k]][1] >= pair[1]
+		})
+		if k > 0 {
+			prevs[i] = tails[k-1]
+		} else {
+			prevs[i] = -1
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	for i := tails[len(tails)-1]; i >= 0; i = prevs[i] {
+		kept[i] = true
+	}
+	return kept
+}
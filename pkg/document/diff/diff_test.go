@@ -0,0 +1,188 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/diff"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+)
+
+// diffOf returns the differences made by the given update.
+func diffOf(t *testing.T, doc *document.Document, update func(root *json.Object)) []*diff.Diff {
+	from, err := doc.InternalDocument().Root().DeepCopy()
+	assert.NoError(t, err)
+
+	assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+		update(root)
+		return nil
+	}))
+
+	return diff.Between(from, doc.InternalDocument().Root())
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("object diff test", func(t *testing.T) {
+		doc := document.New("d1")
+		diffs := diffOf(t, doc, func(root *json.Object) {
+			root.SetString("k1", "v1")
+			root.SetNewObject("k2").SetInteger("k3", 1)
+		})
+		assert.Equal(t, []*diff.Diff{
+			{Type: diff.TypeSet, Path: "$.k1", New: `"v1"`},
+			{Type: diff.TypeSet, Path: "$.k2", New: `{"k3":1}`},
+		}, diffs)
+
+		diffs = diffOf(t, doc, func(root *json.Object) {
+			root.Delete("k1")
+			root.GetObject("k2").SetInteger("k3", 2)
+		})
+		assert.Equal(t, []*diff.Diff{
+			{Type: diff.TypeDelete, Path: "$.k1", Old: `"v1"`},
+			{Type: diff.TypeSet, Path: "$.k2.k3", Old: `1`, New: `2`},
+		}, diffs)
+
+		assert.Empty(t, diffOf(t, doc, func(root *json.Object) {}))
+	})
+
+	t.Run("array diff test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("list").AddInteger(0, 1, 2, 3)
+			return nil
+		}))
+
+		diffs := diffOf(t, doc, func(root *json.Object) {
+			list := root.GetArray("list")
+			list.Delete(1)
+			list.AddInteger(4)
+			list.MoveBefore(list.Get(0).CreatedAt(), list.Get(2).CreatedAt())
+		})
+		assert.Equal(t, `{"list":[3,0,2,4]}`, doc.Marshal())
+		assert.Equal(t, []*diff.Diff{
+			{Type: diff.TypeRemove, Path: "$.list", From: 1, Old: `1`},
+			{Type: diff.TypeInsert, Path: "$.list", To: 3, New: `4`},
+			{Type: diff.TypeMove, Path: "$.list", From: 3, To: 0},
+		}, diffs)
+	})
+
+	t.Run("counter diff test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewCounter("cnt", crdt.IntegerCnt, 1)
+			return nil
+		}))
+
+		diffs := diffOf(t, doc, func(root *json.Object) {
+			root.GetCounter("cnt").Increase(4)
+		})
+		assert.Equal(t, []*diff.Diff{
+			{Type: diff.TypeIncrease, Path: "$.cnt", Old: `1`, New: `5`, Delta: `4`},
+		}, diffs)
+	})
+
+	t.Run("array set diff test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("list").AddInteger(0, 1, 2)
+			return nil
+		}))
+
+		diffs := diffOf(t, doc, func(root *json.Object) {
+			root.GetArray("list").SetInteger(0, 99)
+		})
+		assert.Equal(t, `{"list":[99,1,2]}`, doc.Marshal())
+		assert.Equal(t, []*diff.Diff{
+			{Type: diff.TypeSet, Path: "$.list[0]", Old: `0`, New: `99`},
+		}, diffs)
+	})
+
+	t.Run("set and register diff test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewSet("tags").Add("a", "b")
+			root.SetNewRegister("reg", "v1")
+			return nil
+		}))
+
+		diffs := diffOf(t, doc, func(root *json.Object) {
+			root.GetSet("tags").Remove("a").Add("c")
+			root.GetRegister("reg").Set("v2")
+		})
+		assert.Equal(t, []*diff.Diff{
+			{Type: diff.TypeSet, Path: "$.reg", Old: `"v1"`, New: `"v2"`},
+			{Type: diff.TypeSetRemove, Path: "$.tags", Old: `"a"`},
+			{Type: diff.TypeSetAdd, Path: "$.tags", New: `"c"`},
+		}, diffs)
+
+		assert.Empty(t, diffOf(t, doc, func(root *json.Object) {}))
+	})
+
+	t.Run("text diff test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text").Edit(0, 0, "Hello World")
+			return nil
+		}))
+
+		diffs := diffOf(t, doc, func(root *json.Object) {
+			text := root.GetText("text")
+			text.Edit(0, 5, "Hi")
+			text.Style(3, 8, map[string]string{"b": "1"})
+			text.Edit(8, 8, "!")
+		})
+		assert.Equal(t, `{"text":[{"val":"Hi"},{"val":" "},{"attrs":{"b":"1"},"val":"World"},{"val":"!"}]}`, doc.Marshal())
+		assert.Equal(t, []*diff.Diff{
+			{Type: diff.TypeEdit, Path: "$.text", From: 0, To: 5, Old: "Hello", New: "Hi"},
+			{Type: diff.TypeStyle, Path: "$.text", From: 3, To: 8, Attributes: map[string]string{"b": "1"}},
+			{Type: diff.TypeEdit, Path: "$.text", From: 11, To: 11, New: "!"},
+		}, diffs)
+	})
+
+	t.Run("tree diff test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{{
+					Type:     "p",
+					Children: []json.TreeNode{{Type: "text", Value: "ab"}},
+				}},
+			})
+			return nil
+		}))
+
+		diffs := diffOf(t, doc, func(root *json.Object) {
+			tree := root.GetTree("t")
+			tree.Edit(2, 3, &json.TreeNode{Type: "text", Value: "XY"}, 0)
+			tree.Style(0, 1, map[string]string{"bold": "true"})
+			tree.Edit(5, 5, &json.TreeNode{Type: "p"}, 0)
+		})
+		assert.Equal(t, `<doc><p bold="true">aXY</p><p></p></doc>`, doc.Root().GetTree("t").ToXML())
+		assert.Equal(t, []*diff.Diff{
+			{Type: diff.TypeTreeStyle, Path: "$.t", From: 0, To: 1, Attributes: map[string]string{"bold": "true"}},
+			{Type: diff.TypeTreeEdit, Path: "$.t", From: 2, To: 3, Old: "b", New: "XY"},
+			{Type: diff.TypeTreeEdit, Path: "$.t", From: 4, To: 4, New: "<p></p>"},
+		}, diffs)
+	})
+}
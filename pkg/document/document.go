@@ -18,16 +18,24 @@
 package document
 
 import (
+	"bytes"
+	"context"
 	gojson "encoding/json"
 	"errors"
 	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/schema"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/resource"
 )
@@ -35,12 +43,31 @@ import (
 var (
 	// ErrUnsupportedPayloadType is returned when the payload is unserializable to JSON.
 	ErrUnsupportedPayloadType = errors.New("unsupported payload type")
+
+	// ErrDocumentNotRestorable is returned when the document cannot be restored
+	// from the given pack, e.g. the document already has its own changes.
+	ErrDocumentNotRestorable = errors.New("document is not restorable")
+
+	// ErrDocumentReadOnly is returned when the read-only document is updated.
+	ErrDocumentReadOnly = errors.New("document is read-only")
+
+	// ErrUpdateInProgress is returned when the document is changed by the
+	// updater of Update in other ways than the given root and presence.
+	ErrUpdateInProgress = errors.New("update of document is in progress")
 )
 
 // DocEvent represents the event that occurred in the document.
 type DocEvent struct {
 	Type      DocEventType
 	Presences map[string]innerpresence.Presence
+
+	// Operations is the information of the operations applied by the change.
+	// It is only set for LocalChangeEvent and RemoteChangeEvent.
+	Operations []OpInfo
+
+	// StreamConnection is the state of the watch stream of the document. It
+	// is only set for ConnectionChangedEvent.
+	StreamConnection StreamConnectionStatus
 }
 
 // DocEventType represents the type of the event that occurred in the document.
@@ -58,8 +85,41 @@ const (
 	// PresenceChangedEvent means that the presences of the clients who are editing
 	// the document have changed.
 	PresenceChangedEvent DocEventType = "presence-changed"
+
+	// LocalChangeEvent means that the document has been changed by the local
+	// client.
+	LocalChangeEvent DocEventType = "local-change"
+
+	// RemoteChangeEvent means that the document has been changed by the remote
+	// clients.
+	RemoteChangeEvent DocEventType = "remote-change"
+
+	// ConnectionChangedEvent means that the state of the watch stream of the
+	// document has changed, e.g. disconnected from the server or reconnected.
+	ConnectionChangedEvent DocEventType = "connection-changed"
 )
 
+// StreamConnectionStatus represents the state of the watch stream.
+type StreamConnectionStatus string
+
+const (
+	// StreamConnecting means that the watch stream is being connected.
+	StreamConnecting StreamConnectionStatus = "connecting"
+
+	// StreamConnected means that the watch stream is connected.
+	StreamConnected StreamConnectionStatus = "connected"
+
+	// StreamDisconnected means that the watch stream is disconnected.
+	StreamDisconnected StreamConnectionStatus = "disconnected"
+)
+
+// subscription is a handler subscribing to the change events of the elements
+// under the path.
+type subscription struct {
+	path    string
+	handler func(event DocEvent)
+}
+
 // BroadcastRequest represents a broadcast request that will be delivered to the client.
 type BroadcastRequest struct {
 	Topic   string
@@ -75,6 +135,19 @@ type Options struct {
 	// NOTE(hackerwins): This is temporary option. We need to remove this option
 	// after introducing the garbage collection based on the version vector.
 	DisableGC bool
+
+	// Schema is the schema that the root of the document should conform to.
+	Schema *schema.Schema
+
+	// SizeLimit is the limits on the size of the document and its changes.
+	SizeLimit SizeLimit
+
+	// ReadOnly makes the document reject the updates.
+	ReadOnly bool
+
+	// PresencePatch makes the document send only the updated keys of the
+	// presence. It should be enabled only if the server supports it.
+	PresencePatch bool
 }
 
 // WithDisableGC configures the document to disable garbage collection.
@@ -84,6 +157,22 @@ func WithDisableGC() Option {
 	}
 }
 
+// WithSchema configures the document to validate its root against the given
+// schema on every update.
+func WithSchema(s *schema.Schema) Option {
+	return func(o *Options) {
+		o.Schema = s
+	}
+}
+
+// WithSizeLimit configures the document to reject the updates exceeding the
+// given size limit.
+func WithSizeLimit(limit SizeLimit) Option {
+	return func(o *Options) {
+		o.SizeLimit = limit
+	}
+}
+
 // Document represents a document accessible to the user.
 //
 // How document works:
@@ -119,6 +208,28 @@ type Document struct {
 	broadcastEventHandlers map[string]func(
 		topic, publisher string,
 		payload []byte) error
+
+	// history is the history of the local changes to undo and redo them.
+	history *History
+
+	// subscriptions is the list of handlers subscribing to the change events.
+	subscriptions []*subscription
+
+	// mu protects the document and its subscriptions from being accessed by
+	// the user and the client synchronizing it in the background at the same
+	// time. The events are delivered after it is released, so that the
+	// handlers can read the document.
+	mu sync.RWMutex
+
+	// updater is the ID of the goroutine executing the updater of Update
+	// while holding mu, or 0. The methods called by the updater do not
+	// acquire mu again, so that they do not deadlock.
+	updater atomic.Int64
+
+	// updateCtx and updatePresence are the context and the presence of the
+	// update in progress. The updates nested in the updater are made by them.
+	updateCtx      *change.Context
+	updatePresence *presence.Presence
 }
 
 // New creates a new instance of Document.
@@ -128,7 +239,7 @@ func New(key key.Key, opts ...Option) *Document {
 		opt(&options)
 	}
 
-	return &Document{
+	doc := &Document{
 		doc:                NewInternalDocument(key),
 		options:            options,
 		events:             make(chan DocEvent, 1),
@@ -138,19 +249,50 @@ func New(key key.Key, opts ...Option) *Document {
 			topic, publisher string,
 			payload []byte) error),
 	}
+	doc.history = newHistory(doc)
+
+	return doc
 }
 
-// Update executes the given updater to update this document.
+// Update executes the given updater to update this document. The updater is
+// executed while holding the lock of the document. If Update is called again
+// by the updater, the nested updater is executed as a part of the update.
 func (d *Document) Update(
 	updater func(root *json.Object, p *presence.Presence) error,
 	msgAndArgs ...interface{},
 ) error {
+	if d.inUpdater() {
+		return updater(json.NewObject(d.updateCtx, d.cloneRoot.Object()), d.updatePresence)
+	}
+
+	event, err := d.update(updater, msgAndArgs...)
+	if err != nil {
+		return err
+	}
+
+	d.publish(event)
+	return nil
+}
+
+// update executes the given updater while holding the lock and returns the
+// event of the local change made by it.
+func (d *Document) update(
+	updater func(root *json.Object, p *presence.Presence) error,
+	msgAndArgs ...interface{},
+) (DocEvent, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
 	if d.doc.status == StatusRemoved {
-		return ErrDocumentRemoved
+		return DocEvent{}, ErrDocumentRemoved
+	}
+
+	if d.options.ReadOnly {
+		return DocEvent{}, ErrDocumentReadOnly
 	}
 
 	if err := d.ensureClone(); err != nil {
-		return err
+		return DocEvent{}, err
 	}
 
 	ctx := change.NewContext(
@@ -159,48 +301,223 @@ func (d *Document) Update(
 		d.cloneRoot,
 	)
 
-	if err := updater(
-		json.NewObject(ctx, d.cloneRoot.Object()),
-		presence.New(ctx, d.clonePresences.LoadOrStore(d.ActorID().String(), innerpresence.New())),
-	); err != nil {
+	p := d.clonePresences.LoadOrStore(d.doc.ActorID().String(), innerpresence.New())
+	proxy := presence.New(ctx, p)
+	if d.options.PresencePatch {
+		proxy = presence.NewWithPatch(ctx, p)
+	}
+
+	if err := d.runUpdater(ctx, proxy, updater); err != nil {
 		// drop cloneRoot because it is contaminated.
 		d.cloneRoot = nil
 		d.clonePresences = nil
-		return err
+		return DocEvent{}, err
 	}
 
-	if ctx.HasChange() {
-		c := ctx.ToChange()
-		if err := c.Execute(d.doc.root, d.doc.presences); err != nil {
-			return err
+	if !ctx.HasChange() {
+		return DocEvent{}, nil
+	}
+	c := ctx.ToChange()
+
+	if d.options.Schema != nil {
+		if err := d.options.Schema.Validate(d.cloneRoot.Object()); err != nil {
+			// drop cloneRoot because it does not conform to the schema.
+			d.cloneRoot = nil
+			d.clonePresences = nil
+			return DocEvent{}, err
 		}
+	}
 
-		d.doc.localChanges = append(d.doc.localChanges, c)
-		d.doc.changeID = ctx.NextID()
+	if d.options.SizeLimit.IsEnabled() {
+		if err := d.options.SizeLimit.Validate(d.doc.root.DocSize(), d.cloneRoot.DocSize()); err != nil {
+			// drop cloneRoot because it exceeds the size limit.
+			d.cloneRoot = nil
+			d.clonePresences = nil
+			return DocEvent{}, err
+		}
 	}
 
-	return nil
+	reverseOps, infos, err := executeWithReverse(c, d.doc.root, d.doc.presences)
+	if err != nil {
+		return DocEvent{}, err
+	}
+
+	d.doc.localChanges = append(d.doc.localChanges, c)
+	d.doc.changeID = ctx.NextID()
+	d.history.record(c, reverseOps)
+
+	return DocEvent{Type: LocalChangeEvent, Operations: infos}, nil
+}
+
+// runUpdater executes the given updater with the given context and presence,
+// marking the current goroutine as the one executing the updater.
+func (d *Document) runUpdater(
+	ctx *change.Context,
+	proxy *presence.Presence,
+	updater func(root *json.Object, p *presence.Presence) error,
+) error {
+	d.updateCtx, d.updatePresence = ctx, proxy
+	d.updater.Store(goroutineID())
+	defer func() {
+		d.updater.Store(0)
+		d.updateCtx, d.updatePresence = nil, nil
+	}()
+
+	return updater(json.NewObject(ctx, d.cloneRoot.Object()), proxy)
+}
+
+// SetSchema sets the schema that the root of this document should conform to.
+// If the given schema is nil, the validation is disabled.
+func (d *Document) SetSchema(s *schema.Schema) {
+	defer d.lock()()
+
+	d.options.Schema = s
+}
+
+// Schema returns the schema of this document.
+func (d *Document) Schema() *schema.Schema {
+	defer d.rlock()()
+
+	return d.options.Schema
+}
+
+// SetSizeLimit sets the limits on the size of this document and its changes.
+func (d *Document) SetSizeLimit(limit SizeLimit) {
+	defer d.lock()()
+
+	d.options.SizeLimit = limit
+}
+
+// SizeLimit returns the limits on the size of this document and its changes.
+func (d *Document) SizeLimit() SizeLimit {
+	defer d.rlock()()
+
+	return d.options.SizeLimit
+}
+
+// SetReadOnly sets whether this document rejects the updates.
+func (d *Document) SetReadOnly(readOnly bool) {
+	defer d.lock()()
+
+	d.options.ReadOnly = readOnly
+}
+
+// IsReadOnly returns whether this document rejects the updates.
+func (d *Document) IsReadOnly() bool {
+	defer d.rlock()()
+
+	return d.options.ReadOnly
+}
+
+// SetPresencePatch sets whether this document sends only the updated keys of
+// the presence instead of the whole presence.
+func (d *Document) SetPresencePatch(enabled bool) {
+	defer d.lock()()
+
+	d.options.PresencePatch = enabled
+}
+
+// History returns the history of the local changes of this document.
+func (d *Document) History() *History {
+	return d.history
+}
+
+// applyReverseOps applies the given reverse operations to this document as a
+// new local change and returns the reverse operations and the event of the
+// change. It should be called while holding the lock of the document.
+func (d *Document) applyReverseOps(ops []reverseOp) ([]reverseOp, DocEvent, error) {
+	if d.doc.status == StatusRemoved {
+		return nil, DocEvent{}, ErrDocumentRemoved
+	}
+
+	if err := d.ensureClone(); err != nil {
+		return nil, DocEvent{}, err
+	}
+
+	ctx := change.NewContext(d.doc.changeID, "", d.cloneRoot)
+	rc := &reverseContext{ctx: ctx, root: d.cloneRoot, history: d.history}
+	for i := len(ops) - 1; i >= 0; i-- {
+		if err := ops[i](rc); err != nil {
+			// drop cloneRoot because it is contaminated.
+			d.cloneRoot = nil
+			d.clonePresences = nil
+			return nil, DocEvent{}, err
+		}
+	}
+
+	if !ctx.HasChange() {
+		return nil, DocEvent{}, nil
+	}
+	c := ctx.ToChange()
+
+	if d.options.Schema != nil {
+		if err := d.options.Schema.Validate(d.cloneRoot.Object()); err != nil {
+			d.cloneRoot = nil
+			d.clonePresences = nil
+			return nil, DocEvent{}, err
+		}
+	}
+
+	if d.options.SizeLimit.IsEnabled() {
+		if err := d.options.SizeLimit.Validate(d.doc.root.DocSize(), d.cloneRoot.DocSize()); err != nil {
+			d.cloneRoot = nil
+			d.clonePresences = nil
+			return nil, DocEvent{}, err
+		}
+	}
+
+	reverseOps, infos, err := executeWithReverse(c, d.doc.root, d.doc.presences)
+	if err != nil {
+		return nil, DocEvent{}, err
+	}
+
+	d.doc.localChanges = append(d.doc.localChanges, c)
+	d.doc.changeID = ctx.NextID()
+
+	return reverseOps, DocEvent{Type: LocalChangeEvent, Operations: infos}, nil
 }
 
 // ApplyChangePack applies the given change pack into this document.
 func (d *Document) ApplyChangePack(pack *change.Pack) error {
+	events, err := d.applyChangePack(pack)
+	if err != nil {
+		return err
+	}
+
+	d.dispatch(events)
+	return nil
+}
+
+// applyChangePack applies the given change pack into this document while
+// holding the lock and returns the events of the applied changes.
+func (d *Document) applyChangePack(pack *change.Pack) ([]DocEvent, error) {
+	if d.inUpdater() {
+		return nil, ErrUpdateInProgress
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
 	// 01. Apply remote changes to both the cloneRoot and the document.
 	hasSnapshot := len(pack.Snapshot) > 0
 
+	var events []DocEvent
 	if hasSnapshot {
 		d.cloneRoot = nil
 		d.clonePresences = nil
 		if err := d.doc.applySnapshot(pack.Snapshot, pack.VersionVector); err != nil {
-			return err
+			return nil, err
 		}
 	} else {
-		if err := d.applyChanges(pack.Changes); err != nil {
-			return err
+		evs, err := d.applyChanges(pack.Changes)
+		if err != nil {
+			return nil, err
 		}
+		events = append(events, evs...)
 	}
 
 	// 02. Remove local changes applied to server.
-	for d.HasLocalChanges() {
+	for d.doc.HasLocalChanges() {
 		c := d.doc.localChanges[0]
 		if c.ClientSeq() > pack.Checkpoint.ClientSeq {
 			break
@@ -209,9 +526,11 @@ func (d *Document) ApplyChangePack(pack *change.Pack) error {
 	}
 
 	if len(pack.Snapshot) > 0 {
-		if err := d.applyChanges(d.doc.localChanges); err != nil {
-			return err
+		evs, err := d.applyChanges(d.doc.localChanges)
+		if err != nil {
+			return nil, err
 		}
+		events = append(events, evs...)
 	}
 
 	// 03. Update the checkpoint.
@@ -219,37 +538,122 @@ func (d *Document) ApplyChangePack(pack *change.Pack) error {
 
 	// 04. Do Garbage collection.
 	if !d.options.DisableGC && !hasSnapshot {
-		d.GarbageCollect(pack.VersionVector)
+		d.garbageCollect(pack.VersionVector)
 	}
 
 	// 05. Update the status.
 	if pack.IsRemoved {
-		d.SetStatus(StatusRemoved)
+		d.doc.SetStatus(StatusRemoved)
 	}
 
+	return events, nil
+}
+
+// Restore restores the local state of this document from the given pack,
+// which consists of the snapshot, checkpoint and version vector of the
+// document at the time it was last synchronized with the server, and the
+// local changes not yet sent to the server. The local changes are applied
+// again on the snapshot by the current actor, so that they can be pushed by
+// the actor even if it differs from the actor that made them.
+func (d *Document) Restore(pack *change.Pack) error {
+	events, err := d.restore(pack)
+	if err != nil {
+		return err
+	}
+
+	d.dispatch(events)
 	return nil
 }
 
-func (d *Document) applyChanges(changes []*change.Change) error {
+// restore restores the local state of this document from the given pack
+// while holding the lock and returns the events of the local changes applied
+// again.
+func (d *Document) restore(pack *change.Pack) ([]DocEvent, error) {
+	if d.inUpdater() {
+		return nil, ErrUpdateInProgress
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.doc.key != pack.DocumentKey {
+		return nil, fmt.Errorf("restore %s from pack of %s: %w", d.doc.key, pack.DocumentKey, ErrDocumentNotRestorable)
+	}
+	if d.doc.HasLocalChanges() || !d.doc.checkpoint.Equals(change.InitialCheckpoint) {
+		return nil, fmt.Errorf("restore %s: %w", d.doc.key, ErrDocumentNotRestorable)
+	}
+
+	rootObj, presences, err := converter.BytesToSnapshot(pack.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	vector := pack.VersionVector
+	lamport := int64(time.InitialLamport)
+	if len(vector) > 0 {
+		lamport = vector.MaxLamport()
+	} else {
+		vector = time.NewVersionVector()
+	}
+
+	clientSeq := pack.Checkpoint.ClientSeq
+	if len(pack.Changes) > 0 {
+		clientSeq = pack.Changes[len(pack.Changes)-1].ClientSeq()
+	}
+
+	// NOTE: The local changes were made by the previous actor of the client.
+	// Its version is handed over to the current actor, otherwise the stale
+	// entry would remain in the version vectors and hold back GC.
+	actor := d.doc.ActorID()
+	if len(pack.Changes) > 0 {
+		vector = vector.Rename(pack.Changes[0].ID().ActorID(), actor)
+	}
+
+	d.doc.root = crdt.NewRoot(rootObj)
+	d.doc.presences = presences
+	d.doc.checkpoint = pack.Checkpoint
+	d.doc.changeID = change.NewID(clientSeq, change.InitialServerSeq, lamport, actor, vector)
+	d.cloneRoot = nil
+	d.clonePresences = nil
+
+	for _, c := range pack.Changes {
+		c.SetActor(actor)
+	}
+	events, err := d.applyChanges(pack.Changes)
+	if err != nil {
+		return nil, err
+	}
+	d.doc.localChanges = pack.Changes
+
+	return events, nil
+}
+
+// applyChanges applies the given changes to both the cloneRoot and the
+// document, and returns the events of them.
+func (d *Document) applyChanges(changes []*change.Change) ([]DocEvent, error) {
 	if err := d.ensureClone(); err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, c := range changes {
 		if err := c.Execute(d.cloneRoot, d.clonePresences); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	events, err := d.doc.ApplyChanges(changes...)
-	if err != nil {
-		return err
-	}
+	return d.doc.ApplyChanges(changes...)
+}
 
+// dispatch delivers the given events to the subscriptions or the event
+// channel. It should be called after the lock of the document is released.
+func (d *Document) dispatch(events []DocEvent) {
 	for _, e := range events {
+		if e.Type == LocalChangeEvent || e.Type == RemoteChangeEvent {
+			d.publish(e)
+			continue
+		}
 		d.events <- e
 	}
-	return nil
 }
 
 // InternalDocument returns the internal document.
@@ -264,77 +668,146 @@ func (d *Document) Key() key.Key {
 
 // Checkpoint returns the checkpoint of this document.
 func (d *Document) Checkpoint() change.Checkpoint {
+	defer d.rlock()()
+
 	return d.doc.checkpoint
 }
 
 // HasLocalChanges returns whether this document has local changes or not.
 func (d *Document) HasLocalChanges() bool {
+	defer d.rlock()()
+
 	return d.doc.HasLocalChanges()
 }
 
 // Marshal returns the JSON encoding of this document.
 func (d *Document) Marshal() string {
+	defer d.rlock()()
+
 	return d.doc.Marshal()
 }
 
 // CreateChangePack creates pack of the local changes to send to the server.
 func (d *Document) CreateChangePack() *change.Pack {
+	defer d.rlock()()
+
 	return d.doc.CreateChangePack()
 }
 
+// CreateLocalStatePack creates a pack of the local state of this document to
+// be restored later. Unlike CreateChangePack, the checkpoint of the pack is
+// the one of the document, and the snapshot is included only if withSnapshot
+// is true. The state is taken at once while holding the lock, so that the
+// snapshot is consistent with the local changes.
+func (d *Document) CreateLocalStatePack(withSnapshot bool) (*change.Pack, error) {
+	defer d.rlock()()
+
+	var snapshot []byte
+	if withSnapshot {
+		var err error
+		if snapshot, err = converter.SnapshotToBytes(d.doc.RootObject(), d.doc.AllPresences()); err != nil {
+			return nil, err
+		}
+	}
+
+	return change.NewPack(
+		d.doc.key,
+		d.doc.checkpoint,
+		d.doc.localChanges,
+		d.doc.VersionVector().DeepCopy(),
+		snapshot,
+	), nil
+}
+
 // SetActor sets actor into this document. This is also applied in the local
 // changes the document has.
 func (d *Document) SetActor(actor time.ActorID) {
+	defer d.lock()()
+
 	d.doc.SetActor(actor)
 }
 
 // ActorID returns ID of the actor currently editing the document.
 func (d *Document) ActorID() time.ActorID {
+	defer d.rlock()()
+
 	return d.doc.ActorID()
 }
 
 // SetStatus updates the status of this document.
 func (d *Document) SetStatus(status StatusType) {
+	defer d.lock()()
+
 	d.doc.SetStatus(status)
 }
 
 // VersionVector returns the version vector of this document.
 func (d *Document) VersionVector() time.VersionVector {
+	defer d.rlock()()
+
 	return d.doc.VersionVector()
 }
 
 // Status returns the status of this document.
 func (d *Document) Status() StatusType {
+	defer d.rlock()()
+
 	return d.doc.status
 }
 
 // IsAttached returns whether this document is attached or not.
 func (d *Document) IsAttached() bool {
+	defer d.rlock()()
+
 	return d.doc.IsAttached()
 }
 
 // RootObject returns the internal root object of this document.
 func (d *Document) RootObject() *crdt.Object {
+	defer d.rlock()()
+
 	return d.doc.RootObject()
 }
 
 // Root returns the root object of this document.
 func (d *Document) Root() *json.Object {
-	if err := d.ensureClone(); err != nil {
+	defer d.lock()()
+
+	root, err := d.root()
+	if err != nil {
 		panic(err)
 	}
+	return root
+}
+
+// root returns the root object of the clone of this document. It should be
+// called while holding the lock of the document.
+func (d *Document) root() (*json.Object, error) {
+	if err := d.ensureClone(); err != nil {
+		return nil, err
+	}
 
 	ctx := change.NewContext(d.doc.changeID.Next(), "", d.cloneRoot)
-	return json.NewObject(ctx, d.cloneRoot.Object())
+	return json.NewObject(ctx, d.cloneRoot.Object()), nil
 }
 
 // DocSize returns the size of this document.
 func (d *Document) DocSize() resource.DocSize {
+	defer d.rlock()()
+
 	return d.doc.root.DocSize()
 }
 
 // GarbageCollect purge elements that were removed before the given time.
 func (d *Document) GarbageCollect(vector time.VersionVector) int {
+	defer d.lock()()
+
+	return d.garbageCollect(vector)
+}
+
+// garbageCollect purges elements that were removed before the given time. It
+// should be called while holding the lock of the document.
+func (d *Document) garbageCollect(vector time.VersionVector) int {
 	if d.cloneRoot != nil {
 		if _, err := d.cloneRoot.GarbageCollect(vector); err != nil {
 			panic(err)
@@ -351,6 +824,8 @@ func (d *Document) GarbageCollect(vector time.VersionVector) int {
 
 // GarbageLen returns the count of removed elements.
 func (d *Document) GarbageLen() int {
+	defer d.rlock()()
+
 	return d.doc.GarbageLen()
 }
 
@@ -372,23 +847,31 @@ func (d *Document) ensureClone() error {
 
 // MyPresence returns the presence of the actor.
 func (d *Document) MyPresence() innerpresence.Presence {
+	defer d.rlock()()
+
 	return d.doc.MyPresence()
 }
 
 // Presence returns the presence of the given client.
 // If the client is not online, it returns nil.
 func (d *Document) Presence(clientID string) innerpresence.Presence {
+	defer d.rlock()()
+
 	return d.doc.Presence(clientID)
 }
 
 // PresenceForTest returns the presence of the given client
 // regardless of whether the client is online or not.
 func (d *Document) PresenceForTest(clientID string) innerpresence.Presence {
+	defer d.rlock()()
+
 	return d.doc.PresenceForTest(clientID)
 }
 
 // Presences returns the presence map of online clients.
 func (d *Document) Presences() map[string]innerpresence.Presence {
+	defer d.rlock()()
+
 	// TODO(hackerwins): We need to use client key instead of actor ID for exposing presence.
 	return d.doc.Presences()
 }
@@ -396,21 +879,29 @@ func (d *Document) Presences() map[string]innerpresence.Presence {
 // AllPresences returns the presence map of all clients
 // regardless of whether the client is online or not.
 func (d *Document) AllPresences() map[string]innerpresence.Presence {
+	defer d.rlock()()
+
 	return d.doc.AllPresences()
 }
 
 // SetOnlineClients sets the online clients.
 func (d *Document) SetOnlineClients(clientIDs ...string) {
+	defer d.lock()()
+
 	d.doc.SetOnlineClients(clientIDs...)
 }
 
 // AddOnlineClient adds the given client to the online clients.
 func (d *Document) AddOnlineClient(clientID string) {
+	defer d.lock()()
+
 	d.doc.AddOnlineClient(clientID)
 }
 
 // RemoveOnlineClient removes the given client from the online clients.
 func (d *Document) RemoveOnlineClient(clientID string) {
+	defer d.lock()()
+
 	d.doc.RemoveOnlineClient(clientID)
 }
 
@@ -419,6 +910,66 @@ func (d *Document) Events() <-chan DocEvent {
 	return d.events
 }
 
+// PublishConnectionChanged sends the event that the state of the watch stream
+// has changed to the given status. It gives up if the given context is done
+// before the event is received.
+func (d *Document) PublishConnectionChanged(ctx context.Context, status StreamConnectionStatus) {
+	select {
+	case d.events <- DocEvent{Type: ConnectionChangedEvent, StreamConnection: status}:
+	case <-ctx.Done():
+	}
+}
+
+// Subscribe registers the given handler to receive the change events of the
+// elements under the given path, e.g. `$.todos`. The handler receives only
+// the operations applied to the path or its descendants, and is called
+// synchronously while the change is applied, so it should not update the
+// document. It returns a function to unsubscribe.
+func (d *Document) Subscribe(path string, handler func(event DocEvent)) func() {
+	defer d.lock()()
+
+	sub := &subscription{path: path, handler: handler}
+	d.subscriptions = append(d.subscriptions, sub)
+
+	return func() {
+		defer d.lock()()
+
+		var subs []*subscription
+		for _, s := range d.subscriptions {
+			if s != sub {
+				subs = append(subs, s)
+			}
+		}
+		d.subscriptions = subs
+	}
+}
+
+// publish delivers the given change event to the subscriptions whose path
+// covers the operations of the event.
+func (d *Document) publish(event DocEvent) {
+	if len(event.Operations) == 0 {
+		return
+	}
+
+	unlock := d.rlock()
+	subs := d.subscriptions
+	unlock()
+
+	for _, sub := range subs {
+		var ops []OpInfo
+		for _, op := range event.Operations {
+			if isSameOrChildOf(op.TargetPath(), sub.path) {
+				ops = append(ops, op)
+			}
+		}
+		if len(ops) == 0 {
+			continue
+		}
+
+		sub.handler(DocEvent{Type: event.Type, Operations: ops})
+	}
+}
+
 // BroadcastRequests returns the broadcast requests of this document.
 func (d *Document) BroadcastRequests() <-chan BroadcastRequest {
 	return d.broadcastRequests
@@ -473,6 +1024,51 @@ func (d *Document) setInternalDoc(internalDoc *InternalDocument) {
 	d.doc = internalDoc
 }
 
+// lock acquires the lock of this document unless it is called by the updater
+// holding it already, and returns the function to release it.
+func (d *Document) lock() func() {
+	if d.inUpdater() {
+		return func() {}
+	}
+
+	d.mu.Lock()
+	return d.mu.Unlock
+}
+
+// rlock acquires the read lock of this document unless it is called by the
+// updater holding the lock already, and returns the function to release it.
+func (d *Document) rlock() func() {
+	if d.inUpdater() {
+		return func() {}
+	}
+
+	d.mu.RLock()
+	return d.mu.RUnlock
+}
+
+// inUpdater returns whether it is called by the updater of Update in progress.
+func (d *Document) inUpdater() bool {
+	id := d.updater.Load()
+	return id != 0 && id == goroutineID()
+}
+
+// goroutineID returns the ID of the current goroutine. It is only used to
+// detect the methods called by the updater, like the lock checks of
+// golang.org/x/net/http2.
+func goroutineID() int64 {
+	var buf [64]byte
+	b := bytes.TrimPrefix(buf[:runtime.Stack(buf[:], false)], []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+
+	id, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("parse goroutine ID: %v", err))
+	}
+	return id
+}
+
 func messageFromMsgAndArgs(msgAndArgs ...interface{}) string {
 	if len(msgAndArgs) == 0 {
 		return ""
@@ -19,16 +19,22 @@ package document_test
 import (
 	"errors"
 	"fmt"
+	gosync "sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/schema"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/document/yson"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -197,6 +203,102 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, 0, doc.Root().GetArray("data").Len())
 	})
 
+	t.Run("typed set and insert of array test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			arr := root.SetNewArray("k1").AddInteger(0, 0, 0)
+			arr.SetString(0, "a").SetBool(1, true).SetDouble(2, 1.5)
+			arr.InsertLongAfter(0, 2).InsertNullAfter(3)
+			arr.InsertNewObjectAfter(0).SetString("k", "v")
+			arr.SetNewArray(4).AddInteger(1)
+			arr.InsertNewTextAfter(5).Edit(0, 0, "t")
+			arr.SetNewCounter(1, crdt.IntegerCnt, 3).Increase(1)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":["a",4,2,true,[1],null,[{"val":"t"}]]}`, doc.Marshal())
+
+		assert.Panics(t, func() {
+			_ = doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.GetArray("k1").SetString(8, "x")
+				return nil
+			})
+		})
+	})
+
+	t.Run("move of array by index test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("k1").AddInteger(0, 1, 2, 3)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+			arr := root.GetArray("k1")
+			arr.MoveFrontByIndex(2)
+			assert.Equal(t, `[2,0,1,3]`, arr.Marshal())
+			arr.MoveLastByIndex(0)
+			assert.Equal(t, `[0,1,3,2]`, arr.Marshal())
+			arr.MoveBeforeByIndex(0, 2)
+			assert.Equal(t, `[3,0,1,2]`, arr.Marshal())
+			arr.MoveLast(arr.Get(3).CreatedAt())
+			arr.MoveFront(arr.Get(0).CreatedAt())
+			arr.MoveBeforeByIndex(1, 0)
+			assert.Equal(t, `[3,0,1,2]`, arr.Marshal())
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[3,0,1,2]}`, doc.Marshal())
+	})
+
+	t.Run("move last of array test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("k1").AddInteger(0, 1, 2)
+			return nil
+		}))
+		last := doc.Root().GetArray("k1").Get(2).CreatedAt()
+
+		// NOTE: Moving to the last is moving after the last element at the
+		// time, as in the other SDKs.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("k1").MoveLastByIndex(0)
+			return nil
+		}))
+		assert.Equal(t, `{"k1":[1,2,0]}`, doc.Marshal())
+
+		changes := doc.CreateChangePack().Changes
+		ops := changes[len(changes)-1].Operations()
+		move, ok := ops[0].(*operations.Move)
+		assert.True(t, ok)
+		assert.Equal(t, last, move.PrevCreatedAt())
+	})
+
+	t.Run("splice of array test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("k1").AddInteger(0, 1, 2, 3)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+			arr := root.GetArray("k1")
+			deleted := arr.Splice(1, 2, "a", yson.Object{"k": "v"}, int64(5))
+			assert.Len(t, deleted, 2)
+			assert.Equal(t, "1", deleted[0].Marshal())
+			assert.Equal(t, "2", deleted[1].Marshal())
+
+			arr.Splice(0, 0, yson.Array{int32(1)})
+			arr.Splice(5, 10)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[[1],0,"a",{"k":"v"},5]}`, doc.Marshal())
+		assert.Len(t, doc.CreateChangePack().Changes, 2)
+	})
+
 	t.Run("text test", func(t *testing.T) {
 		doc := document.New("d1")
 
@@ -471,6 +573,325 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, `{"k1":{"k1.1":1,"k1.2":2}}`, doc.Marshal())
 	})
 
+	t.Run("schema validation test", func(t *testing.T) {
+		s, err := schema.Parse(`{
+			"type": "object",
+			"properties": {"title": {"type": "string"}, "content": {"type": "text"}},
+			"required": ["title"]
+		}`)
+		assert.NoError(t, err)
+		doc := document.New("d1", document.WithSchema(s))
+
+		// 01. The update violating the schema is rejected and rolled back.
+		err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("content").Edit(0, 0, "hello")
+			return nil
+		})
+		assert.ErrorIs(t, err, schema.ErrSchemaViolation)
+		assert.Equal(t, `{}`, doc.Marshal())
+		assert.False(t, doc.HasLocalChanges())
+
+		// 02. The update conforming to the schema is applied.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "hello")
+			root.SetNewText("content").Edit(0, 0, "world")
+			return nil
+		}))
+		assert.Equal(t, `{"content":[{"val":"world"}],"title":"hello"}`, doc.Marshal())
+
+		err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.Delete("title")
+			return nil
+		})
+		assert.ErrorIs(t, err, schema.ErrSchemaViolation)
+
+		// 03. The validation is disabled if the schema is removed.
+		doc.SetSchema(nil)
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.Delete("title")
+			return nil
+		}))
+		assert.Equal(t, `{"content":[{"val":"world"}]}`, doc.Marshal())
+	})
+
+	t.Run("size limit test", func(t *testing.T) {
+		doc := document.New("d1", document.WithSizeLimit(document.SizeLimit{
+			MaxDocumentSize: 200,
+			MaxChangeSize:   60,
+		}))
+
+		// 01. The update adding more data than the change limit is rejected.
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "hello world")
+			return nil
+		})
+		assert.ErrorIs(t, err, document.ErrChangeSizeExceeded)
+		assert.Equal(t, `{}`, doc.Marshal())
+		assert.False(t, doc.HasLocalChanges())
+
+		// 02. The update growing the document beyond the limit is rejected.
+		for _, k := range []string{"k1", "k2", "k3"} {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString(k, "v")
+				return nil
+			}))
+		}
+		err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k4", "v")
+			return nil
+		})
+		assert.ErrorIs(t, err, document.ErrDocumentSizeExceeded)
+		assert.Equal(t, `{"k1":"v","k2":"v","k3":"v"}`, doc.Marshal())
+
+		// 03. The update shrinking the document is allowed even if the
+		// document already exceeds the limit.
+		doc.SetSizeLimit(document.SizeLimit{MaxDocumentSize: 100})
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.Delete("k3")
+			return nil
+		}))
+		assert.Equal(t, `{"k1":"v","k2":"v"}`, doc.Marshal())
+	})
+
+	t.Run("read-only test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+
+		doc.SetReadOnly(true)
+		assert.True(t, doc.IsReadOnly())
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		})
+		assert.ErrorIs(t, err, document.ErrDocumentReadOnly)
+		assert.Equal(t, `{"k1":"v1"}`, doc.Marshal())
+
+		doc.SetReadOnly(false)
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, doc.Marshal())
+	})
+
+	t.Run("presence patch test", func(t *testing.T) {
+		d1, d2 := document.New("d1"), document.New("d1")
+		d1.SetActor(time.ActorID{1})
+		d2.SetActor(time.ActorID{2})
+
+		// NOTE: The changes only with presence do not increase the lamport, so
+		// the local changes of d1 are applied to d2 as a whole.
+		syncPresence := func() *change.Change {
+			pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+			assert.NoError(t, err)
+			pack, err := converter.FromChangePack(pbPack)
+			assert.NoError(t, err)
+			assert.NoError(t, d2.ApplyChangePack(pack))
+			return pack.Changes[len(pack.Changes)-1]
+		}
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			p.Set("name", "a")
+			p.SetValue("cursor", map[string]int{"x": 1})
+			return nil
+		}))
+		syncPresence()
+
+		// 01. The whole presence is put by default.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			p.SetValue("cursor", map[string]int{"x": 2})
+			return nil
+		}))
+		assert.Equal(t, &innerpresence.Change{
+			ChangeType: innerpresence.Put,
+			Presence:   innerpresence.Presence{"name": "a", "cursor": `{"x":2}`},
+		}, syncPresence().PresenceChange())
+
+		// 02. Only the updated keys are sent as a patch if it is enabled.
+		d1.SetPresencePatch(true)
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			p.SetValue("cursor", map[string]int{"x": 3})
+			p.SetValue("selected", true)
+			return nil
+		}))
+		assert.Equal(t, &innerpresence.Change{
+			ChangeType: innerpresence.Patch,
+			Presence:   innerpresence.Presence{"cursor": `{"x":3}`, "selected": "true"},
+		}, syncPresence().PresenceChange())
+
+		// 03. The patch is applied to the presence of the remote document.
+		expected := innerpresence.Presence{"name": "a", "cursor": `{"x":3}`, "selected": "true"}
+		assert.Equal(t, expected, d1.PresenceForTest(time.ActorID{1}.String()))
+		assert.Equal(t, expected, d2.PresenceForTest(time.ActorID{1}.String()))
+
+		var cursor map[string]int
+		assert.NoError(t, d2.PresenceForTest(time.ActorID{1}.String()).Value("cursor", &cursor))
+		assert.Equal(t, map[string]int{"x": 3}, cursor)
+
+		// 04. The whole presence is put if a key is deleted in the same change.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			p.Set("name", "b")
+			p.Delete("selected")
+			return nil
+		}))
+		assert.Equal(t, innerpresence.Put, syncPresence().PresenceChange().ChangeType)
+		assert.Equal(t,
+			innerpresence.Presence{"name": "b", "cursor": `{"x":3}`},
+			d2.PresenceForTest(time.ActorID{1}.String()),
+		)
+	})
+
+	t.Run("call document methods in updater test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+
+		// NOTE: The methods called by the updater should not wait for the lock
+		// held by the update in progress.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			assert.Equal(t, `{"k1":"v1"}`, doc.Marshal())
+			assert.True(t, doc.HasLocalChanges())
+			assert.True(t, doc.History().CanUndo())
+
+			// 01. The nested update is made as a part of the update.
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString("k3", "v3")
+				return nil
+			}))
+
+			// 02. The other changes are rejected during the update.
+			assert.ErrorIs(t, doc.History().Undo(), document.ErrUpdateInProgress)
+			assert.ErrorIs(t, doc.ApplyChangePack(doc.CreateChangePack()), document.ErrUpdateInProgress)
+			return nil
+		}))
+		assert.Equal(t, `{"k1":"v1","k2":"v2","k3":"v3"}`, doc.Marshal())
+		assert.Len(t, doc.CreateChangePack().Changes, 2)
+	})
+
+	t.Run("concurrent update and sync test", func(t *testing.T) {
+		d1 := document.New("d1")
+		d1.SetActor(time.ActorID{1})
+
+		// NOTE: The handler reads the document, so it should be called after
+		// the lock of the document is released.
+		unsubscribe := d1.Subscribe("$", func(event document.DocEvent) {
+			assert.NotEmpty(t, d1.Marshal())
+		})
+		defer unsubscribe()
+
+		syncDoc := func() {
+			pack := d1.CreateChangePack()
+			assert.NoError(t, d1.ApplyChangePack(change.NewPack(
+				"d1",
+				pack.Checkpoint,
+				nil,
+				pack.VersionVector,
+				nil,
+			)))
+		}
+
+		wg := gosync.WaitGroup{}
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+					root.SetInteger("k", i)
+					return nil
+				}))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				syncDoc()
+				assert.NotEmpty(t, d1.Marshal())
+			}
+		}()
+		wg.Wait()
+
+		syncDoc()
+		assert.Equal(t, `{"k":99}`, d1.Marshal())
+		assert.False(t, d1.HasLocalChanges())
+	})
+
+	t.Run("restore test", func(t *testing.T) {
+		d1 := document.New("d1")
+		d1.SetActor(time.ActorID{1})
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text").Edit(0, 0, "abc")
+			return nil
+		}))
+
+		// 01. Take the snapshot after the local change is pushed.
+		assert.NoError(t, d1.ApplyChangePack(change.NewPack(
+			"d1",
+			change.NewCheckpoint(1, 1),
+			nil,
+			d1.VersionVector(),
+			nil,
+		)))
+		assert.False(t, d1.HasLocalChanges())
+		snapshot, err := converter.SnapshotToBytes(d1.RootObject(), d1.AllPresences())
+		assert.NoError(t, err)
+		vector := d1.VersionVector().DeepCopy()
+
+		// 02. Make local changes not yet pushed.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(3, 3, "d")
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		pbPack, err := converter.ToChangePack(change.NewPack(
+			"d1",
+			d1.Checkpoint(),
+			d1.CreateChangePack().Changes,
+			vector,
+			snapshot,
+		))
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+
+		// 03. Restore the document by another actor.
+		d2 := document.New("d1")
+		d2.SetActor(time.ActorID{2})
+		assert.NoError(t, d2.Restore(pack))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.True(t, d2.HasLocalChanges())
+
+		restored := d2.CreateChangePack()
+		assert.Equal(t, change.NewCheckpoint(1, 2), restored.Checkpoint)
+		assert.Len(t, restored.Changes, 1)
+		assert.Equal(t, time.ActorID{2}, restored.Changes[0].ID().ActorID())
+
+		// NOTE: The version of the previous actor should be handed over to the
+		// current actor, so that the stale entry does not hold back GC.
+		id := restored.Changes[0].ID()
+		assert.Equal(t, id.Lamport(), id.VersionVector().VersionOf(time.ActorID{2}))
+		for _, v := range []time.VersionVector{id.VersionVector(), d2.VersionVector()} {
+			_, exists := v.Get(time.ActorID{1})
+			assert.False(t, exists)
+		}
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.Equal(t, `{"k1":"v1","k2":"v2","text":[{"val":"abc"},{"val":"d"}]}`, d2.Marshal())
+		assert.Equal(t, uint32(3), d2.CreateChangePack().Checkpoint.ClientSeq)
+
+		// 04. The document edited or of another key cannot be restored.
+		assert.ErrorIs(t, d2.Restore(pack), document.ErrDocumentNotRestorable)
+		assert.ErrorIs(t, document.New("d2").Restore(pack), document.ErrDocumentNotRestorable)
+	})
+
 	t.Run("text garbage collection test", func(t *testing.T) {
 		doc := document.New("d1")
 
@@ -588,4 +1009,42 @@ func TestDocument(t *testing.T) {
 		packB = docB.CreateChangePack()
 		assert.False(t, packA.Changes[2].AfterOrEqual(packB.Changes[1]))
 	})
+	t.Run("text selection with concurrent edits test", func(t *testing.T) {
+		d1, d2 := document.New("d1"), document.New("d1")
+		d1.SetActor(time.ActorID{1})
+		d2.SetActor(time.ActorID{2})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text").Edit(0, 0, "Hello World")
+			return nil
+		}))
+		sync(t, d1, d2)
+
+		// 01. d1 selects "World" and encodes it to share with other clients,
+		// e.g. through its presence.
+		var encoded string
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			encoded = root.GetText("text").CreateSelection(6, 11).Marshal()
+			p.Set("selection", encoded)
+			return nil
+		}))
+
+		// 02. d2 edits the text concurrently in front of the selection.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(0, 5, "Hi")
+			return nil
+		}))
+		assert.Equal(t, "Hi World", d2.Root().GetText("text").String())
+
+		// 03. The selection should still point to "World" in d2.
+		sel, err := json.ParseTextSelection(encoded)
+		assert.NoError(t, err)
+		from, to, err := d2.Root().GetText("text").SelectionToIndexes(sel)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, from)
+		assert.Equal(t, 8, to)
+
+		_, err = json.ParseTextSelection("invalid")
+		assert.Error(t, err)
+	})
 }
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestChangeEvents(t *testing.T) {
+	t.Run("local change event test", func(t *testing.T) {
+		doc := document.New("d1")
+
+		var events []document.DocEvent
+		unsubscribe := doc.Subscribe("$", func(e document.DocEvent) {
+			events = append(events, e)
+		})
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("todos").AddString("a", "b")
+			root.SetNewText("text").Edit(0, 0, "Hello")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			todos := root.GetArray("todos")
+			todos.MoveBefore(todos.Get(0).CreatedAt(), todos.Get(1).CreatedAt())
+			todos.Delete(0)
+			root.GetText("text").Edit(1, 3, "ey")
+			root.Delete("todos")
+			return nil
+		}))
+
+		assert.Equal(t, []document.DocEvent{{
+			Type: document.LocalChangeEvent,
+			Operations: []document.OpInfo{
+				{Type: document.OpSet, Path: "$", Key: "todos"},
+				{Type: document.OpAdd, Path: "$.todos", From: 0, To: 0},
+				{Type: document.OpAdd, Path: "$.todos", From: 1, To: 1},
+				{Type: document.OpSet, Path: "$", Key: "text"},
+				{Type: document.OpEdit, Path: "$.text", From: 0, To: 0},
+			},
+		}, {
+			Type: document.LocalChangeEvent,
+			Operations: []document.OpInfo{
+				{Type: document.OpMove, Path: "$.todos", From: 1, To: 0},
+				{Type: document.OpRemove, Path: "$.todos", From: 0, To: 0},
+				{Type: document.OpEdit, Path: "$.text", From: 1, To: 3},
+				{Type: document.OpRemove, Path: "$", Key: "todos"},
+			},
+		}}, events)
+
+		unsubscribe()
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.Len(t, events, 2)
+	})
+
+	t.Run("remote change event with path test", func(t *testing.T) {
+		d1, d2 := document.New("d1"), document.New("d1")
+		d1.SetActor(time.ActorID{1})
+		d2.SetActor(time.ActorID{2})
+
+		var todoEvents, allEvents []document.DocEvent
+		d2.Subscribe("$.todos", func(e document.DocEvent) {
+			todoEvents = append(todoEvents, e)
+		})
+		d2.Subscribe("$", func(e document.DocEvent) {
+			allEvents = append(allEvents, e)
+		})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("todos")
+			root.SetNewCounter("cnt", crdt.IntegerCnt, 0)
+			return nil
+		}))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("todos").AddNewObject().SetString("title", "buy milk")
+			root.GetCounter("cnt").Increase(1)
+			return nil
+		}))
+		sync(t, d1, d2)
+
+		assert.Equal(t, []document.DocEvent{{
+			Type: document.RemoteChangeEvent,
+			Operations: []document.OpInfo{
+				{Type: document.OpSet, Path: "$", Key: "todos"},
+			},
+		}, {
+			Type: document.RemoteChangeEvent,
+			Operations: []document.OpInfo{
+				{Type: document.OpAdd, Path: "$.todos", From: 0, To: 0},
+				{Type: document.OpSet, Path: "$.todos[0]", Key: "title"},
+			},
+		}}, todoEvents)
+		assert.Len(t, allEvents, 2)
+		assert.Equal(t, document.OpIncrease, allEvents[1].Operations[2].Type)
+		assert.Equal(t, "$.cnt", allEvents[1].Operations[2].Path)
+	})
+
+	t.Run("tree change event test", func(t *testing.T) {
+		doc := document.New("d1")
+
+		var events []document.DocEvent
+		doc.Subscribe("$.t", func(e document.DocEvent) {
+			events = append(events, e)
+		})
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{{
+					Type:     "p",
+					Children: []json.TreeNode{{Type: "text", Value: "abcd"}},
+				}},
+			})
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(2, 4, &json.TreeNode{Type: "text", Value: "X"}, 0)
+			root.GetTree("t").Style(0, 1, map[string]string{"bold": "true"})
+			return nil
+		}))
+		assert.Equal(t, "<doc><p bold=\"true\">aXd</p></doc>", doc.Root().GetTree("t").ToXML())
+
+		assert.Len(t, events, 2)
+		assert.Equal(t, []document.OpInfo{
+			{Type: document.OpTreeEdit, Path: "$.t", From: 2, To: 4},
+			{Type: document.OpTreeStyle, Path: "$.t", From: 0, To: 1},
+		}, events[1].Operations)
+	})
+}
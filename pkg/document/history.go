@@ -0,0 +1,1160 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/document/yson"
+	"github.com/yorkie-team/yorkie/pkg/index"
+)
+
+// MaxUndoRedoStackDepth is the maximum number of changes kept in each of the
+// undo and redo stacks.
+const MaxUndoRedoStackDepth = 50
+
+var (
+	// ErrNothingToUndo is returned when there is no change to undo.
+	ErrNothingToUndo = errors.New("nothing to undo")
+
+	// ErrNothingToRedo is returned when there is no change to redo.
+	ErrNothingToRedo = errors.New("nothing to redo")
+
+	// ErrIrreversibleOperation is returned when undoing a change that has an
+	// operation that can not be reverted.
+	ErrIrreversibleOperation = errors.New("irreversible operation")
+)
+
+// reverseOp reverts an operation with the given reverseContext.
+type reverseOp func(rc *reverseContext) error
+
+// reverseContext is the context to apply reverse operations. It issues time
+// tickets from the change context and pushes the operations for reverting to
+// it, so that reverting is propagated to other peers as a normal local change.
+type reverseContext struct {
+	ctx     *change.Context
+	root    *crdt.Root
+	history *History
+}
+
+// resolve returns the current creation time of the element of the given
+// creation time. Elements restored by undo or redo are created again with
+// new creation times, so the reverse operations recorded before refer to
+// them through the reconciled creation times.
+func (rc *reverseContext) resolve(createdAt *time.Ticket) *time.Ticket {
+	for {
+		next, ok := rc.history.reconciled[createdAt.Key()]
+		if !ok {
+			return createdAt
+		}
+		createdAt = next
+	}
+}
+
+// isReconciled returns whether the element of the given creation time has
+// been created again by undo or redo.
+func (rc *reverseContext) isReconciled(createdAt *time.Ticket) bool {
+	_, ok := rc.history.reconciled[createdAt.Key()]
+	return ok
+}
+
+// reconcile maps the creation times of the given previous element and its
+// descendants to the ones of the given restored element.
+func (rc *reverseContext) reconcile(prev, restored crdt.Element) {
+	if prev == nil || restored == nil {
+		return
+	}
+	rc.history.reconciled[prev.CreatedAt().Key()] = restored.CreatedAt()
+
+	switch prev := prev.(type) {
+	case *crdt.Object:
+		if restored, ok := restored.(*crdt.Object); ok {
+			for key, elem := range prev.Members() {
+				rc.reconcile(elem, restored.Get(key))
+			}
+		}
+	case *crdt.Array:
+		if restored, ok := restored.(*crdt.Array); ok {
+			elems, restoredElems := prev.Elements(), restored.Elements()
+			for i := 0; i < len(elems) && i < len(restoredElems); i++ {
+				rc.reconcile(elems[i], restoredElems[i])
+			}
+		}
+	}
+}
+
+// History keeps the reverse operations of the local changes of the document
+// to undo and redo them. Remote changes are not recorded, so undo and redo
+// only revert the edits of the local actor while remote changes keep being
+// applied to the document.
+type History struct {
+	doc       *Document
+	undoStack [][]reverseOp
+	redoStack [][]reverseOp
+
+	// reconciled maps the creation times of the elements removed and then
+	// restored by undo or redo to the creation times of the restored ones.
+	// It also maps the times the elements of arrays were set to the times
+	// they were set again by undo or redo.
+	reconciled map[string]*time.Ticket
+}
+
+// newHistory creates a new instance of History.
+func newHistory(doc *Document) *History {
+	return &History{
+		doc:        doc,
+		reconciled: make(map[string]*time.Ticket),
+	}
+}
+
+// CanUndo returns whether there is a change to undo.
+func (h *History) CanUndo() bool {
+	defer h.doc.rlock()()
+
+	return len(h.undoStack) > 0
+}
+
+// CanRedo returns whether there is a change to redo.
+func (h *History) CanRedo() bool {
+	defer h.doc.rlock()()
+
+	return len(h.redoStack) > 0
+}
+
+// Undo reverts the last local change of the document.
+func (h *History) Undo() error {
+	event, err := h.undo()
+	if err != nil {
+		return err
+	}
+
+	h.doc.publish(event)
+	return nil
+}
+
+// undo reverts the last local change while holding the lock of the document
+// and returns the event of the change made by reverting it.
+func (h *History) undo() (DocEvent, error) {
+	if h.doc.inUpdater() {
+		return DocEvent{}, ErrUpdateInProgress
+	}
+
+	h.doc.mu.Lock()
+	defer h.doc.mu.Unlock()
+
+	if len(h.undoStack) == 0 {
+		return DocEvent{}, ErrNothingToUndo
+	}
+
+	// NOTE: The entry is popped after it is applied, so that it is kept if
+	// applying it fails. The entry that can never be applied is dropped.
+	reverseOps, event, err := h.doc.applyReverseOps(h.undoStack[len(h.undoStack)-1])
+	if errors.Is(err, ErrIrreversibleOperation) {
+		h.undoStack = h.undoStack[:len(h.undoStack)-1]
+	}
+	if err != nil {
+		return DocEvent{}, err
+	}
+	h.undoStack = h.undoStack[:len(h.undoStack)-1]
+
+	h.redoStack = pushReverseOps(h.redoStack, reverseOps)
+	return event, nil
+}
+
+// Redo reapplies the last change reverted by Undo.
+func (h *History) Redo() error {
+	event, err := h.redo()
+	if err != nil {
+		return err
+	}
+
+	h.doc.publish(event)
+	return nil
+}
+
+// redo reapplies the last reverted change while holding the lock of the
+// document and returns the event of the change made by reapplying it.
+func (h *History) redo() (DocEvent, error) {
+	if h.doc.inUpdater() {
+		return DocEvent{}, ErrUpdateInProgress
+	}
+
+	h.doc.mu.Lock()
+	defer h.doc.mu.Unlock()
+
+	if len(h.redoStack) == 0 {
+		return DocEvent{}, ErrNothingToRedo
+	}
+
+	// NOTE: The entry is popped after it is applied, so that it is kept if
+	// applying it fails.
+	reverseOps, event, err := h.doc.applyReverseOps(h.redoStack[len(h.redoStack)-1])
+	if err != nil {
+		return DocEvent{}, err
+	}
+	h.redoStack = h.redoStack[:len(h.redoStack)-1]
+
+	h.undoStack = pushReverseOps(h.undoStack, reverseOps)
+	return event, nil
+}
+
+// record records the reverse operations of a new local change. It clears
+// the redo stack if the change has operations.
+func (h *History) record(c *change.Change, reverseOps []reverseOp) {
+	h.undoStack = pushReverseOps(h.undoStack, reverseOps)
+	if len(c.Operations()) > 0 {
+		h.redoStack = nil
+	}
+}
+
+// pushReverseOps pushes the given reverse operations to the given stack while
+// keeping the depth of the stack within MaxUndoRedoStackDepth.
+func pushReverseOps(stack [][]reverseOp, ops []reverseOp) [][]reverseOp {
+	if len(ops) == 0 {
+		return stack
+	}
+
+	stack = append(stack, ops)
+	if len(stack) > MaxUndoRedoStackDepth {
+		stack = stack[len(stack)-MaxUndoRedoStackDepth:]
+	}
+	return stack
+}
+
+// executeWithReverse executes the given local change on the given root and
+// returns the reverse operations and the information of the operations of the
+// change.
+func executeWithReverse(
+	c *change.Change,
+	root *crdt.Root,
+	presences *innerpresence.Map,
+) ([]reverseOp, []OpInfo, error) {
+	var reverseOps []reverseOp
+	var infos []OpInfo
+	infoHook := opInfoHook(root, &infos)
+	if err := c.ExecuteWithHook(root, presences, func(op operations.Operation) (func() error, error) {
+		reverseOp, err := reverseOf(root, op)
+		if err != nil {
+			return nil, err
+		}
+		if reverseOp != nil {
+			reverseOps = append(reverseOps, reverseOp)
+		}
+		return infoHook(op)
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return reverseOps, infos, nil
+}
+
+// reverseOf captures the state of the given root that the given operation is
+// about to change and returns the reverse operation of it. It returns nil if
+// the operation can not be reverted.
+func reverseOf(root *crdt.Root, op operations.Operation) (reverseOp, error) {
+	switch op := op.(type) {
+	case *operations.Set:
+		return reverseOfSet(root, op)
+	case *operations.Add:
+		return reverseOfAdd(op), nil
+	case *operations.Remove:
+		return reverseOfRemove(root, op)
+	case *operations.Move:
+		return reverseOfMove(root, op)
+	case *operations.Increase:
+		return reverseOfIncrease(op), nil
+	case *operations.Edit:
+		return reverseOfEdit(root, op)
+	case *operations.Style:
+		return reverseOfStyle(root, op)
+	case *operations.TreeEdit:
+		return reverseOfTreeEdit(root, op)
+	case *operations.TreeStyle:
+		return reverseOfTreeStyle(root, op)
+	case *operations.TreeMove:
+		return reverseOfTreeMove(root, op)
+	case *operations.SetAdd:
+		return reverseOfSetAdd(root, op), nil
+	case *operations.SetRemove:
+		return reverseOfSetRemove(root, op), nil
+	case *operations.RegisterSet:
+		return reverseOfRegisterSet(root, op)
+	case *operations.CounterReset:
+		return reverseOfCounterReset(root, op)
+	case *operations.ArraySet:
+		return reverseOfArraySet(root, op)
+	case *operations.CounterTransfer:
+		// NOTE: The transferred rights belong to the receiver, so the
+		// transferrer can not take them back.
+		return irreversible("counter transfer"), nil
+	default:
+		return nil, nil
+	}
+}
+
+// irreversible returns the reverse operation that fails with
+// ErrIrreversibleOperation for the operation of the given name.
+func irreversible(name string) reverseOp {
+	return func(rc *reverseContext) error {
+		return fmt.Errorf("undo %s: %w", name, ErrIrreversibleOperation)
+	}
+}
+
+func reverseOfSet(root *crdt.Root, op *operations.Set) (reverseOp, error) {
+	obj, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Object)
+	if !ok {
+		return nil, nil
+	}
+
+	var prev crdt.Element
+	parentCreatedAt, key := op.ParentCreatedAt(), op.Key()
+	if elem := obj.Get(key); elem != nil {
+		copied, err := elem.DeepCopy()
+		if err != nil {
+			return nil, err
+		}
+		prev = copied
+	}
+
+	createdAt := op.Value().CreatedAt()
+	return func(rc *reverseContext) error {
+		obj, ok := findLive[*crdt.Object](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+
+		// NOTE: If the value has been overwritten by others, we keep the value
+		// of others.
+		elem := obj.Get(key)
+		if elem == nil || elem.CreatedAt().Compare(rc.resolve(createdAt)) != 0 {
+			return nil
+		}
+
+		if prev == nil {
+			json.NewObject(rc.ctx, obj).Delete(key)
+			return nil
+		}
+		return restoreInObject(rc, obj, key, prev)
+	}, nil
+}
+
+func reverseOfAdd(op *operations.Add) reverseOp {
+	parentCreatedAt, createdAt := op.ParentCreatedAt(), op.Value().CreatedAt()
+	return func(rc *reverseContext) error {
+		arr, ok := findLive[*crdt.Array](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+		elem, ok := findLive[crdt.Element](rc, createdAt)
+		if !ok {
+			return nil
+		}
+
+		return executeReverse(rc, operations.NewRemove(
+			arr.CreatedAt(),
+			elem.CreatedAt(),
+			rc.ctx.IssueTimeTicket(),
+		))
+	}
+}
+
+func reverseOfRemove(root *crdt.Root, op *operations.Remove) (reverseOp, error) {
+	elem := root.FindByCreatedAt(op.CreatedAt())
+	if elem == nil || elem.RemovedAt() != nil {
+		return nil, nil
+	}
+
+	prev, err := elem.DeepCopy()
+	if err != nil {
+		return nil, err
+	}
+
+	parentCreatedAt := op.ParentCreatedAt()
+	switch parent := root.FindByCreatedAt(parentCreatedAt).(type) {
+	case *crdt.Object:
+		var key string
+		for _, node := range parent.RHTNodes() {
+			if node.Element() == elem {
+				key = node.Key()
+				break
+			}
+		}
+
+		return func(rc *reverseContext) error {
+			obj, ok := findLive[*crdt.Object](rc, parentCreatedAt)
+			if !ok {
+				return nil
+			}
+			return restoreInObject(rc, obj, key, prev)
+		}, nil
+	case *crdt.Array:
+		prevCreatedAt, err := parent.FindPrevCreatedAt(op.CreatedAt())
+		if err != nil {
+			return nil, err
+		}
+
+		return func(rc *reverseContext) error {
+			arr, ok := findLive[*crdt.Array](rc, parentCreatedAt)
+			if !ok {
+				return nil
+			}
+
+			value, err := yson.FromCRDT(prev)
+			if err != nil {
+				return err
+			}
+			json.NewArray(rc.ctx, arr).AddYSON(value)
+			restored, err := arr.Get(arr.Len() - 1)
+			if err != nil {
+				return err
+			}
+			rc.reconcile(prev, restored)
+
+			return executeReverse(rc, operations.NewMove(
+				arr.CreatedAt(),
+				rc.resolve(prevCreatedAt),
+				restored.CreatedAt(),
+				rc.ctx.IssueTimeTicket(),
+			))
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// restoreInObject restores the given previous element for the given key of
+// the object.
+func restoreInObject(rc *reverseContext, obj *crdt.Object, key string, prev crdt.Element) error {
+	value, err := yson.FromCRDT(prev)
+	if err != nil {
+		return err
+	}
+
+	json.NewObject(rc.ctx, obj).SetYSONElement(key, value)
+	rc.reconcile(prev, obj.Get(key))
+	return nil
+}
+
+func reverseOfMove(root *crdt.Root, op *operations.Move) (reverseOp, error) {
+	arr, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Array)
+	if !ok {
+		return nil, nil
+	}
+
+	prevCreatedAt, err := arr.FindPrevCreatedAt(op.CreatedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	parentCreatedAt, createdAt := op.ParentCreatedAt(), op.CreatedAt()
+	return func(rc *reverseContext) error {
+		arr, ok := findLive[*crdt.Array](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+		elem, ok := findLive[crdt.Element](rc, createdAt)
+		if !ok {
+			return nil
+		}
+
+		return executeReverse(rc, operations.NewMove(
+			arr.CreatedAt(),
+			rc.resolve(prevCreatedAt),
+			elem.CreatedAt(),
+			rc.ctx.IssueTimeTicket(),
+		))
+	}, nil
+}
+
+func reverseOfArraySet(root *crdt.Root, op *operations.ArraySet) (reverseOp, error) {
+	elem := root.FindByCreatedAt(op.CreatedAt())
+	if elem == nil || elem.RemovedAt() != nil {
+		return nil, nil
+	}
+
+	// NOTE: The new element keeps the creation time of the previous one, so
+	// the previous one is identified by the time it was set.
+	prev, err := elem.DeepCopy()
+	if err != nil {
+		return nil, err
+	}
+
+	parentCreatedAt, createdAt, executedAt := op.ParentCreatedAt(), op.CreatedAt(), op.ExecutedAt()
+	return func(rc *reverseContext) error {
+		if rc.isReconciled(createdAt) {
+			return nil
+		}
+		arr, ok := findLive[*crdt.Array](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+
+		// NOTE: If the element has been set by others, we keep the element of
+		// others.
+		elem, ok := findLive[crdt.Element](rc, createdAt)
+		if !ok || elem.MovedAt() == nil || elem.MovedAt().Compare(rc.resolve(executedAt)) != 0 {
+			return nil
+		}
+
+		ticket := rc.ctx.IssueTimeTicket()
+		if err := executeReverse(rc, operations.NewArraySet(
+			arr.CreatedAt(),
+			createdAt,
+			prev,
+			ticket,
+		)); err != nil {
+			return err
+		}
+		if prev.MovedAt() != nil {
+			rc.history.reconciled[prev.MovedAt().Key()] = ticket
+		}
+		return nil
+	}, nil
+}
+
+func reverseOfIncrease(op *operations.Increase) reverseOp {
+	primitive, ok := op.Value().(*crdt.Primitive)
+	if !ok {
+		return nil
+	}
+
+	var negated interface{}
+	switch v := primitive.Value().(type) {
+	case int32:
+		negated = -v
+	case int64:
+		negated = -v
+	case float64:
+		negated = -v
+	default:
+		return nil
+	}
+
+	parentCreatedAt := op.ParentCreatedAt()
+	return func(rc *reverseContext) error {
+		cnt, ok := findLive[*crdt.Counter](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+
+		counter := json.NewCounter(cnt.Value(), cnt.ValueType()).Initialize(rc.ctx, cnt)
+
+		// NOTE: The rights of the bounded counter may have been spent or
+		// transferred after the increase, so it is not reverted if the actor
+		// does not have enough rights.
+		if cnt.IsBounded() && isNegative(negated) && counter.Rights() < -toLong(negated) {
+			return nil
+		}
+		counter.Increase(negated)
+		return nil
+	}
+}
+
+// reverseOfCounterReset returns the reverse of the given reset, which
+// increases the counter by the amount that the reset cancels.
+func reverseOfCounterReset(root *crdt.Root, op *operations.CounterReset) (reverseOp, error) {
+	cnt, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Counter)
+	if !ok {
+		return nil, nil
+	}
+
+	elem, err := cnt.DeepCopy()
+	if err != nil {
+		return nil, err
+	}
+	reset := elem.(*crdt.Counter)
+	if err := reset.Reset(op.Observed()); err != nil {
+		return nil, err
+	}
+	amount := toLong(cnt.Value()) - toLong(reset.Value())
+	if amount == 0 {
+		return nil, nil
+	}
+
+	parentCreatedAt := op.ParentCreatedAt()
+	return func(rc *reverseContext) error {
+		cnt, ok := findLive[*crdt.Counter](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+		json.NewCounter(cnt.Value(), cnt.ValueType()).Initialize(rc.ctx, cnt).Increase(amount)
+		return nil
+	}, nil
+}
+
+// isNegative returns whether the given numeric value is negative.
+func isNegative(v interface{}) bool {
+	switch v := v.(type) {
+	case int32:
+		return v < 0
+	case int64:
+		return v < 0
+	case float64:
+		return v < 0
+	}
+	return false
+}
+
+// toLong converts the given numeric value to int64.
+func toLong(v interface{}) int64 {
+	switch v := v.(type) {
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	}
+	return 0
+}
+
+func reverseOfSetAdd(root *crdt.Root, op *operations.SetAdd) reverseOp {
+	set, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Set)
+	if !ok {
+		return nil
+	}
+	value, ok := op.Value().(*crdt.Primitive)
+	if !ok || set.Has(value) {
+		return nil
+	}
+
+	parentCreatedAt, createdAt := op.ParentCreatedAt(), value.CreatedAt()
+	return func(rc *reverseContext) error {
+		set, ok := findLive[*crdt.Set](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+
+		// NOTE: Only the addition of this operation is removed, so the value
+		// added by others concurrently remains.
+		return executeReverse(rc, operations.NewSetRemove(
+			set.CreatedAt(),
+			[]*time.Ticket{createdAt},
+			rc.ctx.IssueTimeTicket(),
+		))
+	}
+}
+
+func reverseOfSetRemove(root *crdt.Root, op *operations.SetRemove) reverseOp {
+	set, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Set)
+	if !ok {
+		return nil
+	}
+
+	removed := make(map[string]bool)
+	for _, createdAt := range op.CreatedAts() {
+		removed[createdAt.Key()] = true
+	}
+	var values []interface{}
+	for _, node := range set.Nodes() {
+		if node.RemovedAt() == nil && removed[node.IDString()] {
+			values = append(values, node.Value().Value())
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	parentCreatedAt := op.ParentCreatedAt()
+	return func(rc *reverseContext) error {
+		set, ok := findLive[*crdt.Set](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+
+		proxy := json.NewSet(rc.ctx, set)
+		for _, value := range values {
+			if !proxy.Has(value) {
+				proxy.Add(value)
+			}
+		}
+		return nil
+	}
+}
+
+func reverseOfRegisterSet(root *crdt.Root, op *operations.RegisterSet) (reverseOp, error) {
+	register, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.MVRegister)
+	if !ok {
+		return nil, nil
+	}
+	value, ok := op.Value().(*crdt.Primitive)
+	if !ok {
+		return nil, nil
+	}
+
+	overwritten := make(map[string]bool)
+	for _, createdAt := range op.Overwrites() {
+		overwritten[createdAt.Key()] = true
+	}
+	var values []interface{}
+	for _, node := range register.Nodes() {
+		if node.RemovedAt() == nil && overwritten[node.IDString()] {
+			values = append(values, node.Value().Value())
+		}
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	parentCreatedAt, createdAt := op.ParentCreatedAt(), value.CreatedAt()
+	return func(rc *reverseContext) error {
+		register, ok := findLive[*crdt.MVRegister](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+
+		// NOTE: Only the value of this operation is overwritten, so the values
+		// assigned by others concurrently remain as conflicts.
+		overwrites := []*time.Ticket{createdAt}
+		for _, value := range values {
+			ticket := rc.ctx.IssueTimeTicket()
+			primitive, err := crdt.NewPrimitive(value, ticket)
+			if err != nil {
+				return err
+			}
+			if err := executeReverse(rc, operations.NewRegisterSet(
+				register.CreatedAt(),
+				primitive,
+				overwrites,
+				ticket,
+			)); err != nil {
+				return err
+			}
+			overwrites = nil
+		}
+		return nil
+	}, nil
+}
+
+func reverseOfEdit(root *crdt.Root, op *operations.Edit) (reverseOp, error) {
+	text, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Text)
+	if !ok {
+		return nil, nil
+	}
+
+	leftPos, fragments, err := text.Fragments(op.From(), op.To(), op.ExecutedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fragments) == 0 && op.Content() == "" {
+		return nil, nil
+	}
+
+	parentCreatedAt, executedAt := op.ParentCreatedAt(), op.ExecutedAt()
+	return func(rc *reverseContext) error {
+		// NOTE: The positions refer to the nodes of the text, so the edit can
+		// not be reverted if the text has been created again.
+		if rc.isReconciled(parentCreatedAt) {
+			return nil
+		}
+		text, ok := findLive[*crdt.Text](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+
+		// NOTE: Only the nodes inserted by the edit are removed, so that the
+		// contents inserted by others between them are kept.
+		for _, inserted := range text.InsertedFragments(executedAt) {
+			if err := executeReverse(rc, operations.NewEdit(
+				parentCreatedAt,
+				inserted.From,
+				inserted.To,
+				"",
+				nil,
+				rc.ctx.IssueTimeTicket(),
+			)); err != nil {
+				return err
+			}
+		}
+
+		// NOTE: The removed fragments are inserted right after the left of the
+		// range in reverse order, because a node inserted later at the same
+		// position is placed in front of the others.
+		for i := len(fragments) - 1; i >= 0; i-- {
+			if err := executeReverse(rc, operations.NewEdit(
+				parentCreatedAt,
+				leftPos,
+				leftPos,
+				fragments[i].Value,
+				fragments[i].Attributes,
+				rc.ctx.IssueTimeTicket(),
+			)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, nil
+}
+
+// styleRecord is the previous style of a fragment of Text or a node of Tree.
+type styleRecord struct {
+	attributes         map[string]string
+	attributesToRemove []string
+}
+
+// newStyleRecord creates a styleRecord from the current attributes for the
+// given keys.
+func newStyleRecord(keys []string, get func(key string) (string, bool)) styleRecord {
+	record := styleRecord{attributes: make(map[string]string)}
+	for _, key := range keys {
+		if value, ok := get(key); ok {
+			record.attributes[key] = value
+		} else {
+			record.attributesToRemove = append(record.attributesToRemove, key)
+		}
+	}
+	return record
+}
+
+// styleKeys returns the keys of the attributes changed by a style operation.
+func styleKeys(attributes map[string]string, attributesToRemove []string) []string {
+	if len(attributesToRemove) > 0 {
+		return attributesToRemove
+	}
+
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func reverseOfStyle(root *crdt.Root, op *operations.Style) (reverseOp, error) {
+	// NOTE: Removing a mark also removes the earlier marks of the same key,
+	// so it is not the reverse of the mark.
+	if op.Mark() != nil {
+		return irreversible("mark"), nil
+	}
+
+	text, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Text)
+	if !ok {
+		return nil, nil
+	}
+
+	_, fragments, err := text.Fragments(op.From(), op.To(), op.ExecutedAt())
+	if err != nil {
+		return nil, err
+	}
+	if len(fragments) == 0 {
+		return nil, nil
+	}
+
+	keys := styleKeys(op.Attributes(), op.AttributesToRemove())
+	records := make([]styleRecord, 0, len(fragments))
+	for _, fragment := range fragments {
+		records = append(records, newStyleRecord(keys, func(key string) (string, bool) {
+			value, ok := fragment.Attributes[key]
+			return value, ok
+		}))
+	}
+
+	parentCreatedAt := op.ParentCreatedAt()
+	return func(rc *reverseContext) error {
+		if rc.isReconciled(parentCreatedAt) {
+			return nil
+		}
+		if _, ok := findLive[*crdt.Text](rc, parentCreatedAt); !ok {
+			return nil
+		}
+
+		for i, fragment := range fragments {
+			if len(records[i].attributes) > 0 {
+				if err := executeReverse(rc, operations.NewStyle(
+					parentCreatedAt,
+					fragment.From,
+					fragment.To,
+					records[i].attributes,
+					rc.ctx.IssueTimeTicket(),
+				)); err != nil {
+					return err
+				}
+			}
+			if len(records[i].attributesToRemove) > 0 {
+				if err := executeReverse(rc, operations.NewStyleRemove(
+					parentCreatedAt,
+					fragment.From,
+					fragment.To,
+					records[i].attributesToRemove,
+					rc.ctx.IssueTimeTicket(),
+				)); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}, nil
+}
+
+func reverseOfTreeEdit(root *crdt.Root, op *operations.TreeEdit) (reverseOp, error) {
+	tree, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Tree)
+	if !ok {
+		return nil, nil
+	}
+
+	// NOTE: Splitting and merging element nodes are not reverted for now.
+	// Only the edits within the same parent can be reverted.
+	if op.SplitLevel() > 0 {
+		return nil, nil
+	}
+
+	fromParent, fromLeft, err := tree.FindTreeNodesWithSplitText(op.FromPos(), op.ExecutedAt())
+	if err != nil {
+		return nil, err
+	}
+	toParent, toLeft, err := tree.FindTreeNodesWithSplitText(op.ToPos(), op.ExecutedAt())
+	if err != nil {
+		return nil, err
+	}
+	if fromParent != toParent {
+		return nil, nil
+	}
+
+	start, end := 0, 0
+	if fromLeft != fromParent {
+		start = fromParent.Index.OffsetOfChild(fromLeft.Index) + 1
+	}
+	if toLeft != toParent {
+		end = toParent.Index.OffsetOfChild(toLeft.Index) + 1
+	}
+
+	var removeds []*crdt.TreeNode
+	if start < end {
+		for _, child := range fromParent.Index.Children(true)[start:end] {
+			if !child.Value.IsRemoved() {
+				removeds = append(removeds, child.Value)
+			}
+		}
+	}
+
+	insertedSize := 0
+	for _, content := range op.Contents() {
+		insertedSize += content.Index.PaddedLength()
+	}
+
+	if len(removeds) == 0 && insertedSize == 0 {
+		return nil, nil
+	}
+
+	// NOTE: Group the removed nodes by the kind of the node, because text
+	// nodes and element nodes can not be inserted together.
+	var groups [][]*json.TreeNode
+	var groupSizes []int
+	for i, removed := range removeds {
+		node := yson.FromTreeNode(removed)
+		if i == 0 || removed.IsText() != removeds[i-1].IsText() {
+			groups = append(groups, nil)
+			groupSizes = append(groupSizes, 0)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], &node)
+		groupSizes[len(groupSizes)-1] += removed.Index.PaddedLength()
+	}
+
+	parentCreatedAt, from, executedAt := op.ParentCreatedAt(), op.FromPos(), op.ExecutedAt()
+	return func(rc *reverseContext) error {
+		if rc.isReconciled(parentCreatedAt) {
+			return nil
+		}
+		tree, ok := findLive[*crdt.Tree](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+
+		// NOTE: The position is resolved with the execution time of the
+		// original operation to skip the nodes inserted later at the same
+		// position by others.
+		fromParent, fromLeft, err := tree.FindTreeNodesWithSplitText(from, executedAt)
+		if err != nil {
+			return err
+		}
+		fromIdx, err := tree.ToIndex(fromParent, fromLeft)
+		if err != nil {
+			return err
+		}
+		toIdx := fromIdx + insertedSize
+
+		proxy := json.NewTree().Initialize(rc.ctx, tree)
+		if len(groups) == 0 {
+			proxy.EditBulk(fromIdx, toIdx, nil, 0)
+			return nil
+		}
+
+		for i, group := range groups {
+			proxy.EditBulk(fromIdx, toIdx, group, 0)
+			fromIdx += groupSizes[i]
+			toIdx = fromIdx
+		}
+
+		return nil
+	}, nil
+}
+
+func reverseOfTreeMove(root *crdt.Root, op *operations.TreeMove) (reverseOp, error) {
+	tree, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Tree)
+	if !ok {
+		return nil, nil
+	}
+
+	prevPos, err := tree.PosOfNode(op.Target())
+	if err != nil {
+		return nil, err
+	}
+
+	parentCreatedAt, target := op.ParentCreatedAt(), op.Target()
+	return func(rc *reverseContext) error {
+		if rc.isReconciled(parentCreatedAt) {
+			return nil
+		}
+		tree, ok := findLive[*crdt.Tree](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+		if _, err := tree.PosOfNode(target); err != nil {
+			return nil
+		}
+
+		return executeReverse(rc, operations.NewTreeMove(
+			tree.CreatedAt(),
+			target,
+			prevPos,
+			rc.ctx.IssueTimeTicket(),
+		))
+	}, nil
+}
+
+func reverseOfTreeStyle(root *crdt.Root, op *operations.TreeStyle) (reverseOp, error) {
+	tree, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Tree)
+	if !ok {
+		return nil, nil
+	}
+
+	fromParent, fromLeft, err := tree.FindTreeNodesWithSplitText(op.FromPos(), op.ExecutedAt())
+	if err != nil {
+		return nil, err
+	}
+	toParent, toLeft, err := tree.FindTreeNodesWithSplitText(op.ToPos(), op.ExecutedAt())
+	if err != nil {
+		return nil, err
+	}
+	fromIdx, err := tree.ToIndex(fromParent, fromLeft)
+	if err != nil {
+		return nil, err
+	}
+	toIdx, err := tree.ToIndex(toParent, toLeft)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := styleKeys(op.Attributes(), op.AttributesToRemove())
+	var nodeIDs []*crdt.TreeNodeID
+	var records []styleRecord
+	visited := make(map[*crdt.TreeNode]bool)
+	if err := tree.IndexTree.TokensBetween(fromIdx, toIdx, func(token index.TreeToken[*crdt.TreeNode], _ bool) {
+		node := token.Node
+		if node.IsText() || node.IsRemoved() || visited[node] {
+			return
+		}
+		visited[node] = true
+
+		nodeIDs = append(nodeIDs, node.ID())
+		records = append(records, newStyleRecord(keys, func(key string) (string, bool) {
+			if node.Attrs == nil || !node.Attrs.Has(key) {
+				return "", false
+			}
+			return node.Attrs.Get(key), true
+		}))
+	}); err != nil {
+		return nil, err
+	}
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+
+	parentCreatedAt := op.ParentCreatedAt()
+	return func(rc *reverseContext) error {
+		if rc.isReconciled(parentCreatedAt) {
+			return nil
+		}
+		tree, ok := findLive[*crdt.Tree](rc, parentCreatedAt)
+		if !ok {
+			return nil
+		}
+
+		proxy := json.NewTree().Initialize(rc.ctx, tree)
+		for i, id := range nodeIDs {
+			node, _ := tree.ToTreeNodes(&crdt.TreePos{ParentID: id, LeftSiblingID: id})
+			if node == nil || node.IsRemoved() {
+				continue
+			}
+
+			// NOTE: The range from the front of the node to the inside of the
+			// node only contains the start token of the node.
+			idx, err := tree.ToIndex(node, node)
+			if err != nil {
+				return err
+			}
+			if idx < 1 {
+				continue
+			}
+
+			if len(records[i].attributes) > 0 {
+				proxy.Style(idx-1, idx, records[i].attributes)
+			}
+			if len(records[i].attributesToRemove) > 0 {
+				proxy.RemoveStyle(idx-1, idx, records[i].attributesToRemove)
+			}
+		}
+
+		return nil
+	}, nil
+}
+
+// findLive finds the live element of the given creation time.
+func findLive[T crdt.Element](rc *reverseContext, createdAt *time.Ticket) (T, bool) {
+	elem, ok := rc.root.FindByCreatedAt(rc.resolve(createdAt)).(T)
+	if !ok || elem.RemovedAt() != nil {
+		var zero T
+		return zero, false
+	}
+	return elem, true
+}
+
+// executeReverse executes the given operation and pushes it to the context.
+// The operation is skipped if its target has been purged by garbage
+// collection.
+func executeReverse(rc *reverseContext, op operations.Operation) error {
+	if err := op.Execute(rc.root, nil); err != nil {
+		if errors.Is(err, crdt.ErrChildNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	rc.ctx.Push(op)
+	return nil
+}
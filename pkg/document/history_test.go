@@ -0,0 +1,462 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestHistory(t *testing.T) {
+	t.Run("nothing to undo or redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.False(t, doc.History().CanUndo())
+		assert.False(t, doc.History().CanRedo())
+		assert.ErrorIs(t, doc.History().Undo(), document.ErrNothingToUndo)
+		assert.ErrorIs(t, doc.History().Redo(), document.ErrNothingToRedo)
+	})
+
+	t.Run("object undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		states := []string{doc.Marshal()}
+		updates := []func(root *json.Object){
+			func(root *json.Object) { root.SetString("k1", "v1") },
+			func(root *json.Object) { root.SetNewObject("k2").SetInteger("k3", 1) },
+			func(root *json.Object) { root.SetString("k1", "v2") },
+			func(root *json.Object) { root.Delete("k2") },
+		}
+		for _, update := range updates {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				update(root)
+				return nil
+			}))
+			states = append(states, doc.Marshal())
+		}
+		assert.Equal(t, `{"k1":"v2"}`, doc.Marshal())
+
+		for i := len(states) - 2; i >= 0; i-- {
+			assert.NoError(t, doc.History().Undo())
+			assert.Equal(t, states[i], doc.Marshal())
+		}
+		assert.False(t, doc.History().CanUndo())
+
+		for i := 1; i < len(states); i++ {
+			assert.NoError(t, doc.History().Redo())
+			assert.Equal(t, states[i], doc.Marshal())
+		}
+		assert.False(t, doc.History().CanRedo())
+	})
+
+	t.Run("array undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("list").AddInteger(0, 1, 2)
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("list").Delete(1)
+			return nil
+		}))
+		assert.Equal(t, `{"list":[0,2]}`, doc.Marshal())
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			list := root.GetArray("list")
+			list.MoveBefore(list.Get(0).CreatedAt(), list.Get(1).CreatedAt())
+			return nil
+		}))
+		assert.Equal(t, `{"list":[2,0]}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"list":[0,2]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"list":[0,1,2]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"list":[0,2]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"list":[2,0]}`, doc.Marshal())
+	})
+
+	t.Run("counter undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewCounter("cnt", crdt.IntegerCnt, 0)
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Increase(5)
+			return nil
+		}))
+		assert.Equal(t, `{"cnt":5}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"cnt":0}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"cnt":5}`, doc.Marshal())
+	})
+
+	t.Run("text undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text").Edit(0, 0, "Hello World")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(0, 5, "Hi")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Style(0, 2, map[string]string{"b": "1"})
+			return nil
+		}))
+		assert.Equal(t, `{"text":[{"attrs":{"b":"1"},"val":"Hi"},{"val":" World"}]}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"text":[{"val":"Hi"},{"val":" World"}]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"text":[{"val":"Hello"},{"val":" World"}]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"text":[{"val":"Hi"},{"val":" World"}]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"text":[{"attrs":{"b":"1"},"val":"Hi"},{"val":" World"}]}`, doc.Marshal())
+	})
+
+	t.Run("tree undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{{
+					Type:     "p",
+					Children: []json.TreeNode{{Type: "text", Value: "ab"}},
+				}},
+			})
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(1, 2, &json.TreeNode{Type: "text", Value: "XY"}, 0)
+			return nil
+		}))
+		assert.Equal(t, "<doc><p>XYb</p></doc>", doc.Root().GetTree("t").ToXML())
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Style(0, 1, map[string]string{"bold": "true"})
+			return nil
+		}))
+		assert.Equal(t, `<doc><p bold="true">XYb</p></doc>`, doc.Root().GetTree("t").ToXML())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, "<doc><p>XYb</p></doc>", doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, "<doc><p>ab</p></doc>", doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, "<doc><p>XYb</p></doc>", doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `<doc><p bold="true">XYb</p></doc>`, doc.Root().GetTree("t").ToXML())
+	})
+
+	t.Run("undo only local changes test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1", document.WithDisableGC())
+		docA.SetActor(actorA)
+		docB := document.New("d1", document.WithDisableGC())
+		docB.SetActor(actorB)
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text").Edit(0, 0, "abc")
+			return nil
+		}))
+		sync(t, docA, docB)
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(3, 3, "d")
+			return nil
+		}))
+		assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(0, 0, "0")
+			return nil
+		}))
+		sync(t, docA, docB)
+		sync(t, docB, docA)
+		assert.Equal(t, `{"text":[{"val":"0"},{"val":"abc"},{"val":"d"}]}`, docA.Marshal())
+
+		// undo of docA only reverts the edit of docA.
+		assert.NoError(t, docA.History().Undo())
+		sync(t, docA, docB)
+		assert.Equal(t, `{"text":[{"val":"0"},{"val":"abc"}]}`, docA.Marshal())
+		assert.Equal(t, docA.Marshal(), docB.Marshal())
+	})
+
+	t.Run("keep remote insertion inside undone edit test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1", document.WithDisableGC())
+		docA.SetActor(actorA)
+		docB := document.New("d1", document.WithDisableGC())
+		docB.SetActor(actorB)
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text").Edit(0, 0, "12")
+			return nil
+		}))
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(1, 1, "abcd")
+			return nil
+		}))
+		sync(t, docA, docB)
+
+		assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(3, 3, "X")
+			return nil
+		}))
+		sync(t, docB, docA)
+		assert.Equal(t, "1abXcd2", docA.Root().GetText("text").String())
+
+		// undo of docA removes its insertion while keeping the one of docB.
+		assert.NoError(t, docA.History().Undo())
+		sync(t, docA, docB)
+		assert.Equal(t, "1X2", docA.Root().GetText("text").String())
+		assert.Equal(t, docA.Marshal(), docB.Marshal())
+
+		assert.NoError(t, docA.History().Redo())
+		sync(t, docA, docB)
+		assert.Equal(t, "1abXcd2", docA.Root().GetText("text").String())
+		assert.Equal(t, docA.Marshal(), docB.Marshal())
+	})
+
+	t.Run("keep remote insertion inside undone replacement test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1", document.WithDisableGC())
+		docA.SetActor(actorA)
+		docB := document.New("d1", document.WithDisableGC())
+		docB.SetActor(actorB)
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text").Edit(0, 0, "Hello World")
+			return nil
+		}))
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(0, 5, "Hi")
+			return nil
+		}))
+		sync(t, docA, docB)
+
+		assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(1, 1, "X")
+			return nil
+		}))
+		sync(t, docB, docA)
+		assert.Equal(t, "HXi World", docA.Root().GetText("text").String())
+
+		// undo of docA restores the replaced content and keeps the insertion
+		// of docB.
+		assert.NoError(t, docA.History().Undo())
+		sync(t, docA, docB)
+		assert.Equal(t, "HelloX World", docA.Root().GetText("text").String())
+		assert.Equal(t, docA.Marshal(), docB.Marshal())
+	})
+
+	t.Run("array set undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("list").AddInteger(0, 1, 2)
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("list").SetInteger(1, 3)
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("list").SetInteger(1, 4)
+			return nil
+		}))
+		assert.Equal(t, `{"list":[0,4,2]}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"list":[0,3,2]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"list":[0,1,2]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"list":[0,3,2]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"list":[0,4,2]}`, doc.Marshal())
+	})
+
+	t.Run("keep array element set by others on undo test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1", document.WithDisableGC())
+		docA.SetActor(actorA)
+		docB := document.New("d1", document.WithDisableGC())
+		docB.SetActor(actorB)
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("list").AddInteger(0, 1)
+			return nil
+		}))
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("list").SetInteger(0, 2)
+			return nil
+		}))
+		sync(t, docA, docB)
+
+		assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("list").SetInteger(0, 3)
+			return nil
+		}))
+		sync(t, docB, docA)
+		assert.Equal(t, `{"list":[3,1]}`, docA.Marshal())
+
+		assert.NoError(t, docA.History().Undo())
+		assert.Equal(t, `{"list":[3,1]}`, docA.Marshal())
+	})
+
+	t.Run("irreversible operation test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text").Edit(0, 0, "abc")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Mark(0, 1, "bold", "true", crdt.MarkExpandAfter)
+			return nil
+		}))
+
+		// the change with the mark is dropped from the undo stack and kept in
+		// the document.
+		marshaled := doc.Marshal()
+		assert.ErrorIs(t, doc.History().Undo(), document.ErrIrreversibleOperation)
+		assert.Equal(t, marshaled, doc.Marshal())
+		assert.False(t, doc.History().CanRedo())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{}`, doc.Marshal())
+		assert.False(t, doc.History().CanUndo())
+	})
+
+	t.Run("keep value overwritten by others on undo test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1", document.WithDisableGC())
+		docA.SetActor(actorA)
+		docB := document.New("d1", document.WithDisableGC())
+		docB.SetActor(actorB)
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v2")
+			return nil
+		}))
+		sync(t, docA, docB)
+
+		assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v3")
+			return nil
+		}))
+		sync(t, docB, docA)
+		assert.Equal(t, `{"k1":"v3"}`, docA.Marshal())
+
+		// undo of docA does not restore the value overwritten by docB.
+		assert.NoError(t, docA.History().Undo())
+		assert.Equal(t, `{"k1":"v3"}`, docA.Marshal())
+	})
+
+	t.Run("keep entry when undo fails test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "hello world")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.Delete("k1")
+			return nil
+		}))
+
+		doc.SetSizeLimit(document.SizeLimit{MaxDocumentSize: 10})
+		assert.ErrorIs(t, doc.History().Undo(), document.ErrDocumentSizeExceeded)
+		assert.True(t, doc.History().CanUndo())
+		assert.Equal(t, `{}`, doc.Marshal())
+
+		doc.SetSizeLimit(document.SizeLimit{})
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"k1":"hello world"}`, doc.Marshal())
+	})
+
+	t.Run("redo stack is cleared by new change test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, doc.History().Undo())
+		assert.True(t, doc.History().CanRedo())
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.False(t, doc.History().CanRedo())
+	})
+}
+
+// sync applies the local changes of `from` that `to` has not received yet.
+func sync(t *testing.T, from, to *document.Document) {
+	pack := from.CreateChangePack()
+	var changes []*change.Change
+	for _, c := range pack.Changes {
+		if to.VersionVector().VersionOf(from.ActorID()) < c.ID().Lamport() {
+			changes = append(changes, c)
+		}
+	}
+
+	vector := pack.VersionVector.DeepCopy()
+	vector.Set(to.ActorID(), to.VersionVector().VersionOf(to.ActorID()))
+	assert.NoError(t, to.ApplyChangePack(change.NewPack(
+		to.Key(),
+		to.Checkpoint(),
+		changes,
+		vector,
+		nil,
+	)))
+}
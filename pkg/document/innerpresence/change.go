@@ -30,6 +30,11 @@ const (
 
 	// Clear represents the presence is cleared.
 	Clear ChangeType = "clear"
+
+	// Patch represents the presence is partially updated. Only the keys in
+	// the presence of the change are updated. It is sent only to the servers
+	// supporting it.
+	Patch ChangeType = "patch"
 )
 
 // Change represents the change of presence.
@@ -40,9 +45,12 @@ type Change struct {
 
 // Execute applies the change to the given presences map.
 func (c *Change) Execute(actorID time.ActorID, presences *Map) {
-	if c.ChangeType == Clear {
+	switch c.ChangeType {
+	case Clear:
 		presences.Delete(actorID.String())
-	} else {
+	case Patch:
+		presences.Store(actorID.String(), presences.Load(actorID.String()).Patch(c.Presence))
+	default:
 		presences.Store(actorID.String(), c.Presence)
 	}
 }
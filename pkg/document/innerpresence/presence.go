@@ -20,6 +20,8 @@
 package innerpresence
 
 import (
+	"encoding/json"
+	"fmt"
 	"sync"
 )
 
@@ -98,11 +100,55 @@ func (p Presence) Set(key string, value string) {
 	p[key] = value
 }
 
+// SetValue sets the JSON encoding of the given value to the given key, so
+// that the structured values such as numbers and objects can be shared in the
+// same way as the other SDKs do.
+func (p Presence) SetValue(key string, value any) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("encode presence value of %s: %w", key, err)
+	}
+
+	p[key] = string(encoded)
+	return nil
+}
+
+// Value decodes the JSON encoding of the given key into the given value.
+func (p Presence) Value(key string, value any) error {
+	encoded, ok := p[key]
+	if !ok {
+		return fmt.Errorf("decode presence value of %s: key not found", key)
+	}
+
+	if err := json.Unmarshal([]byte(encoded), value); err != nil {
+		return fmt.Errorf("decode presence value of %s: %w", key, err)
+	}
+	return nil
+}
+
+// Delete deletes the value of the given key.
+func (p Presence) Delete(key string) {
+	delete(p, key)
+}
+
 // Clear clears the presence.
 func (p *Presence) Clear() {
 	*p = make(map[string]string)
 }
 
+// Patch returns a new presence that the values of the given patch are set to.
+func (p Presence) Patch(patch Presence) Presence {
+	patched := p.DeepCopy()
+	if patched == nil {
+		patched = New()
+	}
+
+	for k, v := range patch {
+		patched[k] = v
+	}
+	return patched
+}
+
 // DeepCopy copies itself deeply.
 func (p Presence) DeepCopy() Presence {
 	if p == nil {
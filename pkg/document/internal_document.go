@@ -25,6 +25,7 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/resource"
 )
@@ -169,7 +170,7 @@ func (d *InternalDocument) ApplyChangePack(pack *change.Pack, disableGC bool) er
 			return err
 		}
 	} else {
-		if _, err := d.ApplyChanges(pack.Changes...); err != nil {
+		if _, err := d.applyChanges(pack.Changes, false); err != nil {
 			return err
 		}
 	}
@@ -290,15 +291,24 @@ func (d *InternalDocument) applySnapshot(snapshot []byte, vector time.VersionVec
 	return nil
 }
 
-// ApplyChanges applies remote changes to the document.
+// ApplyChanges applies remote changes to the document. It returns the events
+// that occurred by the changes, including the change events carrying the
+// information of the applied operations.
 func (d *InternalDocument) ApplyChanges(changes ...*change.Change) ([]DocEvent, error) {
+	return d.applyChanges(changes, true)
+}
+
+// applyChanges applies the given changes to the document. If withOpInfos is
+// false, the information of the operations is not collected to avoid the cost
+// of it, e.g. when the server builds the document.
+func (d *InternalDocument) applyChanges(changes []*change.Change, withOpInfos bool) ([]DocEvent, error) {
 	var events []DocEvent
 	for _, c := range changes {
 		if c.PresenceChange() != nil {
 			clientID := c.ID().ActorID().String()
 			if _, ok := d.onlineClients.Load(clientID); ok {
 				switch c.PresenceChange().ChangeType {
-				case innerpresence.Put:
+				case innerpresence.Put, innerpresence.Patch:
 					// NOTE(chacha912): When the user exists in onlineClients, but
 					// their presence was initially absent, we can consider that we have
 					// received their initial presence, so trigger the 'watched' event.
@@ -306,10 +316,16 @@ func (d *InternalDocument) ApplyChanges(changes ...*change.Change) ([]DocEvent,
 					if !d.presences.Has(clientID) {
 						eventType = WatchedEvent
 					}
+					// NOTE: The patch carries only the updated keys, so the event
+					// carries the presence that the patch is applied to.
+					p := c.PresenceChange().Presence
+					if c.PresenceChange().ChangeType == innerpresence.Patch {
+						p = d.presences.Load(clientID).Patch(p)
+					}
 					event := DocEvent{
 						Type: eventType,
 						Presences: map[string]innerpresence.Presence{
-							clientID: c.PresenceChange().Presence,
+							clientID: p,
 						},
 					}
 					events = append(events, event)
@@ -331,10 +347,28 @@ func (d *InternalDocument) ApplyChanges(changes ...*change.Change) ([]DocEvent,
 			}
 		}
 
-		if err := c.Execute(d.root, d.presences); err != nil {
+		var infos []OpInfo
+		var hook func(op operations.Operation) (func() error, error)
+		if withOpInfos {
+			hook = opInfoHook(d.root, &infos)
+		}
+		if err := c.ExecuteWithHook(d.root, d.presences, hook); err != nil {
 			return nil, err
 		}
 
+		if len(infos) > 0 {
+			// NOTE: Local changes can be applied again after applying a
+			// snapshot, so they are distinguished by the actor of the change.
+			eventType := RemoteChangeEvent
+			if c.ID().ActorID().Compare(d.ActorID()) == 0 {
+				eventType = LocalChangeEvent
+			}
+			events = append(events, DocEvent{
+				Type:       eventType,
+				Operations: infos,
+			})
+		}
+
 		d.changeID = d.changeID.SyncClocks(c.ID())
 	}
 
@@ -175,6 +175,23 @@ func (p *Array) AddNewCounter(valueType crdt.CounterType, value interface{}) *Co
 	return v.(*Counter)
 }
 
+// AddNewBoundedCounter adds a new counter whose value never goes below the
+// given lower bound at the last.
+func (p *Array) AddNewBoundedCounter(
+	valueType crdt.CounterType,
+	value interface{},
+	lowerBound int64,
+) *Counter {
+	v := p.addInternal(func(ticket *time.Ticket) crdt.Element {
+		counter, err := crdt.NewBoundedCounter(valueType, value, lowerBound, ticket)
+		if err != nil {
+			panic(err)
+		}
+		return NewCounter(value, valueType).Initialize(p.context, counter)
+	})
+	return v.(*Counter)
+}
+
 // AddNewText adds a new text at the last.
 func (p *Array) AddNewText() *Text {
 	v := p.addInternal(func(ticket *time.Ticket) crdt.Element {
@@ -197,6 +214,25 @@ func (p *Array) AddNewTree(initialRoot ...TreeNode) *Tree {
 	return v.(*Tree)
 }
 
+// AddNewTreeWithSchema adds a new tree with the given schema at the last.
+func (p *Array) AddNewTreeWithSchema(schema *TreeSchema, initialRoot ...TreeNode) *Tree {
+	v := p.addInternal(func(ticket *time.Ticket) crdt.Element {
+		var root *TreeNode
+		if len(initialRoot) > 0 {
+			root = &initialRoot[0]
+		}
+		tree := NewTree(root)
+		return tree.Initialize(p.context, newTreeWithSchema(p.context, root, schema, ticket))
+	})
+	return v.(*Tree)
+}
+
+// AddNewSet adds a new set at the last.
+func (p *Array) AddNewSet() *Set {
+	v := p.addInternal(newSet)
+	return NewSet(p.context, v.(*crdt.Set))
+}
+
 // AddNewObject adds a new object at the last.
 func (p *Array) AddNewObject() *Object {
 	v := p.addInternal(func(ticket *time.Ticket) crdt.Element {
@@ -207,47 +243,7 @@ func (p *Array) AddNewObject() *Object {
 
 // AddYSON adds the given YSON element to the array.
 func (p *Array) AddYSON(value interface{}) *Array {
-	switch y := value.(type) {
-	case yson.Counter:
-		p.AddNewCounter(y.Type, y.Value)
-	case yson.Array:
-		a := p.AddNewArray()
-		for _, elem := range y {
-			a.AddYSON(elem)
-		}
-	case yson.Object:
-		o := p.AddNewObject()
-		for key, value := range y {
-			o.SetYSONElement(key, value)
-		}
-	case yson.Text:
-		t := p.AddNewText()
-		t.EditFromYSON(y)
-	case yson.Tree:
-		p.AddNewTree(y.Root)
-	default:
-		switch v := y.(type) {
-		case nil:
-			p.AddNull()
-		case bool:
-			p.AddBool(v)
-		case int32:
-			p.AddInteger(int(v))
-		case int64:
-			p.AddLong(v)
-		case float64:
-			p.AddDouble(v)
-		case string:
-			p.AddString(v)
-		case []byte:
-			p.AddBytes(v)
-		case gotime.Time:
-			p.AddDate(v)
-		default:
-			panic(fmt.Errorf("unsupported primitive type: %v", v))
-		}
-	}
-
+	p.insertYSONAfter(p.Array.LastCreatedAt(), value)
 	return p
 }
 
@@ -266,22 +262,149 @@ func (p *Array) MoveAfterByIndex(prevIndex, targetIndex int) {
 	p.moveAfterInternal(prev.CreatedAt(), target.CreatedAt())
 }
 
+// MoveBeforeByIndex moves the given element to its new position before the given next element.
+func (p *Array) MoveBeforeByIndex(nextIndex, targetIndex int) {
+	next := p.Get(nextIndex)
+	target := p.Get(targetIndex)
+	if next == nil || target == nil {
+		panic("index out of bound")
+	}
+	p.moveBeforeInternal(next.CreatedAt(), target.CreatedAt())
+}
+
+// MoveFront moves the given element to the front of the array.
+func (p *Array) MoveFront(createdAt *time.Ticket) {
+	p.moveAfterInternal(p.Array.HeadCreatedAt(), createdAt)
+}
+
+// MoveFrontByIndex moves the element of the given index to the front of the
+// array.
+func (p *Array) MoveFrontByIndex(targetIndex int) {
+	target := p.Get(targetIndex)
+	if target == nil {
+		panic("index out of bound")
+	}
+	p.MoveFront(target.CreatedAt())
+}
+
+// MoveLast moves the given element to the last of the array.
+func (p *Array) MoveLast(createdAt *time.Ticket) {
+	p.moveAfterInternal(p.Array.LastCreatedAt(), createdAt)
+}
+
+// MoveLastByIndex moves the element of the given index to the last of the
+// array.
+func (p *Array) MoveLastByIndex(targetIndex int) {
+	target := p.Get(targetIndex)
+	if target == nil {
+		panic("index out of bound")
+	}
+	p.MoveLast(target.CreatedAt())
+}
+
+// InsertNullAfter inserts the null after the given previous element.
+func (p *Array) InsertNullAfter(index int) *Array {
+	p.insertAfterIndex(index, newPrimitive(nil))
+	return p
+}
+
+// InsertBoolAfter inserts the given boolean after the given previous element.
+func (p *Array) InsertBoolAfter(index int, v bool) *Array {
+	p.insertAfterIndex(index, newPrimitive(v))
+	return p
+}
+
 // InsertIntegerAfter inserts the given integer after the given previous
 // element.
 func (p *Array) InsertIntegerAfter(index int, v int) *Array {
-	prev := p.Get(index)
-	if prev == nil {
+	p.insertAfterIndex(index, newPrimitive(v))
+	return p
+}
+
+// InsertLongAfter inserts the given long after the given previous element.
+func (p *Array) InsertLongAfter(index int, v int64) *Array {
+	p.insertAfterIndex(index, newPrimitive(v))
+	return p
+}
+
+// InsertDoubleAfter inserts the given double after the given previous element.
+func (p *Array) InsertDoubleAfter(index int, v float64) *Array {
+	p.insertAfterIndex(index, newPrimitive(v))
+	return p
+}
+
+// InsertStringAfter inserts the given string after the given previous element.
+func (p *Array) InsertStringAfter(index int, v string) *Array {
+	p.insertAfterIndex(index, newPrimitive(v))
+	return p
+}
+
+// InsertBytesAfter inserts the given bytes after the given previous element.
+func (p *Array) InsertBytesAfter(index int, v []byte) *Array {
+	p.insertAfterIndex(index, newPrimitive(v))
+	return p
+}
+
+// InsertDateAfter inserts the given date after the given previous element.
+func (p *Array) InsertDateAfter(index int, v gotime.Time) *Array {
+	p.insertAfterIndex(index, newPrimitive(v))
+	return p
+}
+
+// InsertNewObjectAfter inserts a new object after the given previous element.
+func (p *Array) InsertNewObjectAfter(index int) *Object {
+	return p.insertAfterIndex(index, p.newObject).(*Object)
+}
+
+// InsertNewArrayAfter inserts a new array after the given previous element.
+func (p *Array) InsertNewArrayAfter(index int) *Array {
+	return p.insertAfterIndex(index, p.newArray).(*Array)
+}
+
+// InsertNewTextAfter inserts a new text after the given previous element.
+func (p *Array) InsertNewTextAfter(index int) *Text {
+	return p.insertAfterIndex(index, p.newText).(*Text)
+}
+
+// InsertNewTreeAfter inserts a new tree after the given previous element.
+func (p *Array) InsertNewTreeAfter(index int, initialRoot ...TreeNode) *Tree {
+	return p.insertAfterIndex(index, p.newTree(initialRoot...)).(*Tree)
+}
+
+// InsertNewCounterAfter inserts a new counter after the given previous
+// element.
+func (p *Array) InsertNewCounterAfter(index int, valueType crdt.CounterType, value interface{}) *Counter {
+	return p.insertAfterIndex(index, p.newCounter(valueType, value)).(*Counter)
+}
+
+// Splice deletes deleteCount elements from the given start index and inserts
+// the given YSON values at the start index. The deletions and insertions are
+// made in the same change. It returns the deleted elements.
+func (p *Array) Splice(start, deleteCount int, values ...interface{}) []crdt.Element {
+	if start < 0 || p.Len() < start {
 		panic("index out of bound")
 	}
-	p.insertAfterInternal(prev.CreatedAt(), func(ticket *time.Ticket) crdt.Element {
-		primitive, err := crdt.NewPrimitive(v, ticket)
-		if err != nil {
-			panic(err)
-		}
-		return primitive
-	})
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+	if p.Len()-start < deleteCount {
+		deleteCount = p.Len() - start
+	}
 
-	return p
+	var deleted []crdt.Element
+	for i := 0; i < deleteCount; i++ {
+		deleted = append(deleted, p.Delete(start))
+	}
+
+	prevCreatedAt := p.Array.HeadCreatedAt()
+	if start > 0 {
+		prevCreatedAt = p.Get(start - 1).CreatedAt()
+	}
+	for _, value := range values {
+		prevCreatedAt = p.insertYSONAfter(prevCreatedAt, value).CreatedAt()
+	}
+
+	return deleted
 }
 
 // Get element of the given index.
@@ -386,23 +509,94 @@ func (p *Array) GetTree(idx int) *Tree {
 	}
 }
 
+// GetSet returns Set of the given index.
+func (p *Array) GetSet(idx int) *Set {
+	element := p.Get(idx)
+	if element == nil {
+		return nil
+	}
+
+	switch elem := element.(type) {
+	case *crdt.Set:
+		return NewSet(p.context, elem)
+	default:
+		panic("unsupported type")
+	}
+}
+
+// SetNull sets the null to the element of the given index.
+func (p *Array) SetNull(idx int) *Array {
+	p.setByIndex(idx, newPrimitive(nil))
+	return p
+}
+
+// SetBool sets the given boolean to the element of the given index.
+func (p *Array) SetBool(idx int, value bool) *Array {
+	p.setByIndex(idx, newPrimitive(value))
+	return p
+}
+
 // SetInteger sets element of the given index.
 func (p *Array) SetInteger(idx int, value int) *Array {
-	target := p.Get(idx)
-	if target == nil {
-		panic("index out of bound")
-	}
+	p.setByIndex(idx, newPrimitive(value))
+	return p
+}
 
-	p.setByIndexInternal(target.CreatedAt(), func(ticket *time.Ticket) crdt.Element {
-		primitive, err := crdt.NewPrimitive(value, ticket)
-		if err != nil {
-			panic(err)
-		}
-		return primitive
-	})
+// SetLong sets the given long to the element of the given index.
+func (p *Array) SetLong(idx int, value int64) *Array {
+	p.setByIndex(idx, newPrimitive(value))
+	return p
+}
+
+// SetDouble sets the given double to the element of the given index.
+func (p *Array) SetDouble(idx int, value float64) *Array {
+	p.setByIndex(idx, newPrimitive(value))
+	return p
+}
+
+// SetString sets the given string to the element of the given index.
+func (p *Array) SetString(idx int, value string) *Array {
+	p.setByIndex(idx, newPrimitive(value))
 	return p
 }
 
+// SetBytes sets the given bytes to the element of the given index.
+func (p *Array) SetBytes(idx int, value []byte) *Array {
+	p.setByIndex(idx, newPrimitive(value))
+	return p
+}
+
+// SetDate sets the given date to the element of the given index.
+func (p *Array) SetDate(idx int, value gotime.Time) *Array {
+	p.setByIndex(idx, newPrimitive(value))
+	return p
+}
+
+// SetNewObject sets a new object to the element of the given index.
+func (p *Array) SetNewObject(idx int) *Object {
+	return p.setByIndex(idx, p.newObject).(*Object)
+}
+
+// SetNewArray sets a new array to the element of the given index.
+func (p *Array) SetNewArray(idx int) *Array {
+	return p.setByIndex(idx, p.newArray).(*Array)
+}
+
+// SetNewText sets a new text to the element of the given index.
+func (p *Array) SetNewText(idx int) *Text {
+	return p.setByIndex(idx, p.newText).(*Text)
+}
+
+// SetNewTree sets a new tree to the element of the given index.
+func (p *Array) SetNewTree(idx int, initialRoot ...TreeNode) *Tree {
+	return p.setByIndex(idx, p.newTree(initialRoot...)).(*Tree)
+}
+
+// SetNewCounter sets a new counter to the element of the given index.
+func (p *Array) SetNewCounter(idx int, valueType crdt.CounterType, value interface{}) *Counter {
+	return p.setByIndex(idx, p.newCounter(valueType, value)).(*Counter)
+}
+
 // Delete deletes the element of the given index.
 func (p *Array) Delete(idx int) crdt.Element {
 	if idx < 0 || p.Len() <= idx {
@@ -428,6 +622,132 @@ func (p *Array) Len() int {
 	return p.Array.Len()
 }
 
+// newPrimitive returns a creator of the primitive of the given value.
+func newPrimitive(value interface{}) func(ticket *time.Ticket) crdt.Element {
+	return func(ticket *time.Ticket) crdt.Element {
+		primitive, err := crdt.NewPrimitive(value, ticket)
+		if err != nil {
+			panic(err)
+		}
+		return primitive
+	}
+}
+
+func (p *Array) newObject(ticket *time.Ticket) crdt.Element {
+	return NewObject(p.context, crdt.NewObject(crdt.NewElementRHT(), ticket))
+}
+
+func (p *Array) newArray(ticket *time.Ticket) crdt.Element {
+	return NewArray(p.context, crdt.NewArray(crdt.NewRGATreeList(), ticket))
+}
+
+func (p *Array) newText(ticket *time.Ticket) crdt.Element {
+	text := NewText()
+	return text.Initialize(p.context, crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ticket))
+}
+
+func newSet(ticket *time.Ticket) crdt.Element {
+	return crdt.NewSet(ticket)
+}
+
+func (p *Array) newTree(initialRoot ...TreeNode) func(ticket *time.Ticket) crdt.Element {
+	return func(ticket *time.Ticket) crdt.Element {
+		var root TreeNode
+		if len(initialRoot) > 0 {
+			root = initialRoot[0]
+		}
+		tree := NewTree(&root)
+		return tree.Initialize(p.context, crdt.NewTree(buildRoot(p.context, &root, ticket), ticket))
+	}
+}
+
+func (p *Array) newCounter(
+	valueType crdt.CounterType,
+	value interface{},
+) func(ticket *time.Ticket) crdt.Element {
+	return func(ticket *time.Ticket) crdt.Element {
+		counter, err := crdt.NewCounter(valueType, value, ticket)
+		if err != nil {
+			panic(err)
+		}
+		return NewCounter(value, valueType).Initialize(p.context, counter)
+	}
+}
+
+// insertYSONAfter inserts the given YSON element after the given previous
+// element.
+func (p *Array) insertYSONAfter(prevCreatedAt *time.Ticket, value interface{}) crdt.Element {
+	switch y := value.(type) {
+	case yson.Counter:
+		return p.insertAfterInternal(prevCreatedAt, func(ticket *time.Ticket) crdt.Element {
+			counter, err := newCounterFromYSON(y, ticket)
+			if err != nil {
+				panic(err)
+			}
+			return NewCounter(y.Value, y.Type).Initialize(p.context, counter)
+		})
+	case yson.Array:
+		a := p.insertAfterInternal(prevCreatedAt, p.newArray).(*Array)
+		for _, elem := range y {
+			a.AddYSON(elem)
+		}
+		return a
+	case yson.Object:
+		o := p.insertAfterInternal(prevCreatedAt, p.newObject).(*Object)
+		for key, value := range y {
+			o.SetYSONElement(key, value)
+		}
+		return o
+	case yson.Text:
+		t := p.insertAfterInternal(prevCreatedAt, p.newText).(*Text)
+		t.EditFromYSON(y)
+		return t
+	case yson.Tree:
+		return p.insertAfterInternal(prevCreatedAt, p.newTree(y.Root))
+	case yson.Set:
+		s := p.insertAfterInternal(prevCreatedAt, newSet).(*crdt.Set)
+		NewSet(p.context, s).Add(y...)
+		return s
+	default:
+		switch v := y.(type) {
+		case nil, bool, int64, float64, string, []byte, gotime.Time:
+			return p.insertAfterInternal(prevCreatedAt, newPrimitive(v))
+		case int32:
+			return p.insertAfterInternal(prevCreatedAt, newPrimitive(int(v)))
+		case int:
+			return p.insertAfterInternal(prevCreatedAt, newPrimitive(v))
+		default:
+			panic(fmt.Errorf("unsupported primitive type: %v", v))
+		}
+	}
+}
+
+// insertAfterIndex inserts the element created by the given creator after the
+// element of the given index.
+func (p *Array) insertAfterIndex(
+	index int,
+	creator func(ticket *time.Ticket) crdt.Element,
+) crdt.Element {
+	prev := p.Get(index)
+	if prev == nil {
+		panic("index out of bound")
+	}
+	return p.insertAfterInternal(prev.CreatedAt(), creator)
+}
+
+// setByIndex sets the element created by the given creator to the element of
+// the given index.
+func (p *Array) setByIndex(
+	idx int,
+	creator func(ticket *time.Ticket) crdt.Element,
+) crdt.Element {
+	target := p.Get(idx)
+	if target == nil {
+		panic("index out of bound")
+	}
+	return p.setByIndexInternal(target.CreatedAt(), creator)
+}
+
 func (p *Array) addInternal(
 	creator func(ticket *time.Ticket) crdt.Element,
 ) crdt.Element {
@@ -456,18 +776,22 @@ func (p *Array) insertAfterInternal(
 	if err = p.InsertAfter(prevCreatedAt, value); err != nil {
 		panic(err)
 	}
-	p.context.RegisterElement(value)
+	p.context.RegisterElement(p.Array, value)
 
 	return elem
 }
 
 func (p *Array) moveBeforeInternal(nextCreatedAt, createdAt *time.Ticket) {
-	ticket := p.context.IssueTimeTicket()
-
 	prevCreatedAt, err := p.FindPrevCreatedAt(nextCreatedAt)
 	if err != nil {
 		panic(err)
 	}
+	// NOTE: Moving the element after itself does not change the array.
+	if prevCreatedAt.Compare(createdAt) == 0 {
+		return
+	}
+
+	ticket := p.context.IssueTimeTicket()
 
 	p.context.Push(operations.NewMove(
 		p.Array.CreatedAt(),
@@ -482,6 +806,11 @@ func (p *Array) moveBeforeInternal(nextCreatedAt, createdAt *time.Ticket) {
 }
 
 func (p *Array) moveAfterInternal(prevCreatedAt, createdAt *time.Ticket) {
+	// NOTE: Moving the element after itself does not change the array.
+	if prevCreatedAt.Compare(createdAt) == 0 {
+		return
+	}
+
 	ticket := p.context.IssueTimeTicket()
 
 	p.context.Push(operations.NewMove(
@@ -523,6 +852,6 @@ func (p *Array) setByIndexInternal(
 	}
 	// TODO(junseo): GC logic is not implemented here
 	// because there is no way to distinguish between old and new element with same `createdAt`.
-	p.context.RegisterElement(value)
+	p.context.RegisterElement(p.Array, value)
 	return elem
 }
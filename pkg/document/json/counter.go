@@ -22,6 +22,8 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/document/yson"
 )
 
 // Counter represents a counter in the document. As a proxy for the CRDT counter,
@@ -52,6 +54,30 @@ func (p *Counter) Initialize(ctx *change.Context, counter *crdt.Counter) *Counte
 	return p
 }
 
+// newCounterFromYSON creates a new CRDT counter from the given YSON counter.
+// The counter keeps the lower bound and the reset state of the YSON counter.
+func newCounterFromYSON(y yson.Counter, ticket *time.Ticket) (*crdt.Counter, error) {
+	if y.Bounded {
+		return crdt.NewBoundedCounter(y.Type, y.Value, y.LowerBound, ticket)
+	}
+	if !y.Reset {
+		return crdt.NewCounter(y.Type, y.Value, ticket)
+	}
+
+	// NOTE: The value of the reset counter is restored as the increase of
+	// the creator instead of the initial value, so that the next reset
+	// cancels it only if the resetter has observed it.
+	counter, err := crdt.NewCounter(y.Type, 0, ticket)
+	if err != nil {
+		return nil, err
+	}
+	increases := []*crdt.CounterEntry{crdt.NewCounterEntry(valueAsLong(y.Value), ticket)}
+	if err := counter.Restore(increases, nil, true); err != nil {
+		return nil, err
+	}
+	return counter, nil
+}
+
 // Increase adds an increase operations.
 // Only numeric types are allowed as operand values, excluding
 // uint64 and uintptr.
@@ -84,6 +110,9 @@ func (p *Counter) Increase(v interface{}) *Counter {
 	if err != nil {
 		panic(err)
 	}
+	if p.IsBounded() {
+		p.checkRights(-valueAsLong(primitive.Value()))
+	}
 	if _, err := p.Counter.Increase(primitive); err != nil {
 		panic(err)
 	}
@@ -97,6 +126,75 @@ func (p *Counter) Increase(v interface{}) *Counter {
 	return p
 }
 
+// Reset resets the counter to zero. The increases made concurrently with the
+// reset by others remain. It is not supported for the bounded counter.
+func (p *Counter) Reset() *Counter {
+	ticket := p.context.IssueTimeTicket()
+	observed := p.Counter.Increases()
+	if err := p.Counter.Reset(observed); err != nil {
+		panic(err)
+	}
+
+	p.context.Push(operations.NewCounterReset(
+		p.CreatedAt(),
+		observed,
+		ticket,
+	))
+
+	return p
+}
+
+// Rights returns the amount that the current actor can decrease or transfer
+// in the bounded counter.
+func (p *Counter) Rights() int64 {
+	return p.RightsOf(p.context.ActorID())
+}
+
+// Transfer transfers the given amount of rights of the current actor to the
+// given actor in the bounded counter.
+func (p *Counter) Transfer(to time.ActorID, amount int64) *Counter {
+	if amount <= 0 {
+		panic("amount must be positive")
+	}
+	from := p.context.ActorID()
+	p.checkRights(amount)
+
+	ticket := p.context.IssueTimeTicket()
+	if err := p.Counter.Transfer(from, to, amount); err != nil {
+		panic(err)
+	}
+
+	p.context.Push(operations.NewCounterTransfer(
+		p.CreatedAt(),
+		from,
+		to,
+		amount,
+		ticket,
+	))
+
+	return p
+}
+
+// checkRights panics if the current actor does not have the given amount of
+// rights in the bounded counter.
+func (p *Counter) checkRights(amount int64) {
+	if amount > 0 && p.Rights() < amount {
+		panic(crdt.ErrInsufficientRights)
+	}
+}
+
+// valueAsLong returns the given integer value as int64.
+func valueAsLong(v interface{}) int64 {
+	switch val := v.(type) {
+	case int32:
+		return int64(val)
+	case int64:
+		return val
+	default:
+		return 0
+	}
+}
+
 // isAllowedOperand indicates whether
 // the operand of increase is an allowable type.
 func isAllowedOperand(v interface{}) bool {
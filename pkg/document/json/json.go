@@ -19,6 +19,7 @@ package json
 
 import (
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/yson"
 )
 
 func toOriginal(elem crdt.Element) crdt.Element {
@@ -33,8 +34,37 @@ func toOriginal(elem crdt.Element) crdt.Element {
 		return elem.Counter
 	case *Tree:
 		return elem.Tree
+	case *crdt.Set:
+		return elem
+	case *crdt.MVRegister:
+		return elem
 	case *crdt.Primitive:
 		return elem
 	}
 	panic("unsupported type")
 }
+
+// ToYSON returns the YSON of the given element. If the element is a proxy
+// that is not initialized yet, such as the one created by NewText, it returns
+// the YSON of the initial value of the proxy.
+func ToYSON(elem crdt.Element) (interface{}, error) {
+	switch p := elem.(type) {
+	case *Text:
+		if p.Text == nil {
+			return yson.Text{}, nil
+		}
+	case *Counter:
+		if p.Counter == nil {
+			return yson.Counter{Type: p.valueType, Value: p.value}, nil
+		}
+	case *Tree:
+		if p.Tree == nil {
+			if p.initialRoot == nil {
+				return yson.Tree{Root: TreeNode{Type: yson.DefaultRootNodeType}}, nil
+			}
+			return yson.Tree{Root: *p.initialRoot}, nil
+		}
+	}
+
+	return yson.FromCRDT(toOriginal(elem))
+}
@@ -112,6 +112,21 @@ func (p *Object) SetNewCounter(k string, t crdt.CounterType, n any) *Counter {
 	return v.(*Counter)
 }
 
+// SetNewBoundedCounter sets a new counter whose value never goes below the
+// given lower bound for the given key.
+func (p *Object) SetNewBoundedCounter(k string, t crdt.CounterType, n any, lowerBound int64) *Counter {
+	v := p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
+		crdtCounter, err := crdt.NewBoundedCounter(t, n, lowerBound, ticket)
+		if err != nil {
+			panic(err)
+		}
+
+		return NewCounter(n, t).Initialize(p.context, crdtCounter)
+	})
+
+	return v.(*Counter)
+}
+
 // SetNewTree sets a new Tree for the given key.
 func (p *Object) SetNewTree(k string, initialRoot ...TreeNode) *Tree {
 	v := p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
@@ -126,6 +141,51 @@ func (p *Object) SetNewTree(k string, initialRoot ...TreeNode) *Tree {
 	return v.(*Tree)
 }
 
+// SetNewTreeWithSchema sets a new Tree with the given schema for the given
+// key. The edits of the tree that do not conform to the schema are rejected.
+func (p *Object) SetNewTreeWithSchema(k string, schema *TreeSchema, initialRoot ...TreeNode) *Tree {
+	v := p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
+		var root *TreeNode
+		if len(initialRoot) > 0 {
+			root = &initialRoot[0]
+		}
+		tree := NewTree(root)
+		return tree.Initialize(p.context, newTreeWithSchema(p.context, root, schema, ticket))
+	})
+
+	return v.(*Tree)
+}
+
+// SetNewSet sets a new Set for the given key. It accepts an optional
+// yson.Set that will be used to initialize the set.
+func (p *Object) SetNewSet(k string, v ...yson.Set) *Set {
+	value := p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
+		return crdt.NewSet(ticket)
+	})
+	set := NewSet(p.context, value.(*crdt.Set))
+
+	if len(v) > 0 {
+		set.Add(v[0]...)
+	}
+
+	return set
+}
+
+// SetNewRegister sets a new Register for the given key. It accepts an
+// optional initial value of the register.
+func (p *Object) SetNewRegister(k string, v ...interface{}) *Register {
+	value := p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
+		return crdt.NewMVRegister(ticket)
+	})
+	register := NewRegister(p.context, value.(*crdt.MVRegister))
+
+	if len(v) > 0 {
+		register.Set(v[0])
+	}
+
+	return register
+}
+
 // SetNull sets the null for the given key.
 func (p *Object) SetNull(k string) *Object {
 	p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
@@ -234,7 +294,13 @@ func (p *Object) SetDate(k string, v gotime.Time) *Object {
 func (p *Object) SetYSONElement(k string, v interface{}) *Object {
 	switch y := v.(type) {
 	case yson.Counter:
-		p.SetNewCounter(k, y.Type, y.Value)
+		p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
+			counter, err := newCounterFromYSON(y, ticket)
+			if err != nil {
+				panic(err)
+			}
+			return NewCounter(y.Value, y.Type).Initialize(p.context, counter)
+		})
 	case yson.Array:
 		arr := p.SetNewArray(k)
 		for _, elem := range y {
@@ -250,6 +316,15 @@ func (p *Object) SetYSONElement(k string, v interface{}) *Object {
 		text.EditFromYSON(y)
 	case yson.Tree:
 		p.SetNewTree(k, y.Root)
+	case yson.Set:
+		p.SetNewSet(k, y)
+	case yson.Register:
+		register := p.SetNewRegister(k)
+		for _, value := range y {
+			// NOTE: The values do not overwrite each other to keep the
+			// conflicts of the register.
+			register.assign(value, nil)
+		}
 	default:
 		switch v := v.(type) {
 		case nil:
@@ -380,6 +455,36 @@ func (p *Object) GetTree(k string) *Tree {
 	}
 }
 
+// GetSet returns Set of the given key.
+func (p *Object) GetSet(k string) *Set {
+	elem := p.Object.Get(k)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := p.Object.Get(k).(type) {
+	case *crdt.Set:
+		return NewSet(p.context, elem)
+	default:
+		panic("unsupported type")
+	}
+}
+
+// GetRegister returns Register of the given key.
+func (p *Object) GetRegister(k string) *Register {
+	elem := p.Object.Get(k)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := elem.(type) {
+	case *crdt.MVRegister:
+		return NewRegister(p.context, elem)
+	default:
+		panic("unsupported type")
+	}
+}
+
 func (p *Object) setInternal(
 	k string,
 	creator func(ticket *time.Ticket) crdt.Element,
@@ -394,7 +499,7 @@ func (p *Object) setInternal(
 	}
 
 	removed := p.Set(k, value)
-	p.context.RegisterElement(value)
+	p.context.RegisterElement(p.Object, value)
 	if removed != nil {
 		p.context.RegisterRemovedElementPair(p, removed)
 	}
@@ -0,0 +1,98 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Register represents a multi-value register in the document. As a proxy for
+// the CRDT register, it is used when the user manipulates the register from
+// the outside.
+type Register struct {
+	*crdt.MVRegister
+	context *change.Context
+}
+
+// NewRegister creates a new instance of Register.
+func NewRegister(ctx *change.Context, register *crdt.MVRegister) *Register {
+	return &Register{
+		MVRegister: register,
+		context:    ctx,
+	}
+}
+
+// Set assigns the given value to this register. It overwrites all the values
+// of the register, so it also resolves the conflicts.
+func (p *Register) Set(value interface{}) *Register {
+	var overwrites []*time.Ticket
+	for _, node := range p.MVRegister.Nodes() {
+		if node.RemovedAt() == nil {
+			overwrites = append(overwrites, node.Value().CreatedAt())
+		}
+	}
+
+	p.assign(value, overwrites)
+	return p
+}
+
+// Value returns the value that was assigned last. It returns nil if no value
+// has been assigned.
+func (p *Register) Value() interface{} {
+	value := p.MVRegister.Value()
+	if value == nil {
+		return nil
+	}
+	return value.Value()
+}
+
+// Conflicts returns the values assigned concurrently in the order of their
+// assignment. It returns nil if there is no conflict.
+func (p *Register) Conflicts() []interface{} {
+	var values []interface{}
+	for _, value := range p.MVRegister.Conflicts() {
+		values = append(values, value.Value())
+	}
+	return values
+}
+
+// assign assigns the given value to this register, overwriting the values of
+// the given creation times.
+func (p *Register) assign(value interface{}, overwrites []*time.Ticket) {
+	ticket := p.context.IssueTimeTicket()
+	primitive, err := crdt.NewPrimitive(value, ticket)
+	if err != nil {
+		panic(err)
+	}
+
+	copiedValue, err := primitive.DeepCopy()
+	if err != nil {
+		panic(err)
+	}
+	p.context.Push(operations.NewRegisterSet(
+		p.CreatedAt(),
+		copiedValue,
+		overwrites,
+		ticket,
+	))
+	for _, pair := range p.MVRegister.Assign(primitive, overwrites, ticket) {
+		p.context.RegisterGCPair(pair)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Set represents a set of primitive values in the document. As a proxy for
+// the CRDT set, it is used when the user manipulates the set from the outside.
+type Set struct {
+	*crdt.Set
+	context *change.Context
+}
+
+// NewSet creates a new instance of Set.
+func NewSet(ctx *change.Context, set *crdt.Set) *Set {
+	return &Set{
+		Set:     set,
+		context: ctx,
+	}
+}
+
+// Add adds the given values to this set. The values added concurrently with
+// their removal remain in the set.
+func (p *Set) Add(values ...interface{}) *Set {
+	for _, value := range values {
+		ticket := p.context.IssueTimeTicket()
+		primitive, err := crdt.NewPrimitive(value, ticket)
+		if err != nil {
+			panic(err)
+		}
+
+		copiedValue, err := primitive.DeepCopy()
+		if err != nil {
+			panic(err)
+		}
+		p.context.Push(operations.NewSetAdd(
+			p.CreatedAt(),
+			copiedValue,
+			ticket,
+		))
+		p.Set.Add(primitive)
+	}
+	return p
+}
+
+// Remove removes the given values from this set.
+func (p *Set) Remove(values ...interface{}) *Set {
+	for _, value := range values {
+		primitive, err := crdt.NewPrimitive(value, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		nodes := p.Set.NodesOf(primitive)
+		if len(nodes) == 0 {
+			continue
+		}
+
+		var createdAts []*time.Ticket
+		for _, node := range nodes {
+			createdAts = append(createdAts, node.Value().CreatedAt())
+		}
+
+		ticket := p.context.IssueTimeTicket()
+		for _, pair := range p.Set.Delete(createdAts, ticket) {
+			p.context.RegisterGCPair(pair)
+		}
+		p.context.Push(operations.NewSetRemove(
+			p.CreatedAt(),
+			createdAts,
+			ticket,
+		))
+	}
+	return p
+}
+
+// Has returns whether this set has the given value.
+func (p *Set) Has(value interface{}) bool {
+	primitive, err := crdt.NewPrimitive(value, nil)
+	if err != nil {
+		panic(err)
+	}
+	return p.Set.Has(primitive)
+}
+
+// Values returns the values of this set in the order of their addition.
+func (p *Set) Values() []interface{} {
+	var values []interface{}
+	for _, elem := range p.Set.Elements() {
+		values = append(values, elem.Value())
+	}
+	return values
+}
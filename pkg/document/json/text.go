@@ -17,6 +17,8 @@
 package json
 
 import (
+	gojson "encoding/json"
+	"fmt"
 	"unicode/utf16"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
@@ -53,6 +55,72 @@ func (p *Text) CreateRange(from, to int) (*crdt.RGATreeSplitNodePos, *crdt.RGATr
 	return fromPos, toPos
 }
 
+// TextSelection is a selection of the text that can be shared with other
+// clients, e.g. stored in presence. Unlike integer offsets, it keeps pointing
+// to the same characters even if the text is edited concurrently.
+type TextSelection struct {
+	From crdt.RGATreeSplitNodePosStruct `json:"from"`
+	To   crdt.RGATreeSplitNodePosStruct `json:"to"`
+}
+
+// ParseTextSelection parses the given JSON encoding of TextSelection.
+func ParseTextSelection(data string) (*TextSelection, error) {
+	sel := &TextSelection{}
+	if err := gojson.Unmarshal([]byte(data), sel); err != nil {
+		return nil, fmt.Errorf("parse text selection: %w", err)
+	}
+	return sel, nil
+}
+
+// Marshal returns the JSON encoding of this selection.
+func (s *TextSelection) Marshal() string {
+	bytes, err := gojson.Marshal(s)
+	if err != nil {
+		panic(err)
+	}
+	return string(bytes)
+}
+
+// CreateSelection creates a selection from the given offsets.
+func (p *Text) CreateSelection(from, to int) *TextSelection {
+	fromPos, err := p.Text.IndexToPos(from)
+	if err != nil {
+		panic(err)
+	}
+	toPos, err := p.Text.IndexToPos(to)
+	if err != nil {
+		panic(err)
+	}
+
+	return &TextSelection{
+		From: fromPos.ToStruct(),
+		To:   toPos.ToStruct(),
+	}
+}
+
+// SelectionToIndexes returns the current offsets of the given selection.
+func (p *Text) SelectionToIndexes(sel *TextSelection) (int, int, error) {
+	fromPos, err := crdt.NewRGATreeSplitNodePosFromStruct(sel.From)
+	if err != nil {
+		return 0, 0, err
+	}
+	toPos, err := crdt.NewRGATreeSplitNodePosFromStruct(sel.To)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	from, err := p.Text.PosToIndex(fromPos)
+	if err != nil {
+		return 0, 0, err
+	}
+	to, err := p.Text.PosToIndex(toPos)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return from, to, nil
+}
+
 // Edit edits the given range with the given content and attributes.
 func (p *Text) Edit(
 	from,
@@ -115,6 +183,17 @@ func (p *Text) EditFromYSON(j yson.Text) *Text {
 		}
 		pos += len(utf16.Encode([]rune(node.Value)))
 	}
+
+	for _, mark := range j.Marks {
+		switch {
+		case mark.Removed:
+			p.Unmark(mark.From, mark.To, mark.Key, mark.Expand)
+		case mark.Mergeable:
+			p.Mark(mark.From, mark.To, mark.Key, mark.Value, mark.Expand)
+		default:
+			p.MarkWithID(mark.From, mark.To, mark.Key, mark.Value, mark.Expand)
+		}
+	}
 	return p
 }
 
@@ -154,3 +233,92 @@ func (p *Text) Style(from, to int, attributes map[string]string) *Text {
 
 	return p
 }
+
+// RemoveStyle removes the given attributes of the given range.
+func (p *Text) RemoveStyle(from, to int, attributesToRemove []string) *Text {
+	if from > to {
+		panic("from should be less than or equal to to")
+	}
+	fromPos, toPos, err := p.Text.CreateRange(from, to)
+	if err != nil {
+		panic(err)
+	}
+
+	ticket := p.context.IssueTimeTicket()
+	pairs, err := p.Text.RemoveStyle(
+		fromPos,
+		toPos,
+		attributesToRemove,
+		ticket,
+		nil,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, pair := range pairs {
+		p.context.RegisterGCPair(pair)
+	}
+
+	p.context.Push(operations.NewStyleRemove(
+		p.CreatedAt(),
+		fromPos,
+		toPos,
+		attributesToRemove,
+		ticket,
+	))
+
+	return p
+}
+
+// Mark applies the mark of the given key and value to the given range. Unlike
+// Style, the mark is anchored to the boundaries of the range, and the text
+// inserted at the boundaries has the mark by the given expand behavior even if
+// it is inserted concurrently. A later mark of the same key overrides it.
+func (p *Text) Mark(from, to int, key, value string, expand crdt.MarkExpand) *Text {
+	p.mark(from, to, key, value, expand, true, false)
+	return p
+}
+
+// MarkWithID applies the non-mergeable mark of the given key and value to the
+// given range, such as comments. Non-mergeable marks of the same key are kept
+// separately even if they overlap. It returns the ID of the mark.
+func (p *Text) MarkWithID(from, to int, key, value string, expand crdt.MarkExpand) string {
+	return p.mark(from, to, key, value, expand, false, false).ID().Key()
+}
+
+// Unmark removes the marks of the given key from the given range. The text
+// inserted at the boundaries of the range is not marked by the earlier marks
+// by the given expand behavior.
+func (p *Text) Unmark(from, to int, key string, expand crdt.MarkExpand) *Text {
+	p.mark(from, to, key, "", expand, true, true)
+	return p
+}
+
+func (p *Text) mark(
+	from, to int,
+	key, value string,
+	expand crdt.MarkExpand,
+	mergeable, removed bool,
+) *crdt.TextMark {
+	fromPos, toPos, err := p.Text.CreateRange(from, to)
+	if err != nil {
+		panic(err)
+	}
+	start, end, err := p.Text.CreateMarkAnchors(from, to, expand)
+	if err != nil {
+		panic(err)
+	}
+
+	ticket := p.context.IssueTimeTicket()
+	mark := crdt.NewTextMark(nil, key, value, expand, mergeable, removed, start, end)
+	p.context.Push(operations.NewStyleMark(
+		p.CreatedAt(),
+		fromPos,
+		toPos,
+		mark,
+		ticket,
+	))
+
+	return p.Text.Mark(mark, ticket)
+}
@@ -182,6 +182,38 @@ func (t *Tree) EditBulkByPath(fromPath []int, toPath []int, contents []*TreeNode
 	return t.edit(fromPos, toPos, contents, splitLevel)
 }
 
+// Move moves the element node of the given path to the position of the given
+// path. The destination path is the position in the tree before the move,
+// e.g. moving `[0]` to `[2]` places the first child after the second child.
+func (t *Tree) Move(fromPath []int, toPath []int) bool {
+	if len(fromPath) == 0 || len(toPath) == 0 {
+		panic(ErrEmptyPath)
+	}
+
+	node, err := t.Tree.PathToNode(fromPath)
+	if err != nil {
+		panic(err)
+	}
+	toPos, err := t.Tree.PathToPos(toPath)
+	if err != nil {
+		panic(err)
+	}
+
+	ticket := t.context.IssueTimeTicket()
+	if err := t.Tree.Move(node.ID(), toPos, ticket); err != nil {
+		panic(err)
+	}
+
+	t.context.Push(operations.NewTreeMove(
+		t.CreatedAt(),
+		node.ID(),
+		toPos,
+		ticket,
+	))
+
+	return true
+}
+
 // Style sets the attributes to the elements of the given range.
 func (t *Tree) Style(fromIdx, toIdx int, attributes map[string]string) bool {
 	if fromIdx > toIdx {
@@ -354,6 +386,23 @@ func (t *Tree) edit(fromPos, toPos *crdt.TreePos, contents []*TreeNode, splitLev
 	return true
 }
 
+// newTreeWithSchema creates a new CRDT-based tree with the given schema. It
+// panics if the initial root does not conform to the schema.
+func newTreeWithSchema(
+	ctx *change.Context,
+	root *TreeNode,
+	schema *TreeSchema,
+	createdAt *time.Ticket,
+) *crdt.Tree {
+	tree := crdt.NewTree(buildRoot(ctx, root, createdAt), createdAt)
+	tree.SetSchema((*crdt.TreeSchema)(schema))
+	if err := tree.Validate(); err != nil {
+		panic(err)
+	}
+
+	return tree
+}
+
 // buildRoot converts the given node to a CRDT-based tree node. If the given
 // node is nil, it creates a default root node.
 func buildRoot(ctx *change.Context, node *TreeNode, createdAt *time.Ticket) *crdt.TreeNode {
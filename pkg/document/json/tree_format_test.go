@@ -0,0 +1,173 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/yson"
+)
+
+func TestTreeSchema(t *testing.T) {
+	t.Run("validate test", func(t *testing.T) {
+		assert.NoError(t, json.DefaultTreeSchema.Validate(json.TreeNode{
+			Type: "doc",
+			Children: []json.TreeNode{{
+				Type: "paragraph",
+				Children: []json.TreeNode{
+					{Type: "text", Value: "a"},
+					{Type: "strong", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+				},
+			}},
+		}))
+
+		assert.ErrorIs(t, json.DefaultTreeSchema.Validate(json.TreeNode{
+			Type: "root",
+		}), json.ErrInvalidTreeNode)
+		assert.ErrorIs(t, json.DefaultTreeSchema.Validate(json.TreeNode{
+			Type:     "doc",
+			Children: []json.TreeNode{{Type: "text", Value: "a"}},
+		}), json.ErrInvalidTreeNode)
+		assert.ErrorIs(t, json.DefaultTreeSchema.Validate(json.TreeNode{
+			Type:     "doc",
+			Children: []json.TreeNode{{Type: "video"}},
+		}), json.ErrInvalidTreeNode)
+		assert.ErrorIs(t, json.DefaultTreeSchema.Validate(json.TreeNode{
+			Type: "doc",
+			Children: []json.TreeNode{{
+				Type:     "paragraph",
+				Children: []json.TreeNode{{Type: "text"}},
+			}},
+		}), json.ErrInvalidTreeNode)
+	})
+}
+
+func TestTreeProseMirror(t *testing.T) {
+	t.Run("prosemirror round trip test", func(t *testing.T) {
+		pm := `{"type":"doc","content":[` +
+			`{"type":"heading","attrs":{"level":1},"content":[{"type":"text","text":"Title"}]},` +
+			`{"type":"paragraph","content":[` +
+			`{"type":"text","text":"Hello "},` +
+			`{"type":"text","marks":[{"type":"strong"}],"text":"bold "},` +
+			`{"type":"text","marks":[{"type":"strong"},{"type":"em"}],"text":"both"},` +
+			`{"type":"text","text":" "},` +
+			`{"type":"text","marks":[{"type":"link","attrs":{"href":"https://yorkie.dev"}}],"text":"link"}` +
+			`]},` +
+			`{"type":"horizontal_rule"}]}`
+
+		node, err := json.TreeNodeFromProseMirror([]byte(pm))
+		assert.NoError(t, err)
+		assert.NoError(t, json.DefaultTreeSchema.Validate(node))
+		assert.Equal(t, "1", node.Children[0].Attributes["level"])
+		assert.Equal(t, "strong", node.Children[1].Children[1].Type)
+		assert.Len(t, node.Children[1].Children[1].Children, 2)
+
+		data, err := json.TreeNodeToProseMirror(node, json.DefaultTreeSchema)
+		assert.NoError(t, err)
+		assert.Equal(t, pm, string(data))
+	})
+
+	t.Run("invalid prosemirror test", func(t *testing.T) {
+		_, err := json.TreeNodeFromProseMirror([]byte(`{"type":"doc"`))
+		assert.ErrorIs(t, err, json.ErrInvalidProseMirror)
+
+		_, err = json.TreeNodeFromProseMirror([]byte(`{"type":"text","text":"a"}`))
+		assert.ErrorIs(t, err, json.ErrInvalidProseMirror)
+
+		_, err = json.TreeNodeFromProseMirror([]byte(`{"type":"doc","content":[{"type":"text","text":""}]}`))
+		assert.ErrorIs(t, err, json.ErrInvalidProseMirror)
+	})
+}
+
+func TestTreeMarkdown(t *testing.T) {
+	t.Run("markdown round trip test", func(t *testing.T) {
+		md := "# Yorkie\n" +
+			"\n" +
+			"Hello **bold *both*** and `code` with [link](https://yorkie.dev \"Yorkie\").\\\n" +
+			"Next line with snake_case and \\*stars\\*.\n" +
+			"\n" +
+			"> quoted\n" +
+			">\n" +
+			"> - nested\n" +
+			"\n" +
+			"```go\n" +
+			"fmt.Println(\"a\")\n" +
+			"\n" +
+			"fmt.Println(\"b\")\n" +
+			"```\n" +
+			"\n" +
+			"- one\n" +
+			"- two\n" +
+			"\n" +
+			"  second paragraph\n" +
+			"\n" +
+			"  1. inner\n" +
+			"  2. list\n" +
+			"\n" +
+			"---\n" +
+			"\n" +
+			"![alt](image.png)\n"
+
+		node := json.TreeNodeFromMarkdown(md)
+		assert.NoError(t, json.DefaultTreeSchema.Validate(node))
+
+		result, err := json.TreeNodeToMarkdown(node)
+		assert.NoError(t, err)
+		assert.Equal(t, md, result)
+	})
+
+	t.Run("markdown to tree test", func(t *testing.T) {
+		node := json.TreeNodeFromMarkdown("## A *b*\n\n3. x\n4. y\n")
+		assert.Equal(t,
+			`{"type":"doc","children":[`+
+				`{"type":"heading","attrs":{"level":"2"},"children":[`+
+				`{"type":"text","value":"A "},{"type":"em","children":[{"type":"text","value":"b"}]}]},`+
+				`{"type":"ordered_list","attrs":{"order":"3"},"children":[`+
+				`{"type":"list_item","children":[{"type":"paragraph","children":[{"type":"text","value":"x"}]}]},`+
+				`{"type":"list_item","children":[{"type":"paragraph","children":[{"type":"text","value":"y"}]}]}]}]}`,
+			node.Marshal(),
+		)
+
+		node = json.TreeNodeFromMarkdown("# 1. not a list\n\n1. a\n\n\\# not a heading\n")
+		result, err := json.TreeNodeToMarkdown(node)
+		assert.NoError(t, err)
+		assert.Equal(t, "# 1. not a list\n\n1. a\n\n\\# not a heading\n", result)
+	})
+
+	t.Run("seed and render document test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", json.TreeNodeFromMarkdown("# Title\n\nHello **world**\n"))
+			return nil
+		}))
+
+		tree := doc.Root().GetTree("t")
+		assert.Equal(t,
+			"<doc><heading level=\"1\">Title</heading><paragraph>Hello <strong>world</strong></paragraph></doc>",
+			tree.ToXML(),
+		)
+
+		result, err := json.TreeNodeToMarkdown(yson.FromTreeNode(tree.Root()))
+		assert.NoError(t, err)
+		assert.Equal(t, "# Title\n\nHello **world**\n", result)
+	})
+}
@@ -0,0 +1,724 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/index"
+)
+
+var (
+	headingPattern       = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	fencePattern         = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})[ \t]*([^`]*?)[ \t]*$")
+	thematicBreakPattern = regexp.MustCompile(`^ {0,3}((\*[ \t]*){3,}|(-[ \t]*){3,}|(_[ \t]*){3,})$`)
+	blockquotePattern    = regexp.MustCompile(`^ {0,3}> ?(.*)$`)
+	bulletItemPattern    = regexp.MustCompile(`^( {0,3})([-+*])(?: +|$)`)
+	orderedItemPattern   = regexp.MustCompile(`^( {0,3})(\d{1,9})([.)])(?: +|$)`)
+)
+
+// TreeNodeFromMarkdown converts the given Markdown to a tree node of
+// DefaultTreeSchema. It supports the subset of CommonMark that the schema can
+// represent: ATX headings, paragraphs, blockquotes, fenced code blocks,
+// thematic breaks, lists, emphasis, strong emphasis, code spans, links,
+// images and hard line breaks. Other constructs are kept as plain text.
+func TreeNodeFromMarkdown(markdown string) TreeNode {
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+	return TreeNode{Type: "doc", Children: parseMarkdownBlocks(lines)}
+}
+
+// parseMarkdownBlocks parses the given lines to block nodes.
+func parseMarkdownBlocks(lines []string) []TreeNode {
+	var blocks []TreeNode
+	var paragraph []string
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, TreeNode{
+				Type:     "paragraph",
+				Children: parseMarkdownInline(strings.Join(paragraph, "\n")),
+			})
+			paragraph = nil
+		}
+	}
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if strings.TrimSpace(line) == "" {
+			flush()
+			i++
+			continue
+		}
+
+		if m := headingPattern.FindStringSubmatch(line); m != nil {
+			flush()
+			blocks = append(blocks, TreeNode{
+				Type:       "heading",
+				Attributes: map[string]string{"level": strconv.Itoa(len(m[1]))},
+				Children:   parseMarkdownInline(m[2]),
+			})
+			i++
+		} else if m := fencePattern.FindStringSubmatch(line); m != nil {
+			flush()
+			var code []string
+			for i++; i < len(lines); i++ {
+				closing := strings.TrimSpace(lines[i])
+				if strings.HasPrefix(closing, m[1]) && strings.Trim(closing, m[1][:1]) == "" {
+					i++
+					break
+				}
+				code = append(code, lines[i])
+			}
+			block := TreeNode{Type: "code_block"}
+			if m[2] != "" {
+				block.Attributes = map[string]string{"params": m[2]}
+			}
+			if text := strings.Join(code, "\n"); text != "" {
+				block.Children = []TreeNode{{Type: index.TextNodeType, Value: text}}
+			}
+			blocks = append(blocks, block)
+		} else if thematicBreakPattern.MatchString(line) {
+			flush()
+			blocks = append(blocks, TreeNode{Type: "horizontal_rule"})
+			i++
+		} else if blockquotePattern.MatchString(line) {
+			flush()
+			var quoted []string
+			for ; i < len(lines); i++ {
+				m := blockquotePattern.FindStringSubmatch(lines[i])
+				if m == nil {
+					break
+				}
+				quoted = append(quoted, m[1])
+			}
+			blocks = append(blocks, TreeNode{Type: "blockquote", Children: parseMarkdownBlocks(quoted)})
+		} else if item := matchMarkdownListItem(line); item != nil {
+			flush()
+			list, consumed := parseMarkdownList(lines[i:], item)
+			blocks = append(blocks, list)
+			i += consumed
+		} else {
+			paragraph = append(paragraph, strings.TrimLeft(line, " \t"))
+			i++
+		}
+	}
+	flush()
+
+	return blocks
+}
+
+// markdownListItem is the marker of a list item.
+type markdownListItem struct {
+	ordered   bool
+	delimiter string
+	start     int
+	content   int
+}
+
+// matchMarkdownListItem returns the marker of the list item that starts at
+// the given line, or nil if the line does not start a list item.
+func matchMarkdownListItem(line string) *markdownListItem {
+	if m := bulletItemPattern.FindStringSubmatch(line); m != nil {
+		return &markdownListItem{
+			delimiter: m[2],
+			content:   max(len(m[0]), len(m[1])+len(m[2])+1),
+		}
+	}
+	if m := orderedItemPattern.FindStringSubmatch(line); m != nil {
+		start, _ := strconv.Atoi(m[2])
+		return &markdownListItem{
+			ordered:   true,
+			delimiter: m[3],
+			start:     start,
+			content:   max(len(m[0]), len(m[1])+len(m[2])+len(m[3])+1),
+		}
+	}
+	return nil
+}
+
+// parseMarkdownList parses the list that starts with the given item and
+// returns the list node and the number of consumed lines.
+func parseMarkdownList(lines []string, first *markdownListItem) (TreeNode, int) {
+	list := TreeNode{Type: "bullet_list"}
+	if first.ordered {
+		list.Type = "ordered_list"
+		list.Attributes = map[string]string{"order": strconv.Itoa(first.start)}
+	}
+
+	i := 0
+	for i < len(lines) {
+		item := matchMarkdownListItem(lines[i])
+		if item == nil || item.ordered != first.ordered || item.delimiter != first.delimiter {
+			break
+		}
+
+		itemLines := []string{lines[i][min(item.content, len(lines[i])):]}
+		for i++; i < len(lines); i++ {
+			line := lines[i]
+			if strings.TrimSpace(line) == "" {
+				// NOTE: Blank lines belong to the item only if the item
+				// continues after them.
+				next := i
+				for next < len(lines) && strings.TrimSpace(lines[next]) == "" {
+					next++
+				}
+				if next == len(lines) || indentOf(lines[next]) < item.content {
+					break
+				}
+				for ; i < next; i++ {
+					itemLines = append(itemLines, "")
+				}
+				line = lines[i]
+			}
+
+			if indentOf(line) >= item.content {
+				itemLines = append(itemLines, line[item.content:])
+			} else if itemLines[len(itemLines)-1] != "" && !isMarkdownBlockStart(line) {
+				// NOTE: A lazy continuation line of the paragraph.
+				itemLines = append(itemLines, line)
+			} else {
+				break
+			}
+		}
+		list.Children = append(list.Children, TreeNode{
+			Type:     "list_item",
+			Children: parseMarkdownBlocks(itemLines),
+		})
+
+		next := i
+		for next < len(lines) && strings.TrimSpace(lines[next]) == "" {
+			next++
+		}
+		if next < len(lines) && matchMarkdownListItem(lines[next]) != nil {
+			i = next
+		}
+	}
+
+	return list, i
+}
+
+// indentOf returns the number of leading spaces of the given line.
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// isMarkdownBlockStart returns whether the given line starts a block other
+// than a paragraph.
+func isMarkdownBlockStart(line string) bool {
+	return headingPattern.MatchString(line) ||
+		fencePattern.MatchString(line) ||
+		thematicBreakPattern.MatchString(line) ||
+		blockquotePattern.MatchString(line) ||
+		matchMarkdownListItem(line) != nil
+}
+
+// parseMarkdownInline parses the given text of a block to inline nodes.
+func parseMarkdownInline(text string) []TreeNode {
+	return wrapMarks(parseMarkdownSpans(text, nil), 0)
+}
+
+// parseMarkdownSpans parses the given text to spans that are wrapped by the
+// given marks.
+func parseMarkdownSpans(text string, marks []TreeNode) []inlineSpan {
+	var spans []inlineSpan
+	var buf strings.Builder
+	flush := func() {
+		if buf.Len() > 0 {
+			spans = append(spans, inlineSpan{
+				node:  TreeNode{Type: index.TextNodeType, Value: buf.String()},
+				marks: marks,
+			})
+			buf.Reset()
+		}
+	}
+	withMark := func(mark TreeNode) []TreeNode {
+		return append(slices.Clone(marks), mark)
+	}
+
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\\' && i+1 < len(text) && text[i+1] == '\n':
+			flush()
+			spans = append(spans, inlineSpan{node: TreeNode{Type: "hard_break"}, marks: marks})
+			i += 2
+		case c == '\\' && i+1 < len(text) && isASCIIPunct(text[i+1]):
+			buf.WriteByte(text[i+1])
+			i += 2
+		case c == '\n':
+			line := buf.String()
+			trimmed := strings.TrimRight(line, " ")
+			buf.Reset()
+			buf.WriteString(trimmed)
+			if len(line)-len(trimmed) >= 2 {
+				flush()
+				spans = append(spans, inlineSpan{node: TreeNode{Type: "hard_break"}, marks: marks})
+			} else {
+				buf.WriteByte('\n')
+			}
+			i++
+		case c == '`':
+			n := runLength(text, i)
+			end := findCodeSpanEnd(text, i+n, n)
+			if end < 0 {
+				buf.WriteString(text[i : i+n])
+				i += n
+				continue
+			}
+			flush()
+			if code := codeSpanContentOf(text[i+n : end]); code != "" {
+				spans = append(spans, inlineSpan{
+					node:  TreeNode{Type: index.TextNodeType, Value: code},
+					marks: withMark(TreeNode{Type: "code"}),
+				})
+			}
+			i = end + n
+		case c == '!' && i+1 < len(text) && text[i+1] == '[':
+			label, href, title, end, ok := parseMarkdownLink(text, i+1)
+			if !ok {
+				buf.WriteByte(c)
+				i++
+				continue
+			}
+			flush()
+			attrs := markdownLinkAttrs("src", href, title)
+			attrs["alt"] = plainTextOf(parseMarkdownSpans(label, nil))
+			spans = append(spans, inlineSpan{node: TreeNode{Type: "image", Attributes: attrs}, marks: marks})
+			i = end
+		case c == '[':
+			label, href, title, end, ok := parseMarkdownLink(text, i)
+			if !ok {
+				buf.WriteByte(c)
+				i++
+				continue
+			}
+			flush()
+			link := TreeNode{Type: "link", Attributes: markdownLinkAttrs("href", href, title)}
+			spans = append(spans, parseMarkdownSpans(label, withMark(link))...)
+			i = end
+		case c == '*' || c == '_' && (i == 0 || !isAlphanumeric(text[i-1])):
+			markType, start, end := parseMarkdownEmphasis(text, i)
+			if markType == "" {
+				buf.WriteString(text[i:end])
+				i = end
+				continue
+			}
+			flush()
+			spans = append(spans, parseMarkdownSpans(text[start:end], withMark(TreeNode{Type: markType}))...)
+			i = end + (start - i)
+		default:
+			buf.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+
+	return spans
+}
+
+// codeSpanContentOf returns the content of the code span with the given raw
+// content.
+func codeSpanContentOf(raw string) string {
+	code := strings.ReplaceAll(raw, "\n", " ")
+	if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+		return code[1 : len(code)-1]
+	}
+	return code
+}
+
+// markdownLinkAttrs returns the attributes of links and images.
+func markdownLinkAttrs(key, href, title string) map[string]string {
+	attrs := map[string]string{key: href}
+	if title != "" {
+		attrs["title"] = title
+	}
+	return attrs
+}
+
+// parseMarkdownEmphasis parses the emphasis that starts with the delimiter run
+// at the given position. It returns the type of the mark and the range of its
+// content. If there is no emphasis, the mark type is empty and the end is the
+// end of the delimiter run.
+func parseMarkdownEmphasis(text string, pos int) (string, int, int) {
+	c, n := text[pos], runLength(text, pos)
+	if n >= 2 {
+		if end := findEmphasisEnd(text, pos+2, c, 2); end > pos+2 {
+			return "strong", pos + 2, end
+		}
+	}
+	if end := findEmphasisEnd(text, pos+1, c, 1); end > pos+1 {
+		return "em", pos + 1, end
+	}
+	return "", pos, pos + n
+}
+
+// runLength returns the length of the run of the same character that starts
+// at the given position.
+func runLength(text string, pos int) int {
+	n := 1
+	for pos+n < len(text) && text[pos+n] == text[pos] {
+		n++
+	}
+	return n
+}
+
+// findCodeSpanEnd returns the position of the backtick run of the given length
+// that closes the code span, or -1 if there is no such run.
+func findCodeSpanEnd(text string, from, n int) int {
+	for i := from; i < len(text); {
+		if text[i] != '`' {
+			i++
+			continue
+		}
+		run := runLength(text, i)
+		if run == n {
+			return i
+		}
+		i += run
+	}
+	return -1
+}
+
+// findEmphasisEnd returns the position of the delimiter run of the given
+// length that closes the emphasis, or -1 if there is no such run. Code spans
+// and escaped characters are skipped.
+func findEmphasisEnd(text string, from int, delimiter byte, n int) int {
+	if from >= len(text) || text[from] == ' ' || text[from] == '\n' {
+		return -1
+	}
+
+	for i := from; i < len(text); {
+		switch text[i] {
+		case '\\':
+			i += 2
+		case '`':
+			run := runLength(text, i)
+			if end := findCodeSpanEnd(text, i+run, run); end >= 0 {
+				i = end + run
+			} else {
+				i += run
+			}
+		case delimiter:
+			run := runLength(text, i)
+			intraword := delimiter == '_' && i+run < len(text) && isAlphanumeric(text[i+run])
+			if text[i-1] != ' ' && text[i-1] != '\n' && !intraword && (run == n || run > 2) {
+				// NOTE: The closing delimiter of a run longer than both
+				// strong and emphasis is at the end of the run, so that the
+				// inner emphasis is closed first.
+				return i + run - n
+			}
+			i += run
+		default:
+			i++
+		}
+	}
+	return -1
+}
+
+// parseMarkdownLink parses the link that starts with the bracket at the given
+// position, such as [label](href "title").
+func parseMarkdownLink(text string, pos int) (string, string, string, int, bool) {
+	depth, labelEnd := 0, -1
+	for i := pos; i < len(text) && labelEnd < 0; i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				labelEnd = i
+			}
+		}
+	}
+	if labelEnd < 0 || labelEnd+1 >= len(text) || text[labelEnd+1] != '(' {
+		return "", "", "", 0, false
+	}
+
+	i := labelEnd + 2
+	skipSpaces := func() {
+		for i < len(text) && (text[i] == ' ' || text[i] == '\n') {
+			i++
+		}
+	}
+	skipSpaces()
+
+	var href string
+	if i < len(text) && text[i] == '<' {
+		end := strings.IndexByte(text[i:], '>')
+		if end < 0 {
+			return "", "", "", 0, false
+		}
+		href = text[i+1 : i+end]
+		i += end + 1
+	} else {
+		start, parens := i, 0
+		for ; i < len(text) && text[i] != ' ' && text[i] != '\n'; i++ {
+			if text[i] == '\\' {
+				i++
+			} else if text[i] == '(' {
+				parens++
+			} else if text[i] == ')' {
+				if parens == 0 {
+					break
+				}
+				parens--
+			}
+		}
+		href = text[start:min(i, len(text))]
+	}
+	skipSpaces()
+
+	var title string
+	if i < len(text) && (text[i] == '"' || text[i] == '\'') {
+		quote := text[i]
+		end := i + 1
+		for ; end < len(text) && text[end] != quote; end++ {
+			if text[end] == '\\' {
+				end++
+			}
+		}
+		if end >= len(text) {
+			return "", "", "", 0, false
+		}
+		title = text[i+1 : end]
+		i = end + 1
+		skipSpaces()
+	}
+	if i >= len(text) || text[i] != ')' {
+		return "", "", "", 0, false
+	}
+
+	return text[pos+1 : labelEnd], unescapeMarkdown(href), unescapeMarkdown(title), i + 1, true
+}
+
+// plainTextOf returns the concatenated text of the given spans.
+func plainTextOf(spans []inlineSpan) string {
+	var sb strings.Builder
+	for _, span := range spans {
+		sb.WriteString(span.node.Value)
+	}
+	return sb.String()
+}
+
+// unescapeMarkdown removes the backslashes that escape ASCII punctuations.
+func unescapeMarkdown(text string) string {
+	var sb strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\\' && i+1 < len(text) && isASCIIPunct(text[i+1]) {
+			i++
+		}
+		sb.WriteByte(text[i])
+	}
+	return sb.String()
+}
+
+func isAlphanumeric(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+func isASCIIPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+// TreeNodeToMarkdown converts the given tree node of DefaultTreeSchema to
+// Markdown.
+func TreeNodeToMarkdown(node TreeNode) (string, error) {
+	if err := DefaultTreeSchema.Validate(node); err != nil {
+		return "", err
+	}
+
+	markdown := renderMarkdownBlocks(node.Children)
+	if markdown == "" {
+		return "", nil
+	}
+	return markdown + "\n", nil
+}
+
+// renderMarkdownBlocks renders the given block nodes separated by blank
+// lines.
+func renderMarkdownBlocks(nodes []TreeNode) string {
+	var blocks []string
+	for _, node := range nodes {
+		blocks = append(blocks, renderMarkdownBlock(node))
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func renderMarkdownBlock(node TreeNode) string {
+	switch node.Type {
+	case "paragraph":
+		return escapeMarkdownLineStarts(renderMarkdownInline(node.Children))
+	case "heading":
+		level, err := strconv.Atoi(node.Attributes["level"])
+		if err != nil {
+			level = 1
+		}
+		level = min(max(level, 1), 6)
+		return strings.Repeat("#", level) + " " + renderMarkdownInline(node.Children)
+	case "blockquote":
+		return prefixLines(renderMarkdownBlocks(node.Children), "> ", "> ")
+	case "code_block":
+		code := textOf(node)
+		fence := strings.Repeat("`", max(3, longestRun(code, '`')+1))
+		return fence + node.Attributes["params"] + "\n" + code + "\n" + fence
+	case "horizontal_rule":
+		return "---"
+	case "bullet_list", "ordered_list":
+		order, err := strconv.Atoi(node.Attributes["order"])
+		if err != nil {
+			order = 1
+		}
+
+		var items []string
+		for i, item := range node.Children {
+			marker := "- "
+			if node.Type == "ordered_list" {
+				marker = strconv.Itoa(order+i) + ". "
+			}
+			content := renderMarkdownBlocks(item.Children)
+			items = append(items, strings.TrimRight(
+				prefixLines(content, marker, strings.Repeat(" ", len(marker))), " ",
+			))
+		}
+		return strings.Join(items, "\n")
+	}
+
+	return ""
+}
+
+// renderMarkdownInline renders the given inline nodes.
+func renderMarkdownInline(nodes []TreeNode) string {
+	var sb strings.Builder
+	for _, node := range nodes {
+		switch node.Type {
+		case index.TextNodeType:
+			sb.WriteString(escapeMarkdown(node.Value))
+		case "hard_break":
+			sb.WriteString("\\\n")
+		case "image":
+			sb.WriteString("![" + escapeMarkdown(node.Attributes["alt"]) + "]")
+			sb.WriteString(renderMarkdownDestination(node.Attributes["src"], node.Attributes["title"]))
+		case "strong":
+			sb.WriteString("**" + renderMarkdownInline(node.Children) + "**")
+		case "em":
+			sb.WriteString("*" + renderMarkdownInline(node.Children) + "*")
+		case "code":
+			code := textOf(node)
+			fence := strings.Repeat("`", longestRun(code, '`')+1)
+			if strings.HasPrefix(code, "`") || strings.HasSuffix(code, "`") {
+				code = " " + code + " "
+			}
+			sb.WriteString(fence + code + fence)
+		case "link":
+			sb.WriteString("[" + renderMarkdownInline(node.Children) + "]")
+			sb.WriteString(renderMarkdownDestination(node.Attributes["href"], node.Attributes["title"]))
+		}
+	}
+	return sb.String()
+}
+
+// renderMarkdownDestination renders the destination of links and images.
+func renderMarkdownDestination(href, title string) string {
+	if strings.ContainsAny(href, " ()<>") {
+		href = "<" + href + ">"
+	}
+	if title == "" {
+		return "(" + href + ")"
+	}
+	return "(" + href + ` "` + strings.ReplaceAll(title, `"`, `\"`) + `")`
+}
+
+// escapeMarkdown escapes the characters of the given text that are parsed as
+// inline syntax.
+func escapeMarkdown(text string) string {
+	var sb strings.Builder
+	for i := 0; i < len(text); i++ {
+		intraword := text[i] == '_' && i > 0 && i+1 < len(text) &&
+			isAlphanumeric(text[i-1]) && isAlphanumeric(text[i+1])
+		if strings.IndexByte("\\`*_[]", text[i]) >= 0 && !intraword {
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(text[i])
+	}
+	return sb.String()
+}
+
+// escapeMarkdownLineStarts escapes the lines of the given paragraph that would
+// be parsed as the start of other blocks.
+func escapeMarkdownLineStarts(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if !isMarkdownBlockStart(line) {
+			continue
+		}
+
+		trimmed := strings.TrimLeft(line, " ")
+		if m := orderedItemPattern.FindStringSubmatch(trimmed); m != nil {
+			lines[i] = m[2] + "\\" + trimmed[len(m[2]):]
+		} else {
+			lines[i] = "\\" + trimmed
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// prefixLines prefixes the first line of the given text with the given first
+// prefix and the other non-empty lines with the given rest prefix.
+func prefixLines(text, first, rest string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if i == 0 {
+			lines[i] = first + line
+		} else if line != "" {
+			lines[i] = rest + line
+		} else {
+			lines[i] = strings.TrimRight(rest, " ")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// textOf returns the concatenated text of the descendants of the given node.
+func textOf(node TreeNode) string {
+	if node.Type == index.TextNodeType {
+		return node.Value
+	}
+
+	var sb strings.Builder
+	for _, child := range node.Children {
+		sb.WriteString(textOf(child))
+	}
+	return sb.String()
+}
+
+// longestRun returns the length of the longest run of the given character.
+func longestRun(text string, c byte) int {
+	longest, run := 0, 0
+	for i := 0; i < len(text); i++ {
+		if text[i] == c {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	return longest
+}
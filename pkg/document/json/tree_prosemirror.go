@@ -0,0 +1,179 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/pkg/index"
+)
+
+// ErrInvalidProseMirror is returned when the given ProseMirror document JSON
+// is not valid.
+var ErrInvalidProseMirror = errors.New("invalid ProseMirror document")
+
+// proseMirrorNode is a node of ProseMirror document JSON.
+type proseMirrorNode struct {
+	Type    string                 `json:"type"`
+	Attrs   map[string]interface{} `json:"attrs,omitempty"`
+	Content []proseMirrorNode      `json:"content,omitempty"`
+	Marks   []proseMirrorMark      `json:"marks,omitempty"`
+	Text    string                 `json:"text,omitempty"`
+}
+
+// proseMirrorMark is a mark of ProseMirror document JSON.
+type proseMirrorMark struct {
+	Type  string                 `json:"type"`
+	Attrs map[string]interface{} `json:"attrs,omitempty"`
+}
+
+// TreeNodeFromProseMirror converts the given ProseMirror document JSON to a
+// tree node. Marks of inline nodes become element nodes that wrap them, and
+// attributes that are not strings are stored as their JSON encoding.
+func TreeNodeFromProseMirror(data []byte) (TreeNode, error) {
+	var doc proseMirrorNode
+	if err := gojson.Unmarshal(data, &doc); err != nil {
+		return TreeNode{}, fmt.Errorf("unmarshal ProseMirror: %w", ErrInvalidProseMirror)
+	}
+	if doc.Type == index.TextNodeType {
+		return TreeNode{}, fmt.Errorf("text root: %w", ErrInvalidProseMirror)
+	}
+
+	return fromProseMirrorNode(doc)
+}
+
+func fromProseMirrorNode(pmNode proseMirrorNode) (TreeNode, error) {
+	if pmNode.Type == "" {
+		return TreeNode{}, fmt.Errorf("node without type: %w", ErrInvalidProseMirror)
+	}
+
+	attrs, err := fromProseMirrorAttrs(pmNode.Attrs)
+	if err != nil {
+		return TreeNode{}, err
+	}
+	node := TreeNode{Type: pmNode.Type, Attributes: attrs}
+
+	var spans []inlineSpan
+	for _, pmChild := range pmNode.Content {
+		var child TreeNode
+		if pmChild.Type == index.TextNodeType {
+			if pmChild.Text == "" {
+				return TreeNode{}, fmt.Errorf("empty text node: %w", ErrInvalidProseMirror)
+			}
+			child = TreeNode{Type: index.TextNodeType, Value: pmChild.Text}
+		} else if child, err = fromProseMirrorNode(pmChild); err != nil {
+			return TreeNode{}, err
+		}
+
+		var marks []TreeNode
+		for _, pmMark := range pmChild.Marks {
+			attrs, err := fromProseMirrorAttrs(pmMark.Attrs)
+			if err != nil {
+				return TreeNode{}, err
+			}
+			marks = append(marks, TreeNode{Type: pmMark.Type, Attributes: attrs})
+		}
+		spans = append(spans, inlineSpan{node: child, marks: marks})
+	}
+	node.Children = wrapMarks(spans, 0)
+
+	return node, nil
+}
+
+// fromProseMirrorAttrs converts the given ProseMirror attributes to the
+// attributes of tree node. Null attributes are omitted.
+func fromProseMirrorAttrs(pmAttrs map[string]interface{}) (map[string]string, error) {
+	if len(pmAttrs) == 0 {
+		return nil, nil
+	}
+
+	attrs := make(map[string]string)
+	for key, value := range pmAttrs {
+		switch v := value.(type) {
+		case nil:
+			continue
+		case string:
+			attrs[key] = v
+		default:
+			encoded, err := gojson.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("marshal attribute %s: %w", key, err)
+			}
+			attrs[key] = string(encoded)
+		}
+	}
+	return attrs, nil
+}
+
+// TreeNodeToProseMirror converts the given tree node to ProseMirror document
+// JSON. The nodes that are marks in the given schema become marks of the
+// inline nodes they wrap.
+func TreeNodeToProseMirror(node TreeNode, schema *TreeSchema) ([]byte, error) {
+	if node.Type == index.TextNodeType || schema.IsMark(node.Type) {
+		return nil, fmt.Errorf("root type %q: %w", node.Type, ErrInvalidTreeNode)
+	}
+
+	data, err := gojson.Marshal(toProseMirrorNode(node, schema))
+	if err != nil {
+		return nil, fmt.Errorf("marshal ProseMirror: %w", err)
+	}
+	return data, nil
+}
+
+func toProseMirrorNode(node TreeNode, schema *TreeSchema) proseMirrorNode {
+	if node.Type == index.TextNodeType {
+		return proseMirrorNode{Type: index.TextNodeType, Text: node.Value}
+	}
+
+	pmNode := proseMirrorNode{Type: node.Type, Attrs: toProseMirrorAttrs(node.Attributes)}
+	for _, span := range schema.flattenMarks(node.Children, nil) {
+		pmChild := toProseMirrorNode(span.node, schema)
+		for _, mark := range span.marks {
+			pmChild.Marks = append(pmChild.Marks, proseMirrorMark{
+				Type:  mark.Type,
+				Attrs: toProseMirrorAttrs(mark.Attributes),
+			})
+		}
+		pmNode.Content = append(pmNode.Content, pmChild)
+	}
+
+	return pmNode
+}
+
+// toProseMirrorAttrs converts the given attributes of tree node to ProseMirror
+// attributes. Attributes that are JSON encoded numbers, booleans, arrays or
+// objects are decoded.
+func toProseMirrorAttrs(attrs map[string]string) map[string]interface{} {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	pmAttrs := make(map[string]interface{})
+	for key, value := range attrs {
+		var decoded interface{}
+		if err := gojson.Unmarshal([]byte(value), &decoded); err == nil {
+			if _, isString := decoded.(string); !isString && decoded != nil {
+				pmAttrs[key] = decoded
+				continue
+			}
+		}
+		pmAttrs[key] = value
+	}
+	return pmAttrs
+}
@@ -0,0 +1,201 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/index"
+)
+
+// ErrInvalidTreeNode is returned when the tree node does not conform to the
+// schema.
+var ErrInvalidTreeNode = errors.New("invalid tree node")
+
+// TreeNodeSpec describes a type of element node in the TreeSchema.
+type TreeNodeSpec = crdt.TreeNodeSpec
+
+// TreeSchema describes the node types of a Tree and how they can be nested.
+// It can be attached to a Tree by SetNewTreeWithSchema so that the edits of
+// the tree are validated.
+type TreeSchema crdt.TreeSchema
+
+// IsMark returns whether the given node type is a mark in this schema.
+func (s *TreeSchema) IsMark(nodeType string) bool {
+	spec, ok := s.Nodes[nodeType]
+	return ok && spec.Mark
+}
+
+// Validate checks whether the given node and its descendants conform to this
+// schema.
+func (s *TreeSchema) Validate(node TreeNode) error {
+	if node.Type != s.Root {
+		return fmt.Errorf("root type %q: %w", node.Type, ErrInvalidTreeNode)
+	}
+
+	return s.validate(node, "$")
+}
+
+func (s *TreeSchema) validate(node TreeNode, path string) error {
+	if node.Type == index.TextNodeType {
+		if len(node.Children) != 0 {
+			return fmt.Errorf("text node with children at %s: %w", path, ErrInvalidTreeNode)
+		}
+		if err := validateTextNode(node); err != nil {
+			return fmt.Errorf("empty text node at %s: %w", path, ErrInvalidTreeNode)
+		}
+		return nil
+	}
+
+	spec, ok := s.Nodes[node.Type]
+	if !ok {
+		return fmt.Errorf("unknown node type %q at %s: %w", node.Type, path, ErrInvalidTreeNode)
+	}
+
+	for _, attr := range spec.RequiredAttributes {
+		if _, ok := node.Attributes[attr]; !ok {
+			return fmt.Errorf("%q requires attribute %q at %s: %w", node.Type, attr, path, ErrInvalidTreeNode)
+		}
+	}
+	if spec.Attributes != nil {
+		for attr := range node.Attributes {
+			if !slices.Contains(spec.Attributes, attr) && !slices.Contains(spec.RequiredAttributes, attr) {
+				return fmt.Errorf("%q cannot have attribute %q at %s: %w", node.Type, attr, path, ErrInvalidTreeNode)
+			}
+		}
+	}
+
+	for i, child := range node.Children {
+		childPath := path + "[" + strconv.Itoa(i) + "]"
+		if spec.TextOnly && child.Type != index.TextNodeType {
+			return fmt.Errorf(
+				"%q can only contain text, not %q at %s: %w",
+				node.Type, child.Type, childPath, ErrInvalidTreeNode,
+			)
+		}
+		if !spec.TextOnly && !slices.Contains(spec.Content, child.Type) {
+			return fmt.Errorf(
+				"%q cannot contain %q at %s: %w",
+				node.Type, child.Type, childPath, ErrInvalidTreeNode,
+			)
+		}
+		if err := s.validate(child, childPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inlineContent is the content of textblocks in DefaultTreeSchema.
+var inlineContent = []string{
+	index.TextNodeType, "hard_break", "image", "strong", "em", "code", "link",
+}
+
+// blockContent is the content of block containers in DefaultTreeSchema.
+var blockContent = []string{
+	"paragraph", "heading", "blockquote", "code_block", "horizontal_rule",
+	"bullet_list", "ordered_list",
+}
+
+// DefaultTreeSchema is the schema of the basic ProseMirror schema with lists.
+// It is also the schema of the trees that are converted from Markdown.
+var DefaultTreeSchema = &TreeSchema{
+	Root: "doc",
+	Nodes: map[string]TreeNodeSpec{
+		"doc":             {Content: blockContent},
+		"paragraph":       {Content: inlineContent},
+		"heading":         {Content: inlineContent},
+		"blockquote":      {Content: blockContent},
+		"code_block":      {Content: []string{index.TextNodeType}},
+		"horizontal_rule": {},
+		"bullet_list":     {Content: []string{"list_item"}},
+		"ordered_list":    {Content: []string{"list_item"}},
+		"list_item":       {Content: blockContent},
+		"hard_break":      {},
+		"image":           {},
+		"strong":          {Content: inlineContent, Mark: true},
+		"em":              {Content: inlineContent, Mark: true},
+		"code":            {Content: []string{index.TextNodeType}, Mark: true},
+		"link":            {Content: inlineContent, Mark: true},
+	},
+}
+
+// inlineSpan is an inline leaf node with the marks that wrap it, outermost
+// first. Marks are stored as element nodes without children.
+type inlineSpan struct {
+	node  TreeNode
+	marks []TreeNode
+}
+
+// flattenMarks converts the given inline nodes that can be wrapped by mark
+// nodes to a list of spans.
+func (s *TreeSchema) flattenMarks(nodes []TreeNode, marks []TreeNode) []inlineSpan {
+	var spans []inlineSpan
+	for _, node := range nodes {
+		if !s.IsMark(node.Type) {
+			spans = append(spans, inlineSpan{node: node, marks: marks})
+			continue
+		}
+
+		mark := TreeNode{Type: node.Type, Attributes: node.Attributes}
+		spans = append(spans, s.flattenMarks(node.Children, append(slices.Clone(marks), mark))...)
+	}
+	return spans
+}
+
+// wrapMarks converts the given spans to inline nodes by wrapping them with
+// mark nodes. Adjacent spans with the same mark share a single mark node and
+// adjacent text nodes are merged.
+func wrapMarks(spans []inlineSpan, depth int) []TreeNode {
+	var nodes []TreeNode
+	for i := 0; i < len(spans); {
+		span := spans[i]
+		if len(span.marks) <= depth {
+			last := len(nodes) - 1
+			if span.node.Type == index.TextNodeType && last >= 0 && nodes[last].Type == index.TextNodeType {
+				nodes[last].Value += span.node.Value
+			} else {
+				nodes = append(nodes, span.node)
+			}
+			i++
+			continue
+		}
+
+		mark := span.marks[depth]
+		j := i + 1
+		for j < len(spans) && len(spans[j].marks) > depth && isSameMark(spans[j].marks[depth], mark) {
+			j++
+		}
+		nodes = append(nodes, TreeNode{
+			Type:       mark.Type,
+			Attributes: mark.Attributes,
+			Children:   wrapMarks(spans[i:j], depth+1),
+		})
+		i = j
+	}
+	return nodes
+}
+
+func isSameMark(a, b TreeNode) bool {
+	return a.Type == b.Type && maps.Equal(a.Attributes, b.Attributes)
+}
@@ -0,0 +1,311 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+)
+
+// OpType represents the type of the operation applied to the document.
+type OpType string
+
+const (
+	// OpSet means that a value has been set to a key of an object.
+	OpSet OpType = "set"
+
+	// OpAdd means that a value has been added to an array.
+	OpAdd OpType = "add"
+
+	// OpRemove means that a value has been removed from an object or an array.
+	OpRemove OpType = "remove"
+
+	// OpMove means that an element of an array has been moved.
+	OpMove OpType = "move"
+
+	// OpArraySet means that an element of an array has been replaced.
+	OpArraySet OpType = "array-set"
+
+	// OpIncrease means that a counter has been increased.
+	OpIncrease OpType = "increase"
+
+	// OpEdit means that a range of a text has been edited.
+	OpEdit OpType = "edit"
+
+	// OpStyle means that a range of a text has been styled.
+	OpStyle OpType = "style"
+
+	// OpTreeEdit means that a range of a tree has been edited.
+	OpTreeEdit OpType = "tree-edit"
+
+	// OpTreeStyle means that a range of a tree has been styled.
+	OpTreeStyle OpType = "tree-style"
+
+	// OpTreeMove means that a node of a tree has been moved.
+	OpTreeMove OpType = "tree-move"
+
+	// OpSetAdd means that a value has been added to a set.
+	OpSetAdd OpType = "set-add"
+
+	// OpSetRemove means that a value has been removed from a set.
+	OpSetRemove OpType = "set-remove"
+
+	// OpRegisterSet means that a value has been assigned to a register.
+	OpRegisterSet OpType = "register-set"
+
+	// OpCounterReset means that a counter has been reset.
+	OpCounterReset OpType = "counter-reset"
+
+	// OpCounterTransfer means that the rights of a bounded counter have been
+	// transferred.
+	OpCounterTransfer OpType = "counter-transfer"
+)
+
+// OpInfo represents the information of an operation applied to the document.
+type OpInfo struct {
+	// Type is the type of the operation.
+	Type OpType
+
+	// Path is the path of the element that the operation is applied to,
+	// e.g. `$.todos[0]`.
+	Path string
+
+	// Key is the key of the member of the object for `set` and `remove`.
+	Key string
+
+	// From and To are the affected range of the element. For arrays, they are
+	// the indexes of the element before and after the operation. For text and
+	// tree, they are the range replaced or styled by the operation. For tree
+	// moves, they are the indexes of the moved node before and after the move.
+	From int
+	To   int
+
+	// Violation is the violation of the schema of the tree found after the
+	// tree operation is applied. The remote operations are applied even if
+	// they violate the schema to keep the replicas convergent, so that they
+	// are flagged by this instead.
+	Violation error
+}
+
+// TargetPath returns the path of the element affected by the operation.
+func (i OpInfo) TargetPath() string {
+	if i.Key == "" {
+		return i.Path
+	}
+	return i.Path + "." + i.Key
+}
+
+// isSameOrChildOf returns whether the given path is the same as or a child of
+// the given target path.
+func isSameOrChildOf(path, target string) bool {
+	if path == target {
+		return true
+	}
+	return strings.HasPrefix(path, target+".") || strings.HasPrefix(path, target+"[")
+}
+
+// opInfoHook returns a hook for change.ExecuteWithHook that appends the
+// information of the executed operations to the given infos. The operations
+// applied to removed elements are skipped.
+func opInfoHook(
+	root *crdt.Root,
+	infos *[]OpInfo,
+) func(op operations.Operation) (func() error, error) {
+	return func(op operations.Operation) (func() error, error) {
+		path, err := root.PathOf(op.ParentCreatedAt())
+		if errors.Is(err, crdt.ErrElementNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		info, after, err := opInfoOf(root, op)
+		if err != nil || info == nil {
+			return nil, err
+		}
+		info.Path = path
+
+		return func() error {
+			if after != nil {
+				if err := after(info); err != nil {
+					return err
+				}
+			}
+			*infos = append(*infos, *info)
+			return nil
+		}, nil
+	}
+}
+
+// opInfoOf returns the information of the given operation captured before it
+// is executed, and a function to complete the information after it is
+// executed. It returns nil if the operation does not change the document.
+func opInfoOf(root *crdt.Root, op operations.Operation) (*OpInfo, func(*OpInfo) error, error) {
+	parent := root.FindByCreatedAt(op.ParentCreatedAt())
+
+	switch op := op.(type) {
+	case *operations.Set:
+		return &OpInfo{Type: OpSet, Key: op.Key()}, nil, nil
+	case *operations.Add:
+		arr, ok := parent.(*crdt.Array)
+		if !ok {
+			return nil, nil, nil
+		}
+		return &OpInfo{Type: OpAdd}, func(info *OpInfo) error {
+			info.From = arr.IndexOf(op.Value().CreatedAt())
+			info.To = info.From
+			return nil
+		}, nil
+	case *operations.Remove:
+		return opInfoOfRemove(root, parent, op), nil, nil
+	case *operations.Move:
+		arr, ok := parent.(*crdt.Array)
+		if !ok {
+			return nil, nil, nil
+		}
+		return &OpInfo{Type: OpMove, From: arr.IndexOf(op.CreatedAt())}, func(info *OpInfo) error {
+			info.To = arr.IndexOf(op.CreatedAt())
+			return nil
+		}, nil
+	case *operations.ArraySet:
+		arr, ok := parent.(*crdt.Array)
+		if !ok {
+			return nil, nil, nil
+		}
+		idx := arr.IndexOf(op.CreatedAt())
+		return &OpInfo{Type: OpArraySet, From: idx, To: idx}, nil, nil
+	case *operations.Edit:
+		text, ok := parent.(*crdt.Text)
+		if !ok {
+			return nil, nil, nil
+		}
+		from, to, err := text.FindIndexesFromRange(op.From(), op.To())
+		if err != nil {
+			return nil, nil, err
+		}
+		return &OpInfo{Type: OpEdit, From: from, To: to}, nil, nil
+	case *operations.Style:
+		text, ok := parent.(*crdt.Text)
+		if !ok {
+			return nil, nil, nil
+		}
+		from, to, err := text.FindIndexesFromRange(op.From(), op.To())
+		if err != nil {
+			return nil, nil, err
+		}
+		return &OpInfo{Type: OpStyle, From: from, To: to}, nil, nil
+	case *operations.TreeEdit:
+		tree, ok := parent.(*crdt.Tree)
+		if !ok {
+			return nil, nil, nil
+		}
+		from, to, err := tree.FindIndexesFromRange(op.FromPos(), op.ToPos())
+		if err != nil {
+			return nil, nil, err
+		}
+		return &OpInfo{Type: OpTreeEdit, From: from, To: to}, flagViolation(tree), nil
+	case *operations.TreeStyle:
+		tree, ok := parent.(*crdt.Tree)
+		if !ok {
+			return nil, nil, nil
+		}
+		from, to, err := tree.FindIndexesFromRange(op.FromPos(), op.ToPos())
+		if err != nil {
+			return nil, nil, err
+		}
+		return &OpInfo{Type: OpTreeStyle, From: from, To: to}, flagViolation(tree), nil
+	case *operations.TreeMove:
+		tree, ok := parent.(*crdt.Tree)
+		if !ok {
+			return nil, nil, nil
+		}
+		from, err := tree.IndexOfNode(op.Target())
+		if err != nil {
+			return nil, nil, err
+		}
+		return &OpInfo{Type: OpTreeMove, From: from}, func(info *OpInfo) error {
+			to, err := tree.IndexOfNode(op.Target())
+			if err != nil {
+				return err
+			}
+			info.To = to
+			info.Violation = tree.Validate()
+			return nil
+		}, nil
+	default:
+		if opType, ok := valueOpTypeOf(op); ok {
+			return &OpInfo{Type: opType}, nil, nil
+		}
+		return nil, nil, nil
+	}
+}
+
+// flagViolation returns a function that flags the violation of the schema of
+// the given tree to the information of the operation.
+func flagViolation(tree *crdt.Tree) func(*OpInfo) error {
+	return func(info *OpInfo) error {
+		info.Violation = tree.Validate()
+		return nil
+	}
+}
+
+// opInfoOfRemove returns the information of the given remove operation. It
+// returns nil if the target has already been removed.
+func opInfoOfRemove(root *crdt.Root, parent crdt.Element, op *operations.Remove) *OpInfo {
+	elem := root.FindByCreatedAt(op.CreatedAt())
+	if elem == nil || elem.RemovedAt() != nil {
+		return nil
+	}
+
+	switch parent := parent.(type) {
+	case *crdt.Object:
+		for _, node := range parent.RHTNodes() {
+			if node.Element() == elem {
+				return &OpInfo{Type: OpRemove, Key: node.Key()}
+			}
+		}
+	case *crdt.Array:
+		idx := parent.IndexOf(op.CreatedAt())
+		return &OpInfo{Type: OpRemove, From: idx, To: idx}
+	}
+	return nil
+}
+
+// valueOpTypeOf returns the type of the given operation that changes the
+// value of an element without the position.
+func valueOpTypeOf(op operations.Operation) (OpType, bool) {
+	switch op.(type) {
+	case *operations.Increase:
+		return OpIncrease, true
+	case *operations.SetAdd:
+		return OpSetAdd, true
+	case *operations.SetRemove:
+		return OpSetRemove, true
+	case *operations.RegisterSet:
+		return OpRegisterSet, true
+	case *operations.CounterReset:
+		return OpCounterReset, true
+	case *operations.CounterTransfer:
+		return OpCounterTransfer, true
+	default:
+		return "", false
+	}
+}
@@ -69,7 +69,7 @@ func (o *Add) Execute(root *crdt.Root, _ time.VersionVector) error {
 		return err
 	}
 
-	root.RegisterElement(value)
+	root.RegisterElement(obj, value)
 	return nil
 }
 
@@ -71,7 +71,7 @@ func (o *ArraySet) Execute(root *crdt.Root, _ time.VersionVector) error {
 
 	// TODO(junseo): GC logic is not implemented here
 	// because there is no way to distinguish between old and new element with same `createdAt`.
-	root.RegisterElement(value)
+	root.RegisterElement(obj, value)
 	return nil
 }
 
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// CounterReset is an operation representing resetting a Counter. It resets
+// only the increases that were observed when the counter was reset.
+type CounterReset struct {
+	// parentCreatedAt is the creation time of the Counter that executes
+	// CounterReset.
+	parentCreatedAt *time.Ticket
+
+	// observed is the increases of each actor that were observed.
+	observed []*crdt.CounterEntry
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewCounterReset creates a new instance of CounterReset.
+func NewCounterReset(
+	parentCreatedAt *time.Ticket,
+	observed []*crdt.CounterEntry,
+	executedAt *time.Ticket,
+) *CounterReset {
+	return &CounterReset{
+		parentCreatedAt: parentCreatedAt,
+		observed:        observed,
+		executedAt:      executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (o *CounterReset) Execute(root *crdt.Root, _ time.VersionVector) error {
+	parent := root.FindByCreatedAt(o.parentCreatedAt)
+	cnt, ok := parent.(*crdt.Counter)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	return cnt.Reset(o.observed)
+}
+
+// Observed returns the observed increases of this operation.
+func (o *CounterReset) Observed() []*crdt.CounterEntry {
+	return o.observed
+}
+
+// ParentCreatedAt returns the creation time of the Counter.
+func (o *CounterReset) ParentCreatedAt() *time.Ticket {
+	return o.parentCreatedAt
+}
+
+// ExecutedAt returns execution time of this operation.
+func (o *CounterReset) ExecutedAt() *time.Ticket {
+	return o.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (o *CounterReset) SetActor(actorID time.ActorID) {
+	o.executedAt = o.executedAt.SetActorID(actorID)
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// CounterTransfer is an operation representing transferring the rights of a
+// bounded Counter from an actor to another.
+type CounterTransfer struct {
+	// parentCreatedAt is the creation time of the Counter that executes
+	// CounterTransfer.
+	parentCreatedAt *time.Ticket
+
+	// from is the actor that transfers the rights.
+	from time.ActorID
+
+	// to is the actor that receives the rights.
+	to time.ActorID
+
+	// amount is the amount of the rights to transfer.
+	amount int64
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewCounterTransfer creates a new instance of CounterTransfer.
+func NewCounterTransfer(
+	parentCreatedAt *time.Ticket,
+	from time.ActorID,
+	to time.ActorID,
+	amount int64,
+	executedAt *time.Ticket,
+) *CounterTransfer {
+	return &CounterTransfer{
+		parentCreatedAt: parentCreatedAt,
+		from:            from,
+		to:              to,
+		amount:          amount,
+		executedAt:      executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (o *CounterTransfer) Execute(root *crdt.Root, _ time.VersionVector) error {
+	parent := root.FindByCreatedAt(o.parentCreatedAt)
+	cnt, ok := parent.(*crdt.Counter)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	return cnt.Transfer(o.from, o.to, o.amount)
+}
+
+// From returns the actor that transfers the rights.
+func (o *CounterTransfer) From() time.ActorID {
+	return o.from
+}
+
+// To returns the actor that receives the rights.
+func (o *CounterTransfer) To() time.ActorID {
+	return o.to
+}
+
+// Amount returns the amount of the rights to transfer.
+func (o *CounterTransfer) Amount() int64 {
+	return o.amount
+}
+
+// ParentCreatedAt returns the creation time of the Counter.
+func (o *CounterTransfer) ParentCreatedAt() *time.Ticket {
+	return o.parentCreatedAt
+}
+
+// ExecutedAt returns execution time of this operation.
+func (o *CounterTransfer) ExecutedAt() *time.Ticket {
+	return o.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (o *CounterTransfer) SetActor(actorID time.ActorID) {
+	o.executedAt = o.executedAt.SetActorID(actorID)
+}
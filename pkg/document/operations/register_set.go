@@ -0,0 +1,104 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// RegisterSet is an operation representing assigning a value to a
+// MVRegister.
+type RegisterSet struct {
+	// parentCreatedAt is the creation time of the MVRegister that executes
+	// RegisterSet.
+	parentCreatedAt *time.Ticket
+
+	// value is the value to assign. Its creation time identifies the
+	// assignment.
+	value crdt.Element
+
+	// overwrites is the creation times of the values that were observed
+	// when the value was assigned.
+	overwrites []*time.Ticket
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewRegisterSet creates a new instance of RegisterSet.
+func NewRegisterSet(
+	parentCreatedAt *time.Ticket,
+	value crdt.Element,
+	overwrites []*time.Ticket,
+	executedAt *time.Ticket,
+) *RegisterSet {
+	return &RegisterSet{
+		parentCreatedAt: parentCreatedAt,
+		value:           value,
+		overwrites:      overwrites,
+		executedAt:      executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (o *RegisterSet) Execute(root *crdt.Root, _ time.VersionVector) error {
+	parent := root.FindByCreatedAt(o.parentCreatedAt)
+	register, ok := parent.(*crdt.MVRegister)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	value, err := o.value.DeepCopy()
+	if err != nil {
+		return err
+	}
+	primitive, ok := value.(*crdt.Primitive)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	for _, pair := range register.Assign(primitive, o.overwrites, o.executedAt) {
+		root.RegisterGCPair(pair)
+	}
+	return nil
+}
+
+// Value returns the value of this operation.
+func (o *RegisterSet) Value() crdt.Element {
+	return o.value
+}
+
+// Overwrites returns the creation times of the overwritten values.
+func (o *RegisterSet) Overwrites() []*time.Ticket {
+	return o.overwrites
+}
+
+// ParentCreatedAt returns the creation time of the MVRegister.
+func (o *RegisterSet) ParentCreatedAt() *time.Ticket {
+	return o.parentCreatedAt
+}
+
+// ExecutedAt returns execution time of this operation.
+func (o *RegisterSet) ExecutedAt() *time.Ticket {
+	return o.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (o *RegisterSet) SetActor(actorID time.ActorID) {
+	o.executedAt = o.executedAt.SetActorID(actorID)
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// SetAdd is an operation representing adding a value to a Set.
+type SetAdd struct {
+	// parentCreatedAt is the creation time of the Set that executes SetAdd.
+	parentCreatedAt *time.Ticket
+
+	// value is the value to add. Its creation time identifies the addition.
+	value crdt.Element
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewSetAdd creates a new instance of SetAdd.
+func NewSetAdd(
+	parentCreatedAt *time.Ticket,
+	value crdt.Element,
+	executedAt *time.Ticket,
+) *SetAdd {
+	return &SetAdd{
+		parentCreatedAt: parentCreatedAt,
+		value:           value,
+		executedAt:      executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (o *SetAdd) Execute(root *crdt.Root, _ time.VersionVector) error {
+	parent := root.FindByCreatedAt(o.parentCreatedAt)
+	set, ok := parent.(*crdt.Set)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	value, err := o.value.DeepCopy()
+	if err != nil {
+		return err
+	}
+	primitive, ok := value.(*crdt.Primitive)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	set.Add(primitive)
+	return nil
+}
+
+// Value returns the value of this operation.
+func (o *SetAdd) Value() crdt.Element {
+	return o.value
+}
+
+// ParentCreatedAt returns the creation time of the Set.
+func (o *SetAdd) ParentCreatedAt() *time.Ticket {
+	return o.parentCreatedAt
+}
+
+// ExecutedAt returns execution time of this operation.
+func (o *SetAdd) ExecutedAt() *time.Ticket {
+	return o.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (o *SetAdd) SetActor(actorID time.ActorID) {
+	o.executedAt = o.executedAt.SetActorID(actorID)
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// SetRemove is an operation representing removing a value from a Set.
+type SetRemove struct {
+	// parentCreatedAt is the creation time of the Set that executes
+	// SetRemove.
+	parentCreatedAt *time.Ticket
+
+	// createdAts is the creation times of the additions of the value that
+	// were observed when the value was removed.
+	createdAts []*time.Ticket
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewSetRemove creates a new instance of SetRemove.
+func NewSetRemove(
+	parentCreatedAt *time.Ticket,
+	createdAts []*time.Ticket,
+	executedAt *time.Ticket,
+) *SetRemove {
+	return &SetRemove{
+		parentCreatedAt: parentCreatedAt,
+		createdAts:      createdAts,
+		executedAt:      executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (o *SetRemove) Execute(root *crdt.Root, _ time.VersionVector) error {
+	parent := root.FindByCreatedAt(o.parentCreatedAt)
+	set, ok := parent.(*crdt.Set)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	for _, pair := range set.Delete(o.createdAts, o.executedAt) {
+		root.RegisterGCPair(pair)
+	}
+	return nil
+}
+
+// CreatedAts returns the creation times of the removed additions.
+func (o *SetRemove) CreatedAts() []*time.Ticket {
+	return o.createdAts
+}
+
+// ParentCreatedAt returns the creation time of the Set.
+func (o *SetRemove) ParentCreatedAt() *time.Ticket {
+	return o.parentCreatedAt
+}
+
+// ExecutedAt returns execution time of this operation.
+func (o *SetRemove) ExecutedAt() *time.Ticket {
+	return o.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (o *SetRemove) SetActor(actorID time.ActorID) {
+	o.executedAt = o.executedAt.SetActorID(actorID)
+}
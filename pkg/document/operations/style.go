@@ -35,6 +35,13 @@ type Style struct {
 	// attributes represents the text style.
 	attributes map[string]string
 
+	// attributesToRemove represents the text style to be removed.
+	attributesToRemove []string
+
+	// mark represents the mark to be applied instead of the attributes. The
+	// ID of the mark is the execution time of this operation.
+	mark *crdt.TextMark
+
 	// executedAt is the time the operation was executed.
 	executedAt *time.Ticket
 }
@@ -48,11 +55,51 @@ func NewStyle(
 	executedAt *time.Ticket,
 ) *Style {
 	return &Style{
-		parentCreatedAt: parentCreatedAt,
-		from:            from,
-		to:              to,
-		attributes:      attributes,
-		executedAt:      executedAt,
+		parentCreatedAt:    parentCreatedAt,
+		from:               from,
+		to:                 to,
+		attributes:         attributes,
+		attributesToRemove: []string{},
+		executedAt:         executedAt,
+	}
+}
+
+// NewStyleRemove creates a new instance of Style that removes the given
+// attributes.
+func NewStyleRemove(
+	parentCreatedAt *time.Ticket,
+	from *crdt.RGATreeSplitNodePos,
+	to *crdt.RGATreeSplitNodePos,
+	attributesToRemove []string,
+	executedAt *time.Ticket,
+) *Style {
+	return &Style{
+		parentCreatedAt:    parentCreatedAt,
+		from:               from,
+		to:                 to,
+		attributes:         map[string]string{},
+		attributesToRemove: attributesToRemove,
+		executedAt:         executedAt,
+	}
+}
+
+// NewStyleMark creates a new instance of Style that applies the given mark.
+// The given range is the range of the mark when it is created.
+func NewStyleMark(
+	parentCreatedAt *time.Ticket,
+	from *crdt.RGATreeSplitNodePos,
+	to *crdt.RGATreeSplitNodePos,
+	mark *crdt.TextMark,
+	executedAt *time.Ticket,
+) *Style {
+	return &Style{
+		parentCreatedAt:    parentCreatedAt,
+		from:               from,
+		to:                 to,
+		attributes:         map[string]string{},
+		attributesToRemove: []string{},
+		mark:               mark,
+		executedAt:         executedAt,
 	}
 }
 
@@ -64,7 +111,20 @@ func (e *Style) Execute(root *crdt.Root, versionVector time.VersionVector) error
 		return ErrNotApplicableDataType
 	}
 
-	pairs, err := obj.Style(e.from, e.to, e.attributes, e.executedAt, versionVector)
+	// NOTE: Marks are anchored to the characters, so they are applied
+	// regardless of the version vector.
+	if e.mark != nil {
+		obj.Mark(e.mark, e.executedAt)
+		return nil
+	}
+
+	var pairs []crdt.GCPair
+	var err error
+	if len(e.attributesToRemove) > 0 {
+		pairs, err = obj.RemoveStyle(e.from, e.to, e.attributesToRemove, e.executedAt, versionVector)
+	} else {
+		pairs, err = obj.Style(e.from, e.to, e.attributes, e.executedAt, versionVector)
+	}
 	if err != nil {
 		return err
 	}
@@ -105,3 +165,14 @@ func (e *Style) ParentCreatedAt() *time.Ticket {
 func (e *Style) Attributes() map[string]string {
 	return e.attributes
 }
+
+// AttributesToRemove returns the attributes to be removed by this operation.
+func (e *Style) AttributesToRemove() []string {
+	return e.attributesToRemove
+}
+
+// Mark returns the mark of this operation. It is nil if this operation
+// applies attributes.
+func (e *Style) Mark() *crdt.TextMark {
+	return e.mark
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// TreeMove represents the operation that moves a node of the tree to another
+// position.
+type TreeMove struct {
+	// parentCreatedAt is the creation time of the Tree that executes Move.
+	parentCreatedAt *time.Ticket
+
+	// target is the ID of the node to be moved.
+	target *crdt.TreeNodeID
+
+	// to is the position where the node is moved to.
+	to *crdt.TreePos
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewTreeMove creates a new instance of TreeMove.
+func NewTreeMove(
+	parentCreatedAt *time.Ticket,
+	target *crdt.TreeNodeID,
+	to *crdt.TreePos,
+	executedAt *time.Ticket,
+) *TreeMove {
+	return &TreeMove{
+		parentCreatedAt: parentCreatedAt,
+		target:          target,
+		to:              to,
+		executedAt:      executedAt,
+	}
+}
+
+// Execute executes this operation on the given `CRDTRoot`.
+func (m *TreeMove) Execute(root *crdt.Root, _ time.VersionVector) error {
+	parent := root.FindByCreatedAt(m.parentCreatedAt)
+	obj, ok := parent.(*crdt.Tree)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	return obj.Move(m.target, m.to, m.executedAt)
+}
+
+// Target returns the ID of the node to be moved.
+func (m *TreeMove) Target() *crdt.TreeNodeID {
+	return m.target
+}
+
+// ToPos returns the position where the node is moved to.
+func (m *TreeMove) ToPos() *crdt.TreePos {
+	return m.to
+}
+
+// ExecutedAt returns execution time of this operation.
+func (m *TreeMove) ExecutedAt() *time.Ticket {
+	return m.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (m *TreeMove) SetActor(actorID time.ActorID) {
+	m.executedAt = m.executedAt.SetActorID(actorID)
+}
+
+// ParentCreatedAt returns the creation time of the Tree.
+func (m *TreeMove) ParentCreatedAt() *time.Ticket {
+	return m.parentCreatedAt
+}
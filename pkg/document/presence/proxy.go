@@ -26,6 +26,10 @@ import (
 type Presence struct {
 	context  *change.Context
 	presence innerpresence.Presence
+
+	// patch records the updates of the keys as a patch carrying only the
+	// updated keys instead of the whole presence.
+	patch bool
 }
 
 // New creates a new instance of Presence.
@@ -36,6 +40,16 @@ func New(ctx *change.Context, presence innerpresence.Presence) *Presence {
 	}
 }
 
+// NewWithPatch creates a new instance of Presence that records the updates of
+// the keys as a patch. It should be used only if the server supports it.
+func NewWithPatch(ctx *change.Context, presence innerpresence.Presence) *Presence {
+	return &Presence{
+		context:  ctx,
+		presence: presence,
+		patch:    true,
+	}
+}
+
 // Initialize initializes the presence.
 func (p *Presence) Initialize(presence innerpresence.Presence) {
 	p.presence = presence
@@ -51,12 +65,28 @@ func (p *Presence) Initialize(presence innerpresence.Presence) {
 
 // Set sets the value of the given key.
 func (p *Presence) Set(key string, value string) {
-	innerPresence := p.presence
-	innerPresence.Set(key, value)
+	p.presence.Set(key, value)
+	p.update(key)
+}
+
+// SetValue sets the JSON encoding of the given value to the given key. It
+// panics if the value can not be encoded.
+func (p *Presence) SetValue(key string, value any) {
+	if err := p.presence.SetValue(key, value); err != nil {
+		panic(err)
+	}
+	p.update(key)
+}
+
+// Delete deletes the value of the given key.
+func (p *Presence) Delete(key string) {
+	p.presence.Delete(key)
 
+	// NOTE: A patch only carries the updated values, so the whole presence is
+	// put to delete the key.
 	p.context.SetPresenceChange(innerpresence.Change{
 		ChangeType: innerpresence.Put,
-		Presence:   innerPresence,
+		Presence:   p.presence,
 	})
 }
 
@@ -69,3 +99,27 @@ func (p *Presence) Clear() {
 		ChangeType: innerpresence.Clear,
 	})
 }
+
+// update records the update of the given key. If the presence is not patched
+// or it is put or cleared in the same change, the whole presence is put.
+func (p *Presence) update(key string) {
+	prev := p.context.PresenceChange()
+	if !p.patch || (prev != nil && prev.ChangeType != innerpresence.Patch) {
+		p.context.SetPresenceChange(innerpresence.Change{
+			ChangeType: innerpresence.Put,
+			Presence:   p.presence,
+		})
+		return
+	}
+
+	patch := innerpresence.New()
+	if prev != nil {
+		patch = prev.Presence
+	}
+	patch[key] = p.presence[key]
+
+	p.context.SetPresenceChange(innerpresence.Change{
+		ChangeType: innerpresence.Patch,
+		Presence:   patch,
+	})
+}
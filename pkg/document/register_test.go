@@ -0,0 +1,162 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/document/yson"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestRegister(t *testing.T) {
+	setup := func(t *testing.T) (*document.Document, *document.Document) {
+		d1, d2 := document.New("d1"), document.New("d1")
+		d1.SetActor(time.ActorID{1})
+		d2.SetActor(time.ActorID{2})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewRegister("r", "a")
+			return nil
+		}))
+		sync(t, d1, d2)
+		return d1, d2
+	}
+
+	t.Run("set test", func(t *testing.T) {
+		d1, _ := setup(t)
+		assert.Equal(t, `{"r":"a"}`, d1.Marshal())
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			r := root.GetRegister("r")
+			r.Set("b").Set(1)
+			assert.Equal(t, int32(1), r.Value())
+			assert.Nil(t, r.Conflicts())
+			return nil
+		}))
+		assert.Equal(t, `{"r":1}`, d1.Marshal())
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			assert.Nil(t, root.SetNewRegister("empty").Value())
+			return nil
+		}))
+		assert.Equal(t, `{"empty":null,"r":1}`, d1.Marshal())
+	})
+
+	t.Run("concurrent set test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetRegister("r").Set("b")
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetRegister("r").Set("c")
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		// 01. The values assigned concurrently are kept as conflicts.
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.Equal(t, []interface{}{"b", "c"}, d1.Root().GetRegister("r").Conflicts())
+		assert.Equal(t, []interface{}{"b", "c"}, d2.Root().GetRegister("r").Conflicts())
+
+		// 02. Setting a value again resolves the conflicts.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetRegister("r").Set("b")
+			return nil
+		}))
+		sync(t, d2, d1)
+		assert.Nil(t, d1.Root().GetRegister("r").Conflicts())
+		assert.Equal(t, `{"r":"b"}`, d1.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("garbage collection test", func(t *testing.T) {
+		doc, _ := setup(t)
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetRegister("r").Set("b").Set("c")
+			return nil
+		}))
+		assert.Equal(t, 2, doc.GarbageLen())
+
+		doc.GarbageCollect(helper.MaxVersionVector(doc.ActorID()))
+		assert.Equal(t, 0, doc.GarbageLen())
+		assert.Len(t, doc.Root().GetRegister("r").Nodes(), 1)
+	})
+
+	t.Run("undo and redo test", func(t *testing.T) {
+		doc, _ := setup(t)
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetRegister("r").Set("b")
+			return nil
+		}))
+		assert.Equal(t, `{"r":"b"}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"r":"a"}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"r":"b"}`, doc.Marshal())
+	})
+
+	t.Run("snapshot and yson test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetRegister("r").Set("b")
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetRegister("r").Set(1)
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		bytes, err := converter.ObjectToBytes(d1.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, d1.Marshal(), obj.Marshal())
+
+		root, err := yson.FromCRDT(d1.RootObject())
+		assert.NoError(t, err)
+		marshaled, err := root.(yson.Object).Marshal()
+		assert.NoError(t, err)
+		assert.Equal(t, `{"r":Register(["b",Int(1)])}`, marshaled)
+
+		var parsed yson.Object
+		assert.NoError(t, yson.Unmarshal(marshaled, &parsed))
+		assert.Equal(t, root, parsed)
+
+		doc := document.New("d2")
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetYSON(parsed)
+			return nil
+		}))
+		assert.Equal(t, []interface{}{"b", int32(1)}, doc.Root().GetRegister("r").Conflicts())
+	})
+}
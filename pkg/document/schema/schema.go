@@ -0,0 +1,259 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package schema provides a JSON-Schema-like description of the document root
+// and validation of the root against it. In addition to the types of JSON
+// Schema, it covers the types of Yorkie such as Text, Tree and Counter.
+package schema
+
+import (
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+)
+
+var (
+	// ErrInvalidSchema is returned when the given schema is not valid.
+	ErrInvalidSchema = errors.New("invalid schema")
+
+	// ErrSchemaViolation is returned when the document does not conform to
+	// the schema.
+	ErrSchemaViolation = errors.New("schema violation")
+)
+
+// Type represents the type of the element described by the schema.
+type Type string
+
+// The types below are the types that can be described by the schema.
+const (
+	// Any means that the element can be of any type.
+	Any Type = ""
+
+	Object   Type = "object"
+	Array    Type = "array"
+	Null     Type = "null"
+	Boolean  Type = "boolean"
+	Integer  Type = "integer"
+	Long     Type = "long"
+	Double   Type = "double"
+	Number   Type = "number"
+	String   Type = "string"
+	Bytes    Type = "bytes"
+	Date     Type = "date"
+	Text     Type = "text"
+	Tree     Type = "tree"
+	Counter  Type = "counter"
+	Set      Type = "set"
+	Register Type = "register"
+)
+
+var knownTypes = map[Type]bool{
+	Any: true, Object: true, Array: true, Null: true, Boolean: true,
+	Integer: true, Long: true, Double: true, Number: true, String: true,
+	Bytes: true, Date: true, Text: true, Tree: true, Counter: true,
+	Set: true, Register: true,
+}
+
+// Schema describes an element of the document. The root of the schema
+// describes the root object of the document.
+type Schema struct {
+	// Type is the type of the element. If it is empty, any type is allowed.
+	Type Type `json:"type,omitempty"`
+
+	// Properties describes the members of the object.
+	Properties map[string]*Schema `json:"properties,omitempty"`
+
+	// Required is the list of the members that the object must have.
+	Required []string `json:"required,omitempty"`
+
+	// AdditionalProperties is whether the object can have members that are
+	// not described in Properties. If it is nil, they are allowed.
+	AdditionalProperties *bool `json:"additionalProperties,omitempty"`
+
+	// Items describes the elements of the array.
+	Items *Schema `json:"items,omitempty"`
+}
+
+// Parse parses the given JSON into a schema. The root of the schema should
+// describe an object.
+func Parse(data string) (*Schema, error) {
+	s := &Schema{}
+	if err := gojson.Unmarshal([]byte(data), s); err != nil {
+		return nil, fmt.Errorf("parse schema: %s: %w", err.Error(), ErrInvalidSchema)
+	}
+
+	if s.Type != Any && s.Type != Object {
+		return nil, fmt.Errorf("root should be an object, got %s: %w", s.Type, ErrInvalidSchema)
+	}
+
+	if err := s.check("$"); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// String returns the JSON encoding of this schema.
+func (s *Schema) String() string {
+	bytes, err := gojson.Marshal(s)
+	if err != nil {
+		return ""
+	}
+
+	return string(bytes)
+}
+
+// Validate validates the given root object against this schema.
+func (s *Schema) Validate(root *crdt.Object) error {
+	return s.validate("$", root)
+}
+
+// check checks whether this schema is well-formed.
+func (s *Schema) check(path string) error {
+	if !knownTypes[s.Type] {
+		return fmt.Errorf("%s: unknown type %q: %w", path, s.Type, ErrInvalidSchema)
+	}
+
+	if (len(s.Properties) > 0 || len(s.Required) > 0) && s.Type != Object {
+		return fmt.Errorf("%s: properties of non-object type %q: %w", path, s.Type, ErrInvalidSchema)
+	}
+	if s.Items != nil && s.Type != Array {
+		return fmt.Errorf("%s: items of non-array type %q: %w", path, s.Type, ErrInvalidSchema)
+	}
+
+	for _, k := range sortedKeys(s.Properties) {
+		if s.Properties[k] == nil {
+			return fmt.Errorf("%s.%s: empty schema: %w", path, k, ErrInvalidSchema)
+		}
+		if err := s.Properties[k].check(path + "." + k); err != nil {
+			return err
+		}
+	}
+
+	if s.Items != nil {
+		return s.Items.check(path + "[]")
+	}
+
+	return nil
+}
+
+// validate validates the given element against this schema.
+func (s *Schema) validate(path string, elem crdt.Element) error {
+	if actual := typeOf(elem); !s.accepts(actual) {
+		return fmt.Errorf("%s: expected %s, got %s: %w", path, s.Type, actual, ErrSchemaViolation)
+	}
+
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		return s.validateObject(path, elem)
+	case *crdt.Array:
+		if s.Items == nil {
+			return nil
+		}
+		for i, child := range elem.Elements() {
+			if err := s.Items.validate(fmt.Sprintf("%s.%d", path, i), child); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateObject validates the members of the given object.
+func (s *Schema) validateObject(path string, obj *crdt.Object) error {
+	members := obj.Members()
+	for _, k := range s.Required {
+		if _, ok := members[k]; !ok {
+			return fmt.Errorf("%s: missing required property %q: %w", path, k, ErrSchemaViolation)
+		}
+	}
+
+	for _, k := range sortedKeys(members) {
+		prop, ok := s.Properties[k]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return fmt.Errorf("%s: unexpected property %q: %w", path, k, ErrSchemaViolation)
+			}
+			continue
+		}
+
+		if err := prop.validate(path+"."+k, members[k]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// accepts returns whether this schema accepts the given type.
+func (s *Schema) accepts(t Type) bool {
+	switch s.Type {
+	case Any:
+		return true
+	case Number:
+		return t == Integer || t == Long || t == Double
+	default:
+		return s.Type == t
+	}
+}
+
+// typeOf returns the type of the given element.
+func typeOf(elem crdt.Element) Type {
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		return Object
+	case *crdt.Array:
+		return Array
+	case *crdt.Primitive:
+		return primitiveTypes[elem.ValueType()]
+	case *crdt.Text:
+		return Text
+	case *crdt.Tree:
+		return Tree
+	case *crdt.Counter:
+		return Counter
+	case *crdt.Set:
+		return Set
+	case *crdt.MVRegister:
+		return Register
+	}
+
+	return Any
+}
+
+var primitiveTypes = map[crdt.ValueType]Type{
+	crdt.Null:    Null,
+	crdt.Boolean: Boolean,
+	crdt.Integer: Integer,
+	crdt.Long:    Long,
+	crdt.Double:  Double,
+	crdt.String:  String,
+	crdt.Bytes:   Bytes,
+	crdt.Date:    Date,
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/schema"
+)
+
+const todoSchema = `{
+	"type": "object",
+	"properties": {
+		"title": {"type": "string"},
+		"content": {"type": "text"},
+		"views": {"type": "counter"},
+		"todos": {
+			"type": "array",
+			"items": {
+				"type": "object",
+				"properties": {
+					"text": {"type": "string"},
+					"done": {"type": "boolean"}
+				},
+				"required": ["text"],
+				"additionalProperties": false
+			}
+		},
+		"score": {"type": "number"},
+		"meta": {}
+	},
+	"required": ["title"]
+}`
+
+func TestSchema(t *testing.T) {
+	t.Run("parse test", func(t *testing.T) {
+		s, err := schema.Parse(todoSchema)
+		assert.NoError(t, err)
+		assert.Equal(t, schema.Object, s.Type)
+		assert.Equal(t, schema.Text, s.Properties["content"].Type)
+
+		reparsed, err := schema.Parse(s.String())
+		assert.NoError(t, err)
+		assert.Equal(t, s, reparsed)
+	})
+
+	t.Run("parse invalid schema test", func(t *testing.T) {
+		for _, data := range []string{
+			`{`,
+			`{"type": "string"}`,
+			`{"type": "object", "properties": {"a": {"type": "unknown"}}}`,
+			`{"type": "object", "properties": {"a": {"type": "string", "items": {}}}}`,
+			`{"type": "object", "properties": {"a": {"type": "array", "required": ["b"]}}}`,
+		} {
+			_, err := schema.Parse(data)
+			assert.ErrorIs(t, err, schema.ErrInvalidSchema, data)
+		}
+	})
+
+	t.Run("validate test", func(t *testing.T) {
+		s, err := schema.Parse(todoSchema)
+		assert.NoError(t, err)
+
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "todo")
+			root.SetNewText("content").Edit(0, 0, "hello")
+			root.SetNewCounter("views", crdt.IntegerCnt, 0)
+			root.SetNewArray("todos").AddNewObject().SetString("text", "a").SetBool("done", false)
+			root.SetDouble("score", 1.5)
+			root.SetNewObject("meta").SetInteger("any", 1)
+			return nil
+		}))
+		assert.NoError(t, s.Validate(doc.RootObject()))
+	})
+
+	t.Run("validate violation test", func(t *testing.T) {
+		s, err := schema.Parse(todoSchema)
+		assert.NoError(t, err)
+
+		for _, tc := range []struct {
+			updater func(root *json.Object)
+			message string
+		}{
+			{func(root *json.Object) {}, `$: missing required property "title"`},
+			{func(root *json.Object) {
+				root.SetString("title", "t").SetInteger("views", 1)
+			}, `$.views: expected counter, got integer`},
+			{func(root *json.Object) {
+				root.SetString("title", "t").SetString("content", "hello")
+			}, `$.content: expected text, got string`},
+			{func(root *json.Object) {
+				root.SetString("title", "t").SetString("score", "high")
+			}, `$.score: expected number, got string`},
+			{func(root *json.Object) {
+				root.SetString("title", "t").SetNewArray("todos").AddNewObject().SetBool("done", true)
+			}, `$.todos.0: missing required property "text"`},
+			{func(root *json.Object) {
+				todo := root.SetString("title", "t").SetNewArray("todos").AddNewObject()
+				todo.SetString("text", "a").SetInteger("due", 1)
+			}, `$.todos.0: unexpected property "due"`},
+		} {
+			doc := document.New("d1")
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				tc.updater(root)
+				return nil
+			}))
+
+			err := s.Validate(doc.RootObject())
+			assert.ErrorIs(t, err, schema.ErrSchemaViolation)
+			assert.ErrorContains(t, err, tc.message)
+		}
+	})
+}
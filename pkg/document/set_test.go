@@ -0,0 +1,145 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/document/yson"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestSet(t *testing.T) {
+	setup := func(t *testing.T) (*document.Document, *document.Document) {
+		d1, d2 := document.New("d1"), document.New("d1")
+		d1.SetActor(time.ActorID{1})
+		d2.SetActor(time.ActorID{2})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewSet("s").Add("a", "b", 1)
+			return nil
+		}))
+		sync(t, d1, d2)
+		return d1, d2
+	}
+
+	t.Run("add and remove test", func(t *testing.T) {
+		d1, _ := setup(t)
+		assert.Equal(t, `{"s":["a","b",1]}`, d1.Marshal())
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			s := root.GetSet("s")
+			s.Add("a").Remove("b", "c")
+			assert.True(t, s.Has("a"))
+			assert.False(t, s.Has("b"))
+			assert.Equal(t, []interface{}{"a", int32(1)}, s.Values())
+			return nil
+		}))
+		assert.Equal(t, `{"s":["a",1]}`, d1.Marshal())
+	})
+
+	t.Run("concurrent add wins over remove test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetSet("s").Remove("a")
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetSet("s").Remove("a").Add("a")
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t, `{"s":["b",1,"a"]}`, d1.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("concurrent remove test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetSet("s").Remove("a", "b")
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetSet("s").Remove("b")
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t, `{"s":[1]}`, d1.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("garbage collection test", func(t *testing.T) {
+		doc, _ := setup(t)
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetSet("s").Remove("a")
+			return nil
+		}))
+		assert.Equal(t, 1, doc.GarbageLen())
+
+		doc.GarbageCollect(helper.MaxVersionVector(doc.ActorID()))
+		assert.Equal(t, 0, doc.GarbageLen())
+		assert.Len(t, doc.Root().GetSet("s").Nodes(), 2)
+	})
+
+	t.Run("undo and redo test", func(t *testing.T) {
+		doc, _ := setup(t)
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetSet("s").Remove("a").Add("c")
+			return nil
+		}))
+		assert.Equal(t, `{"s":["b",1,"c"]}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"s":["b",1,"a"]}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"s":["b",1,"c"]}`, doc.Marshal())
+	})
+
+	t.Run("snapshot and yson test", func(t *testing.T) {
+		doc, _ := setup(t)
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), obj.Marshal())
+
+		root, err := yson.FromCRDT(doc.RootObject())
+		assert.NoError(t, err)
+		marshaled, err := root.(yson.Object).Marshal()
+		assert.NoError(t, err)
+		assert.Equal(t, `{"s":Set(["a","b",Int(1)])}`, marshaled)
+
+		var parsed yson.Object
+		assert.NoError(t, yson.Unmarshal(marshaled, &parsed))
+		assert.Equal(t, root, parsed)
+	})
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/pkg/resource"
+)
+
+var (
+	// ErrDocumentSizeExceeded is returned when a change makes the document
+	// larger than the maximum document size.
+	ErrDocumentSizeExceeded = errors.New("document size limit exceeded")
+
+	// ErrChangeSizeExceeded is returned when a change adds more data to the
+	// document than the maximum change size.
+	ErrChangeSizeExceeded = errors.New("change size limit exceeded")
+)
+
+// SizeLimit represents the limits on the size of a document. The sizes are
+// measured by the live data and meta of the document in bytes; the garbage is
+// not counted.
+type SizeLimit struct {
+	// MaxDocumentSize is the maximum size of the document. If it is 0, there
+	// is no limit.
+	MaxDocumentSize int
+
+	// MaxChangeSize is the maximum size that a single change can add to the
+	// document. If it is 0, there is no limit.
+	MaxChangeSize int
+}
+
+// IsEnabled returns whether any of the limits is set.
+func (l SizeLimit) IsEnabled() bool {
+	return l.MaxDocumentSize > 0 || l.MaxChangeSize > 0
+}
+
+// Validate checks whether the change that turned the document of the before
+// size into the after size is within the limits. A change that does not grow
+// the document is always allowed, so that the users can shrink a document
+// that already exceeds the limit.
+func (l SizeLimit) Validate(before, after resource.DocSize) error {
+	growth := after.Live.Total() - before.Live.Total()
+	if growth <= 0 {
+		return nil
+	}
+
+	if l.MaxChangeSize > 0 && growth > l.MaxChangeSize {
+		return fmt.Errorf("%d bytes added, %d bytes allowed per change: %w",
+			growth,
+			l.MaxChangeSize,
+			ErrChangeSizeExceeded,
+		)
+	}
+
+	if l.MaxDocumentSize > 0 && after.Live.Total() > l.MaxDocumentSize {
+		return fmt.Errorf("%d bytes in total, %d bytes allowed per document: %w",
+			after.Live.Total(),
+			l.MaxDocumentSize,
+			ErrDocumentSizeExceeded,
+		)
+	}
+
+	return nil
+}
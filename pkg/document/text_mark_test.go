@@ -0,0 +1,204 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestTextMark(t *testing.T) {
+	setup := func(t *testing.T, content string) (*document.Document, *document.Document) {
+		d1, d2 := document.New("d1"), document.New("d1")
+		d1.SetActor(time.ActorID{1})
+		d2.SetActor(time.ActorID{2})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("t").Edit(0, 0, content)
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+		return d1, d2
+	}
+
+	t.Run("concurrent insertion at the boundaries of mark test", func(t *testing.T) {
+		d1, d2 := setup(t, "abc")
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Mark(1, 2, "bold", "true", crdt.MarkExpandAfter)
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Edit(2, 2, "X")
+			root.GetText("t").Edit(1, 1, "Y")
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.Equal(t, "aYbXc", d1.Root().GetText("t").String())
+		assert.Equal(
+			t,
+			`{"t":[{"val":"aY"},{"attrs":{"bold":"true"},"val":"bX"},{"val":"c"}]}`,
+			d1.Marshal(),
+		)
+	})
+
+	t.Run("concurrent insertion with expand none test", func(t *testing.T) {
+		d1, d2 := setup(t, "abc")
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Mark(1, 2, "link", "https://yorkie.dev", crdt.MarkExpandNone)
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Edit(2, 2, "X")
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.Equal(
+			t,
+			`{"t":[{"val":"a"},{"attrs":{"link":"https://yorkie.dev"},"val":"b"},{"val":"Xc"}]}`,
+			d1.Marshal(),
+		)
+
+		// NOTE: The text inserted inside the mark is always marked.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Edit(1, 1, "Y")
+			root.GetText("t").Mark(1, 3, "link", "https://yorkie.dev", crdt.MarkExpandNone)
+			root.GetText("t").Edit(2, 2, "Z")
+			return nil
+		}))
+		assert.Equal(
+			t,
+			`{"t":[{"val":"a"},{"attrs":{"link":"https://yorkie.dev"},"val":"YZb"},{"val":"Xc"}]}`,
+			d1.Marshal(),
+		)
+	})
+
+	t.Run("overlapping non-mergeable marks test", func(t *testing.T) {
+		d1, d2 := setup(t, "abcd")
+
+		var id1, id2 string
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			id1 = root.GetText("t").MarkWithID(0, 3, "comment", "c1", crdt.MarkExpandNone)
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			id2 = root.GetText("t").MarkWithID(1, 4, "comment", "c2", crdt.MarkExpandNone)
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.NotEqual(t, id1, id2)
+
+		spans := d1.Root().GetText("t").Spans()
+		assert.Len(t, spans, 3)
+		assert.Equal(t, "a", spans[0].Value)
+		assert.Equal(t, "c1", spans[0].Attributes["comment:"+id1])
+		assert.Equal(t, "bc", spans[1].Value)
+		assert.Equal(t, "c1", spans[1].Attributes["comment:"+id1])
+		assert.Equal(t, "c2", spans[1].Attributes["comment:"+id2])
+		assert.Equal(t, "d", spans[2].Value)
+		assert.Equal(t, "c2", spans[2].Attributes["comment:"+id2])
+	})
+
+	t.Run("unmark test", func(t *testing.T) {
+		d1, d2 := setup(t, "abcd")
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Mark(0, 4, "bold", "true", crdt.MarkExpandAfter)
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Unmark(1, 3, "bold", crdt.MarkExpandNone)
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Edit(4, 4, "e")
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.Equal(
+			t,
+			`{"t":[{"attrs":{"bold":"true"},"val":"a"},{"val":"bc"},{"attrs":{"bold":"true"},"val":"de"}]}`,
+			d1.Marshal(),
+		)
+	})
+
+	t.Run("mark with removed boundaries test", func(t *testing.T) {
+		d1, d2 := setup(t, "abcd")
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Mark(1, 3, "bold", "true", crdt.MarkExpandBoth)
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Edit(1, 3, "")
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+		assert.Equal(t, `{"t":[{"val":"ad"}]}`, d1.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// NOTE: The removed characters that anchor the mark are not purged, so
+		// the text inserted between them still has the mark.
+		assert.Equal(t, 0, d1.GarbageLen())
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Edit(1, 1, "X")
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+		assert.Equal(t, `{"t":[{"val":"a"},{"attrs":{"bold":"true"},"val":"X"},{"val":"d"}]}`, d1.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("mark converting test", func(t *testing.T) {
+		d1, _ := setup(t, "abc")
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("t").Mark(0, 2, "bold", "true", crdt.MarkExpandAfter)
+			root.GetText("t").MarkWithID(1, 3, "comment", "c1", crdt.MarkExpandNone)
+			return nil
+		}))
+
+		bytes, err := converter.ObjectToBytes(d1.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, d1.Marshal(), obj.Marshal())
+
+		text := obj.Get("t").(*crdt.Text)
+		assert.Len(t, text.Marks(), 2)
+		assert.Equal(t, crdt.MarkExpandAfter, text.Marks()[0].Expand())
+		assert.False(t, text.Marks()[1].Mergeable())
+	})
+}
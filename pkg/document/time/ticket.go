@@ -83,6 +83,39 @@ func NewTicket(
 	}
 }
 
+// TicketStruct is the serializable form of Ticket. The lamport is
+// represented as a string because it may exceed the safe integer range of
+// JavaScript.
+type TicketStruct struct {
+	Lamport   string `json:"lamport"`
+	Delimiter uint32 `json:"delimiter"`
+	ActorID   string `json:"actorID"`
+}
+
+// NewTicketFromStruct creates an instance of Ticket from the given struct.
+func NewTicketFromStruct(s TicketStruct) (*Ticket, error) {
+	lamport, err := strconv.ParseInt(s.Lamport, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse lamport %s: %w", s.Lamport, err)
+	}
+
+	actorID, err := ActorIDFromHex(s.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTicket(lamport, s.Delimiter, actorID), nil
+}
+
+// ToStruct returns the serializable form of this Ticket.
+func (t *Ticket) ToStruct() TicketStruct {
+	return TicketStruct{
+		Lamport:   strconv.FormatInt(t.lamport, 10),
+		Delimiter: t.delimiter,
+		ActorID:   t.ActorIDHex(),
+	}
+}
+
 // ToTestString returns a string containing the metadata of the ticket
 // for debugging purpose.
 func (t *Ticket) ToTestString() string {
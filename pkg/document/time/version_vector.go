@@ -93,6 +93,23 @@ func (v VersionVector) VersionOf(id ActorID) int64 {
 	return v[id]
 }
 
+// Rename returns a copy of this VersionVector in which the version of the
+// given actor is moved to the other actor. If the given actor does not exist
+// in this VersionVector, this VersionVector itself is returned.
+func (v VersionVector) Rename(from, to ActorID) VersionVector {
+	version, exists := v[from]
+	if !exists || from == to {
+		return v
+	}
+
+	renamed := v.DeepCopy()
+	renamed.Unset(from)
+	if version > renamed.VersionOf(to) {
+		renamed.Set(to, version)
+	}
+	return renamed
+}
+
 // DeepCopy creates a deep copy of this VersionVector.
 func (v VersionVector) DeepCopy() VersionVector {
 	copied := NewVersionVector()
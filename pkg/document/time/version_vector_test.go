@@ -106,3 +106,27 @@ func TestVersionVector(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionVectorRename(t *testing.T) {
+	actor1, _ := time.ActorIDFromHex("000000000000000000000001")
+	actor2, _ := time.ActorIDFromHex("000000000000000000000002")
+	actor3, _ := time.ActorIDFromHex("000000000000000000000003")
+
+	t.Run("rename to new actor test", func(t *testing.T) {
+		v := helper.VersionVectorOf(map[time.ActorID]int64{actor1: 3, actor2: 4})
+		renamed := v.Rename(actor1, actor3)
+		assert.Equal(t, helper.VersionVectorOf(map[time.ActorID]int64{actor2: 4, actor3: 3}), renamed)
+		assert.Equal(t, helper.VersionVectorOf(map[time.ActorID]int64{actor1: 3, actor2: 4}), v)
+	})
+
+	t.Run("rename to existing actor test", func(t *testing.T) {
+		v := helper.VersionVectorOf(map[time.ActorID]int64{actor1: 3, actor2: 4})
+		assert.Equal(t, helper.VersionVectorOf(map[time.ActorID]int64{actor2: 4}), v.Rename(actor1, actor2))
+		assert.Equal(t, helper.VersionVectorOf(map[time.ActorID]int64{actor1: 4}), v.Rename(actor2, actor1))
+	})
+
+	t.Run("rename absent actor test", func(t *testing.T) {
+		v := helper.VersionVectorOf(map[time.ActorID]int64{actor2: 4})
+		assert.Equal(t, v, v.Rename(actor1, actor3))
+	})
+}
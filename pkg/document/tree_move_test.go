@@ -0,0 +1,288 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestTreeMove(t *testing.T) {
+	// <root><div><p>a</p></div><div><p>b</p></div><p>c</p></root>
+	initialState := json.TreeNode{
+		Type: "root",
+		Children: []json.TreeNode{
+			{Type: "div", Children: []json.TreeNode{
+				{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+			}},
+			{Type: "div", Children: []json.TreeNode{
+				{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+			}},
+			{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "c"}}},
+		},
+	}
+
+	setup := func(t *testing.T) (*document.Document, *document.Document) {
+		d1, d2 := document.New("d1"), document.New("d1")
+		d1.SetActor(time.ActorID{1})
+		d2.SetActor(time.ActorID{2})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", initialState)
+			return nil
+		}))
+		sync(t, d1, d2)
+		return d1, d2
+	}
+
+	t.Run("move node test", func(t *testing.T) {
+		d1, _ := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{2}, []int{0})
+			return nil
+		}))
+		assert.Equal(t,
+			"<root><p>c</p><div><p>a</p></div><div><p>b</p></div></root>",
+			d1.Root().GetTree("t").ToXML(),
+		)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{1, 1})
+			return nil
+		}))
+		assert.Equal(t,
+			"<root><div><p>a</p><p>c</p></div><div><p>b</p></div></root>",
+			d1.Root().GetTree("t").ToXML(),
+		)
+		assert.Equal(t, 13, d1.Root().GetTree("t").Len())
+
+		assert.Panics(t, func() {
+			_ = d1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.GetTree("t").Move([]int{5}, []int{0})
+				return nil
+			})
+		})
+	})
+
+	t.Run("move node into its descendant test", func(t *testing.T) {
+		d1, _ := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{0, 0})
+			return nil
+		}))
+		assert.Equal(t,
+			"<root><div><p>a</p></div><div><p>b</p></div><p>c</p></root>",
+			d1.Root().GetTree("t").ToXML(),
+		)
+	})
+
+	t.Run("concurrent move of the same node test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{2}, []int{0})
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{2}, []int{1, 0})
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		// NOTE: The move executed later wins and the node is not duplicated.
+		assert.Equal(t,
+			"<root><div><p>a</p></div><div><p>c</p><p>b</p></div></root>",
+			d1.Root().GetTree("t").ToXML(),
+		)
+		assert.Equal(t, d1.Root().GetTree("t").ToXML(), d2.Root().GetTree("t").ToXML())
+	})
+
+	t.Run("concurrent moves making a cycle test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{1, 1})
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{1}, []int{0, 1})
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		// NOTE: The move executed later is skipped because it would make a
+		// cycle after the earlier one.
+		assert.Equal(t,
+			"<root><div><p>b</p><div><p>a</p></div></div><p>c</p></root>",
+			d1.Root().GetTree("t").ToXML(),
+		)
+		assert.Equal(t, d1.Root().GetTree("t").ToXML(), d2.Root().GetTree("t").ToXML())
+	})
+
+	t.Run("concurrent move and edit test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{3})
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(3, 3, &json.TreeNode{Type: "text", Value: "A"}, 0)
+			root.GetTree("t").Edit(10, 10, &json.TreeNode{Type: "p"}, 0)
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t,
+			"<root><div><p>b</p><p></p></div><p>c</p><div><p>aA</p></div></root>",
+			d1.Root().GetTree("t").ToXML(),
+		)
+		assert.Equal(t, d1.Root().GetTree("t").ToXML(), d2.Root().GetTree("t").ToXML())
+	})
+
+	t.Run("concurrent move and delete test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{3})
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(0, 5, nil, 0)
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t,
+			"<root><div><p>b</p></div><p>c</p></root>",
+			d1.Root().GetTree("t").ToXML(),
+		)
+		assert.Equal(t, d1.Root().GetTree("t").ToXML(), d2.Root().GetTree("t").ToXML())
+		assert.Equal(t, d1.Root().GetTree("t").Len(), d2.Root().GetTree("t").Len())
+	})
+
+	t.Run("concurrent move and delete inside removed parent test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{1, 0}, []int{0, 0})
+			root.GetTree("t").Edit(8, 10, nil, 0)
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(6, 9, nil, 0)
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t,
+			"<root><div><p>a</p></div><p>c</p></root>",
+			d1.Root().GetTree("t").ToXML(),
+		)
+		assert.Equal(t, d1.Root().GetTree("t").ToXML(), d2.Root().GetTree("t").ToXML())
+		assert.Equal(t, d1.Root().GetTree("t").Len(), d2.Root().GetTree("t").Len())
+	})
+
+	t.Run("compact moves seen by all replicas test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		vector := d1.VersionVector().DeepCopy()
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{2}, []int{0})
+			return nil
+		}))
+		assert.Len(t, d1.Root().GetTree("t").Moves(), 1)
+
+		// 01. The move not seen by the given version vector is kept.
+		d1.GarbageCollect(vector)
+		assert.Len(t, d1.Root().GetTree("t").Moves(), 1)
+
+		// 02. The move seen by all replicas is removed from the log.
+		syncConcurrently(t, d1, d2)
+		assert.Empty(t, d1.Root().GetTree("t").Moves())
+		assert.Empty(t, d2.Root().GetTree("t").Moves())
+		assert.Equal(t, d1.Root().GetTree("t").ToXML(), d2.Root().GetTree("t").ToXML())
+
+		copied, err := d1.Root().GetTree("t").DeepCopy()
+		assert.NoError(t, err)
+		assert.Empty(t, copied.(*crdt.Tree).Moves())
+	})
+
+	t.Run("undo move test", func(t *testing.T) {
+		d1, _ := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{2}, []int{0})
+			return nil
+		}))
+		assert.NoError(t, d1.History().Undo())
+		assert.Equal(t,
+			"<root><div><p>a</p></div><div><p>b</p></div><p>c</p></root>",
+			d1.Root().GetTree("t").ToXML(),
+		)
+		assert.NoError(t, d1.History().Redo())
+		assert.Equal(t,
+			"<root><p>c</p><div><p>a</p></div><div><p>b</p></div></root>",
+			d1.Root().GetTree("t").ToXML(),
+		)
+	})
+}
+
+// syncConcurrently exchanges the local changes of the given documents. The
+// packs are encoded before being applied as clients do, so that the version
+// vectors of the changes are not affected by the changes of the other.
+func syncConcurrently(t *testing.T, d1, d2 *document.Document) {
+	pack1, pack2 := encodedPackOf(t, d1, d2), encodedPackOf(t, d2, d1)
+	assert.NoError(t, d2.ApplyChangePack(pack1))
+	assert.NoError(t, d1.ApplyChangePack(pack2))
+}
+
+func encodedPackOf(t *testing.T, from, to *document.Document) *change.Pack {
+	pack := from.CreateChangePack()
+	var changes []*change.Change
+	for _, c := range pack.Changes {
+		if to.VersionVector().VersionOf(from.ActorID()) < c.ID().Lamport() {
+			changes = append(changes, c)
+		}
+	}
+
+	vector := pack.VersionVector.DeepCopy()
+	vector.Set(to.ActorID(), to.VersionVector().VersionOf(to.ActorID()))
+	pbPack, err := converter.ToChangePack(change.NewPack(
+		to.Key(),
+		to.Checkpoint(),
+		changes,
+		vector,
+		nil,
+	))
+	assert.NoError(t, err)
+
+	decoded, err := converter.FromChangePack(pbPack)
+	assert.NoError(t, err)
+	return decoded
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+var testTreeSchema = &json.TreeSchema{
+	Root: "doc",
+	Nodes: map[string]json.TreeNodeSpec{
+		"doc":        {Content: []string{"paragraph", "code_block"}},
+		"paragraph":  {Content: []string{"text", "image"}},
+		"code_block": {TextOnly: true, Attributes: []string{"language"}},
+		"image":      {RequiredAttributes: []string{"src"}, Attributes: []string{"alt"}},
+	},
+}
+
+func TestTreeSchema(t *testing.T) {
+	setup := func(t *testing.T) (*document.Document, *document.Document) {
+		d1, d2 := document.New("d1"), document.New("d1")
+		d1.SetActor(time.ActorID{1})
+		d2.SetActor(time.ActorID{2})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTreeWithSchema("t", testTreeSchema, json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{
+					{Type: "code_block", Children: []json.TreeNode{{Type: "text", Value: "ab"}}},
+					{Type: "paragraph", Children: []json.TreeNode{
+						{Type: "text", Value: "c"},
+						{Type: "image", Attributes: map[string]string{"src": "a.png"}},
+					}},
+				},
+			})
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+		return d1, d2
+	}
+
+	t.Run("initial root test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			assert.PanicsWithError(t, `"doc" cannot contain "p": tree schema violation`, func() {
+				root.SetNewTreeWithSchema("t", testTreeSchema, json.TreeNode{
+					Type:     "doc",
+					Children: []json.TreeNode{{Type: "p"}},
+				})
+			})
+			return nil
+		}))
+	})
+
+	t.Run("edit test", func(t *testing.T) {
+		d1, _ := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			tree := root.GetTree("t")
+			assert.PanicsWithError(t, `"code_block" can only contain text, not "image": tree schema violation`, func() {
+				tree.Edit(1, 1, &json.TreeNode{Type: "image", Attributes: map[string]string{"src": "b.png"}}, 0)
+			})
+			assert.PanicsWithError(t, `"image" requires attribute "src": tree schema violation`, func() {
+				tree.Edit(5, 5, &json.TreeNode{Type: "image"}, 0)
+			})
+			assert.PanicsWithError(t, `"doc" cannot contain "image": tree schema violation`, func() {
+				tree.Edit(0, 0, &json.TreeNode{Type: "image", Attributes: map[string]string{"src": "b.png"}}, 0)
+			})
+
+			tree.Edit(5, 5, &json.TreeNode{Type: "image", Attributes: map[string]string{"src": "b.png"}}, 0)
+			tree.Edit(0, 0, &json.TreeNode{Type: "paragraph"}, 0)
+			return nil
+		}))
+		assert.Equal(t,
+			`<doc><paragraph></paragraph><code_block>ab</code_block><paragraph>`+
+				`<image src="b.png"></image>c<image src="a.png"></image></paragraph></doc>`,
+			d1.Root().GetTree("t").ToXML(),
+		)
+	})
+
+	t.Run("style test", func(t *testing.T) {
+		d1, _ := setup(t)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			tree := root.GetTree("t")
+			assert.PanicsWithError(t, `"code_block" cannot have attribute "theme": tree schema violation`, func() {
+				tree.Style(0, 1, map[string]string{"theme": "dark"})
+			})
+			assert.PanicsWithError(t, `"image" requires attribute "src": tree schema violation`, func() {
+				tree.RemoveStyle(6, 7, []string{"src"})
+			})
+
+			tree.Style(0, 1, map[string]string{"language": "go"})
+			tree.Style(6, 7, map[string]string{"alt": "a"})
+			return nil
+		}))
+		assert.Equal(t,
+			`<doc><code_block language="go">ab</code_block>`+
+				`<paragraph>c<image alt="a" src="a.png"></image></paragraph></doc>`,
+			d1.Root().GetTree("t").ToXML(),
+		)
+	})
+
+	t.Run("remote change test", func(t *testing.T) {
+		d1, d2 := setup(t)
+
+		// 01. The schema is replicated to the remote document.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			assert.Equal(t, crdt.TreeSchema(*testTreeSchema), *root.GetTree("t").Schema())
+			assert.Panics(t, func() {
+				root.GetTree("t").Edit(0, 0, &json.TreeNode{Type: "image"}, 0)
+			})
+			return nil
+		}))
+
+		// 02. Merging the nodes by deleting their boundary is flagged, both in
+		// the local and the remote documents.
+		var localInfos, remoteInfos []document.OpInfo
+		d1.Subscribe("$.t", func(e document.DocEvent) {
+			localInfos = append(localInfos, e.Operations...)
+		})
+		d2.Subscribe("$.t", func(e document.DocEvent) {
+			remoteInfos = append(remoteInfos, e.Operations...)
+		})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(2, 6, nil, 0)
+			return nil
+		}))
+		syncConcurrently(t, d1, d2)
+
+		assert.Equal(t,
+			`<doc><code_block>a<image src="a.png"></image></code_block></doc>`,
+			d2.Root().GetTree("t").ToXML(),
+		)
+		assert.Len(t, localInfos, 1)
+		assert.ErrorIs(t, localInfos[0].Violation, crdt.ErrTreeSchemaViolation)
+		assert.Len(t, remoteInfos, 1)
+		assert.ErrorIs(t, remoteInfos[0].Violation, crdt.ErrTreeSchemaViolation)
+		assert.ErrorIs(t, d2.Root().GetTree("t").Validate(), crdt.ErrTreeSchemaViolation)
+	})
+}
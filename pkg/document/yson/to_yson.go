@@ -38,6 +38,10 @@ func FromCRDT(elem crdt.Element) (interface{}, error) {
 		return toText(elem), nil
 	case *crdt.Tree:
 		return toTree(elem), nil
+	case *crdt.Set:
+		return toSet(elem), nil
+	case *crdt.MVRegister:
+		return toRegister(elem), nil
 	default:
 		return nil, fmt.Errorf("%v: %w", reflect.TypeOf(elem), ErrUnsupported)
 	}
@@ -73,8 +77,11 @@ func toPrimitive(primitive *crdt.Primitive) interface{} {
 
 func toCounter(counter *crdt.Counter) (Counter, error) {
 	return Counter{
-		Type:  counter.ValueType(),
-		Value: counter.Value(),
+		Type:       counter.ValueType(),
+		Value:      counter.Value(),
+		Bounded:    counter.IsBounded(),
+		LowerBound: counter.LowerBound(),
+		Reset:      counter.IsReset(),
 	}, nil
 }
 
@@ -96,34 +103,66 @@ func toText(text *crdt.Text) Text {
 			Attributes: attrs,
 		})
 	}
-	return Text{Nodes: nodes}
-}
-
-func toTree(crdtTree *crdt.Tree) Tree {
-	var buildTreeNode func(crdtNode *crdt.TreeNode) TreeNode
-	buildTreeNode = func(crdtNode *crdt.TreeNode) TreeNode {
-		var attrs map[string]string
-		if crdtNode.Attrs != nil && crdtNode.Attrs.Len() > 0 {
-			attrs = crdtNode.Attrs.Elements()
-		}
 
-		node := TreeNode{
-			Type:       crdtNode.Type(),
-			Attributes: attrs,
-			Value:      crdtNode.Value,
+	var marks []TextMark
+	for _, mark := range text.Marks() {
+		from, to := text.MarkRange(mark)
+		if from == to {
+			continue
 		}
 
-		for _, child := range crdtNode.Children() {
-			node.Children = append(node.Children, buildTreeNode(child))
-		}
+		marks = append(marks, TextMark{
+			From:      from,
+			To:        to,
+			Key:       mark.Key(),
+			Value:     mark.Value(),
+			Expand:    mark.Expand(),
+			Mergeable: mark.Mergeable(),
+			Removed:   mark.Removed(),
+		})
+	}
+	return Text{Nodes: nodes, Marks: marks}
+}
 
-		return node
+func toSet(set *crdt.Set) Set {
+	elements := Set{}
+	for _, elem := range set.Elements() {
+		elements = append(elements, toPrimitive(elem))
 	}
+	return elements
+}
 
-	// Start building from the root node
-	root := buildTreeNode(crdtTree.Root())
+func toRegister(register *crdt.MVRegister) Register {
+	values := Register{}
+	for _, value := range register.Values() {
+		values = append(values, toPrimitive(value))
+	}
+	return values
+}
 
+func toTree(crdtTree *crdt.Tree) Tree {
 	return Tree{
-		Root: root,
+		Root: FromTreeNode(crdtTree.Root()),
+	}
+}
+
+// FromTreeNode converts a CRDT tree node and its live descendants to a YSON
+// tree node.
+func FromTreeNode(crdtNode *crdt.TreeNode) TreeNode {
+	var attrs map[string]string
+	if crdtNode.Attrs != nil && crdtNode.Attrs.Len() > 0 {
+		attrs = crdtNode.Attrs.Elements()
 	}
+
+	node := TreeNode{
+		Type:       crdtNode.Type(),
+		Attributes: attrs,
+		Value:      crdtNode.Value,
+	}
+
+	for _, child := range crdtNode.Children() {
+		node.Children = append(node.Children, FromTreeNode(child))
+	}
+
+	return node
 }
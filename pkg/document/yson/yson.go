@@ -67,6 +67,13 @@ type Element interface {
 type Counter struct {
 	Type  crdt.CounterType
 	Value interface{} // counter value (int32 for IntegerCnt, int64 for LongCnt)
+
+	// Bounded is whether the counter has the lower bound.
+	Bounded    bool
+	LowerBound int64
+
+	// Reset is whether the initial value of the counter has been reset.
+	Reset bool
 }
 
 // Array represents an array CRDT value.
@@ -107,16 +114,55 @@ type TextNode struct {
 	Attributes map[string]string
 }
 
+// TextMark represents a mark of the text. From and To are the indexes of the
+// live characters covered by the mark.
+type TextMark struct {
+	From int
+	To   int
+
+	Key   string
+	Value string
+
+	// Expand is the expand behavior of the mark.
+	Expand crdt.MarkExpand
+
+	// Mergeable is whether the mark is merged with the marks of the same key.
+	Mergeable bool
+
+	// Removed is whether the mark removes the earlier marks of the same key.
+	Removed bool
+}
+
 // Text represents a text CRDT value.
 type Text struct {
 	Nodes []TextNode
+
+	// Marks is the marks of the text in the order they were applied.
+	Marks []TextMark
+}
+
+// markExpandNames is the names of the expand behaviors of marks in YSON.
+var markExpandNames = map[crdt.MarkExpand]string{
+	crdt.MarkExpandNone:   "none",
+	crdt.MarkExpandBefore: "before",
+	crdt.MarkExpandAfter:  "after",
+	crdt.MarkExpandBoth:   "both",
 }
 
-func (y Object) isElement()  {}
-func (y Array) isElement()   {}
-func (y Counter) isElement() {}
-func (y Text) isElement()    {}
-func (y Tree) isElement()    {}
+// Set represents a set CRDT value. Its elements are primitive values.
+type Set []interface{}
+
+// Register represents a multi-value register CRDT value. Its elements are the
+// primitive values assigned concurrently.
+type Register []interface{}
+
+func (y Object) isElement()   {}
+func (y Array) isElement()    {}
+func (y Counter) isElement()  {}
+func (y Text) isElement()     {}
+func (y Tree) isElement()     {}
+func (y Set) isElement()      {}
+func (y Register) isElement() {}
 
 // marshalElement marshals any element type
 func marshalElement(elem interface{}) (string, error) {
@@ -185,14 +231,25 @@ func (y Array) Marshal() (string, error) {
 }
 
 func (y Counter) Marshal() (string, error) {
+	var value string
 	switch y.Type {
 	case crdt.IntegerCnt:
-		return fmt.Sprintf("Counter(Int(%v))", y.Value), nil
+		value = fmt.Sprintf("Int(%v)", y.Value)
 	case crdt.LongCnt:
-		return fmt.Sprintf("Counter(Long(%v))", y.Value), nil
+		value = fmt.Sprintf("Long(%v)", y.Value)
 	default:
 		return "", fmt.Errorf("marshal counter: %w", ErrUnsupported)
 	}
+	if !y.Bounded && !y.Reset {
+		return fmt.Sprintf("Counter(%s)", value), nil
+	}
+
+	// NOTE: If the counter is bounded or reset, the value is wrapped in an
+	// object with the lower bound and the reset state.
+	if y.Bounded {
+		return fmt.Sprintf(`Counter({"value":%s,"lowerBound":%d})`, value, y.LowerBound), nil
+	}
+	return fmt.Sprintf(`Counter({"value":%s,"reset":true})`, value), nil
 }
 
 func (y Text) Marshal() (string, error) {
@@ -210,13 +267,62 @@ func (y Text) Marshal() (string, error) {
 		sort.Strings(attrs)
 		nodes = append(nodes, fmt.Sprintf(`{"val":%s,"attrs":{%s}}`, strconv.Quote(node.Value), strings.Join(attrs, ",")))
 	}
-	return fmt.Sprintf("Text([%s])", strings.Join(nodes, ",")), nil
+	if len(y.Marks) == 0 {
+		return fmt.Sprintf("Text([%s])", strings.Join(nodes, ",")), nil
+	}
+
+	// NOTE: If there are marks, the nodes and the marks are wrapped in an
+	// object to keep the format of the text without marks.
+	var marks []string
+	for _, mark := range y.Marks {
+		expand, ok := markExpandNames[mark.Expand]
+		if !ok {
+			return "", fmt.Errorf("marshal text mark: %w", ErrUnsupported)
+		}
+
+		marks = append(marks, fmt.Sprintf(
+			`{"from":%d,"to":%d,"key":%s,"val":%s,"expand":%s,"mergeable":%v,"removed":%v}`,
+			mark.From, mark.To, strconv.Quote(mark.Key), strconv.Quote(mark.Value),
+			strconv.Quote(expand), mark.Mergeable, mark.Removed,
+		))
+	}
+	return fmt.Sprintf(
+		`Text({"nodes":[%s],"marks":[%s]})`,
+		strings.Join(nodes, ","),
+		strings.Join(marks, ","),
+	), nil
 }
 
 func (y Tree) Marshal() (string, error) {
 	return fmt.Sprintf("Tree(%s)", y.Root.Marshal()), nil
 }
 
+func (y Set) Marshal() (string, error) {
+	var elements []string
+	for _, elem := range y {
+		marshalled, err := marshalPrimitive(elem)
+		if err != nil {
+			return "", err
+		}
+
+		elements = append(elements, marshalled)
+	}
+	return fmt.Sprintf("Set([%s])", strings.Join(elements, ",")), nil
+}
+
+func (y Register) Marshal() (string, error) {
+	var elements []string
+	for _, elem := range y {
+		marshalled, err := marshalPrimitive(elem)
+		if err != nil {
+			return "", err
+		}
+
+		elements = append(elements, marshalled)
+	}
+	return fmt.Sprintf("Register([%s])", strings.Join(elements, ",")), nil
+}
+
 func (n *TreeNode) Marshal() string {
 	if n.Type == "text" {
 		return fmt.Sprintf(`{"type":%s,"value":%s}`, strconv.Quote(n.Type), strconv.Quote(n.Value))
@@ -294,16 +400,42 @@ func Unmarshal(data string, elem Element) error {
 			return fmt.Errorf("unmarshal text: %w", ErrInvalidYSON)
 		}
 
-		if v, ok := text["value"].([]interface{}); ok {
-			parsed, err := parseText(v)
+		parsed, err := parseText(text["value"])
+		if err != nil {
+			return err
+		}
+		*e = parsed
+
+	case *Set:
+		set, ok := raw.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unmarshal set: %w", ErrInvalidYSON)
+		}
+
+		if v, ok := set["value"].([]interface{}); ok {
+			parsed, err := parseSet(v)
 			if err != nil {
 				return err
 			}
 			*e = parsed
 		} else {
-			return fmt.Errorf("unmarshal text: %w", ErrInvalidYSON)
+			return fmt.Errorf("unmarshal set: %w", ErrInvalidYSON)
+		}
+	case *Register:
+		register, ok := raw.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unmarshal register: %w", ErrInvalidYSON)
 		}
 
+		if v, ok := register["value"].([]interface{}); ok {
+			parsed, err := parseSet(v)
+			if err != nil {
+				return err
+			}
+			*e = Register(parsed)
+		} else {
+			return fmt.Errorf("unmarshal register: %w", ErrInvalidYSON)
+		}
 	case *Counter:
 		counter, err := parseCounter(raw.(map[string]interface{}))
 		if err != nil {
@@ -351,10 +483,21 @@ func parseTypedValue(raw map[string]interface{}) (interface{}, error) {
 
 		return nil, fmt.Errorf("parse counter: %w", ErrInvalidYSON)
 	case "Text":
+		return parseText(raw["value"])
+	case "Set":
+		if value, ok := raw["value"].([]interface{}); ok {
+			return parseSet(value)
+		}
+		return nil, fmt.Errorf("parse set: %w", ErrInvalidYSON)
+	case "Register":
 		if value, ok := raw["value"].([]interface{}); ok {
-			return parseText(value)
+			set, err := parseSet(value)
+			if err != nil {
+				return nil, err
+			}
+			return Register(set), nil
 		}
-		return nil, fmt.Errorf("parse text: %w", ErrInvalidYSON)
+		return nil, fmt.Errorf("parse register: %w", ErrInvalidYSON)
 	}
 
 	return nil, ErrUnsupported
@@ -428,32 +571,71 @@ func parseArray(raw []interface{}) (Array, error) {
 
 func parseCounter(raw map[string]interface{}) (Counter, error) {
 	counter := Counter{}
-	if value, ok := raw["value"].(map[string]interface{}); ok {
-		if t, ok := value["type"].(string); ok {
-			switch t {
-			case "Int":
-				counter.Type = crdt.IntegerCnt
-				counter.Value = int32(value["value"].(float64))
-			case "Long":
-				counter.Type = crdt.LongCnt
-				counter.Value = int64(value["value"].(float64))
-			default:
-				return Counter{}, fmt.Errorf("parse counter type: %w", ErrUnsupported)
-			}
-		} else {
+	value, ok := raw["value"].(map[string]interface{})
+	if !ok {
+		return Counter{}, fmt.Errorf("parse counter value: %w", ErrUnsupported)
+	}
+
+	// NOTE: The value of the bounded or reset counter is wrapped in an object
+	// with the lower bound and the reset state.
+	if _, ok := value["type"]; !ok {
+		if lowerBound, ok := value["lowerBound"].(float64); ok {
+			counter.Bounded = true
+			counter.LowerBound = int64(lowerBound)
+		}
+		counter.Reset, _ = value["reset"].(bool)
+
+		if value, ok = value["value"].(map[string]interface{}); !ok {
+			return Counter{}, fmt.Errorf("parse counter value: %w", ErrUnsupported)
+		}
+	}
+
+	if t, ok := value["type"].(string); ok {
+		switch t {
+		case "Int":
+			counter.Type = crdt.IntegerCnt
+			counter.Value = int32(value["value"].(float64))
+		case "Long":
+			counter.Type = crdt.LongCnt
+			counter.Value = int64(value["value"].(float64))
+		default:
 			return Counter{}, fmt.Errorf("parse counter type: %w", ErrUnsupported)
 		}
 	} else {
-		return Counter{}, fmt.Errorf("parse counter value: %w", ErrUnsupported)
+		return Counter{}, fmt.Errorf("parse counter type: %w", ErrUnsupported)
 	}
 	return counter, nil
 }
 
-func parseText(raw []interface{}) (Text, error) {
+// parseText parses the value of the text. The value is either the array of
+// the nodes or the object of the nodes and the marks.
+func parseText(raw interface{}) (Text, error) {
 	var text Text
 
-	for _, node := range raw {
-		n := node.(map[string]interface{})
+	var nodes []interface{}
+	switch v := raw.(type) {
+	case []interface{}:
+		nodes = v
+	case map[string]interface{}:
+		var ok bool
+		if nodes, ok = v["nodes"].([]interface{}); !ok {
+			return text, fmt.Errorf("parse text: %w", ErrInvalidYSON)
+		}
+
+		marks, err := parseTextMarks(v["marks"])
+		if err != nil {
+			return text, err
+		}
+		text.Marks = marks
+	default:
+		return text, fmt.Errorf("parse text: %w", ErrInvalidYSON)
+	}
+
+	for _, node := range nodes {
+		n, ok := node.(map[string]interface{})
+		if !ok {
+			return text, fmt.Errorf("parse text node: %w", ErrInvalidYSON)
+		}
 		textNode := TextNode{}
 
 		if val, ok := n["val"].(string); !ok {
@@ -474,6 +656,71 @@ func parseText(raw []interface{}) (Text, error) {
 	return text, nil
 }
 
+func parseTextMarks(raw interface{}) ([]TextMark, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("parse text marks: %w", ErrInvalidYSON)
+	}
+
+	var marks []TextMark
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("parse text mark: %w", ErrInvalidYSON)
+		}
+
+		from, fromOK := m["from"].(float64)
+		to, toOK := m["to"].(float64)
+		key, keyOK := m["key"].(string)
+		if !fromOK || !toOK || !keyOK {
+			return nil, fmt.Errorf("parse text mark: %w", ErrInvalidYSON)
+		}
+
+		mark := TextMark{From: int(from), To: int(to), Key: key}
+		mark.Value, _ = m["val"].(string)
+		mark.Mergeable, _ = m["mergeable"].(bool)
+		mark.Removed, _ = m["removed"].(bool)
+
+		name, _ := m["expand"].(string)
+		found := false
+		for expand, expandName := range markExpandNames {
+			if expandName == name {
+				mark.Expand, found = expand, true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("parse text mark expand: %w", ErrInvalidYSON)
+		}
+
+		marks = append(marks, mark)
+	}
+	return marks, nil
+}
+
+func parseSet(raw []interface{}) (Set, error) {
+	set := Set{}
+	for _, item := range raw {
+		switch v := item.(type) {
+		case map[string]interface{}:
+			val, err := parseTypedValue(v)
+			if err != nil {
+				return nil, err
+			}
+			set = append(set, val)
+		case []interface{}:
+			return nil, fmt.Errorf("parse set element: %w", ErrInvalidYSON)
+		default:
+			set = append(set, v)
+		}
+	}
+	return set, nil
+}
+
 func parseTree(raw map[string]interface{}) (Tree, error) {
 	root, err := parseTreeNode(raw)
 	if err != nil {
@@ -529,11 +776,15 @@ func preprocessTypeValues(data string) string {
 		// Process empty constructors first
 		{`Text()`, `{"type":"Text","value":[]}`},
 		{`Tree()`, `{"type":"Tree","value":{}}`},
+		{`Set()`, `{"type":"Set","value":[]}`},
+		{`Register()`, `{"type":"Register","value":[]}`},
 
 		// Process constructors with values
 		{`Counter(`, `{"type":"Counter","value":`},
 		{`Text(`, `{"type":"Text","value":`},
 		{`Tree(`, `{"type":"Tree","value":`},
+		{`Set(`, `{"type":"Set","value":`},
+		{`Register(`, `{"type":"Register","value":`},
 		{`Int(`, `{"type":"Int","value":`},
 		{`Long(`, `{"type":"Long","value":`},
 		{`BinData("`, `{"type":"BinData","value":"`},
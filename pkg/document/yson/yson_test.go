@@ -116,6 +116,92 @@ func TestYSONConversion(t *testing.T) {
 		assert.Equal(t, prevMarshalled, newMarshalled)
 	})
 
+	t.Run("text with marks conversion test", func(t *testing.T) {
+		doc := document.New("yson")
+		err := doc.Update(func(r *json.Object, p *presence.Presence) error {
+			text := r.SetNewText("k1").Edit(0, 0, "Hello World")
+			text.Mark(0, 5, "bold", "true", crdt.MarkExpandAfter)
+			text.Unmark(2, 4, "bold", crdt.MarkExpandNone)
+			text.MarkWithID(6, 11, "comment", "c1", crdt.MarkExpandNone)
+			text.Edit(7, 8, "")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		root, err := yson.FromCRDT(doc.RootObject())
+		assert.NoError(t, err)
+		assert.Len(t, root.(yson.Object)["k1"].(yson.Text).Marks, 3)
+
+		marshalled, err := root.(yson.Object).Marshal()
+		assert.NoError(t, err)
+		parsed := yson.Object{}
+		assert.NoError(t, yson.Unmarshal(marshalled, &parsed))
+
+		newDoc := document.New("yson")
+		err = newDoc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetYSON(parsed)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		// NOTE: The IDs of the non-mergeable marks are issued again, so the
+		// marks are compared by YSON which does not have the IDs.
+		newRoot, err := yson.FromCRDT(newDoc.RootObject())
+		assert.NoError(t, err)
+		assert.Equal(t, root, newRoot)
+
+		// The mark still expands to the text inserted at its end.
+		assert.NoError(t, newDoc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.GetText("k1").Edit(5, 5, "!")
+			return nil
+		}))
+		assert.Contains(
+			t,
+			newDoc.Root().GetText("k1").Marshal(),
+			`{"attrs":{"bold":"true"},"val":"He"},{"val":"ll"},{"attrs":{"bold":"true"},"val":"o!"}`,
+		)
+	})
+
+	t.Run("bounded and reset counter conversion test", func(t *testing.T) {
+		doc := document.New("yson")
+		err := doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetNewBoundedCounter("bounded", crdt.IntegerCnt, 10, 0).Increase(-3)
+			r.SetNewCounter("reset", crdt.LongCnt, 5).Reset().Increase(2)
+			r.SetNewArray("arr").AddNewBoundedCounter(crdt.LongCnt, 7, 2)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		root, err := yson.FromCRDT(doc.RootObject())
+		assert.NoError(t, err)
+		marshalled, err := root.(yson.Object).Marshal()
+		assert.NoError(t, err)
+		parsed := yson.Object{}
+		assert.NoError(t, yson.Unmarshal(marshalled, &parsed))
+
+		newDoc := document.New("yson")
+		err = newDoc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetYSON(parsed)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), newDoc.Marshal())
+
+		bounded := newDoc.Root().GetCounter("bounded")
+		assert.True(t, bounded.IsBounded())
+		assert.Equal(t, int64(0), bounded.LowerBound())
+		assert.True(t, newDoc.Root().GetArray("arr").GetCounter(0).IsBounded())
+
+		reset := newDoc.Root().GetCounter("reset")
+		assert.False(t, reset.IsBounded())
+		assert.True(t, reset.IsReset())
+		assert.Equal(t, int64(2), reset.Value())
+
+		newRoot, err := yson.FromCRDT(newDoc.RootObject())
+		assert.NoError(t, err)
+		assert.Equal(t, root, newRoot)
+	})
+
 	t.Run("array with nested types test", func(t *testing.T) {
 		doc := document.New("nested-types")
 
@@ -335,6 +421,19 @@ func TestYSONMarshal(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 
+	t.Run("bounded and reset counter marshal/unmarshal test", func(t *testing.T) {
+		initialRoot := yson.Object{
+			"bounded": yson.Counter{Type: crdt.IntegerCnt, Value: int32(10), Bounded: true, LowerBound: -5},
+			"reset":   yson.Counter{Type: crdt.LongCnt, Value: int64(3), Reset: true},
+		}
+		marshalled, err := initialRoot.Marshal()
+		assert.NoError(t, err)
+
+		actualRoot := yson.Object{}
+		assert.NoError(t, yson.Unmarshal(marshalled, &actualRoot))
+		assert.Equal(t, initialRoot, actualRoot)
+	})
+
 	t.Run("text marshal/unmarshal test", func(t *testing.T) {
 		text := yson.Text{
 			Nodes: []yson.TextNode{
@@ -367,6 +466,25 @@ func TestYSONMarshal(t *testing.T) {
 		assert.Equal(t, initialRoot, actualRoot)
 	})
 
+	t.Run("text with marks marshal/unmarshal test", func(t *testing.T) {
+		initialRoot := yson.Object{
+			"text": yson.Text{
+				Nodes: []yson.TextNode{{Value: "Hello World"}},
+				Marks: []yson.TextMark{
+					{From: 0, To: 5, Key: "bold", Value: "true", Expand: crdt.MarkExpandAfter, Mergeable: true},
+					{From: 2, To: 4, Key: "bold", Expand: crdt.MarkExpandNone, Mergeable: true, Removed: true},
+					{From: 6, To: 11, Key: "comment", Value: "c1", Expand: crdt.MarkExpandNone},
+				},
+			},
+		}
+		marshalled, err := initialRoot.Marshal()
+		assert.NoError(t, err)
+
+		actualRoot := yson.Object{}
+		assert.NoError(t, yson.Unmarshal(marshalled, &actualRoot))
+		assert.Equal(t, initialRoot, actualRoot)
+	})
+
 	t.Run("tree marshal/unmarshal test", func(t *testing.T) {
 		tree := yson.Tree{
 			Root: yson.TreeNode{
@@ -574,6 +574,49 @@ func (n *Node[V]) RemoveChild(child *Node[V]) error {
 	return nil
 }
 
+// DetachChild detaches the given child with its descendants from this node.
+// Unlike RemoveChild, it also updates the size of the ancestors.
+func (n *Node[V]) DetachChild(child *Node[V]) error {
+	if err := n.RemoveChild(child); err != nil {
+		return err
+	}
+
+	if !child.Value.IsRemoved() {
+		n.updateSizeBy(-child.PaddedLength())
+	}
+
+	return nil
+}
+
+// AttachChild attaches the given child with its descendants at the given
+// offset. Unlike InsertAt, it keeps the size of the ancestors if the child is
+// removed.
+func (n *Node[V]) AttachChild(child *Node[V], offset int) error {
+	if err := n.insertAtInternal(child, offset); err != nil {
+		return err
+	}
+
+	if !child.Value.IsRemoved() {
+		n.updateSizeBy(child.PaddedLength())
+	}
+
+	return nil
+}
+
+// updateSizeBy updates the size of this node and its ancestors by the given
+// delta. The ancestors of a removed node are not updated.
+func (n *Node[V]) updateSizeBy(delta int) {
+	node := n
+	for node != nil {
+		node.Length += delta
+		if node.Value.IsRemoved() {
+			break
+		}
+
+		node = node.Parent
+	}
+}
+
 // InsertBefore inserts the given node before the given child.
 func (n *Node[V]) InsertBefore(newNode, referenceNode *Node[V]) error {
 	if n.IsText() {
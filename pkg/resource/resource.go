@@ -27,3 +27,20 @@ type DataSize struct {
 	Data int
 	Meta int
 }
+
+// Total returns the total size of the data and the meta in bytes.
+func (s DataSize) Total() int {
+	return s.Data + s.Meta
+}
+
+// Add adds the given size to this size.
+func (s *DataSize) Add(other DataSize) {
+	s.Data += other.Data
+	s.Meta += other.Meta
+}
+
+// Sub subtracts the given size from this size.
+func (s *DataSize) Sub(other DataSize) {
+	s.Data -= other.Data
+	s.Meta -= other.Meta
+}
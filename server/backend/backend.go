@@ -24,10 +24,12 @@ import (
 	"fmt"
 	"os"
 
+	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/hashicorp/golang-lru/v2/expirable"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/cluster"
+	"github.com/yorkie-team/yorkie/pkg/document"
 	pkgtypes "github.com/yorkie-team/yorkie/pkg/types"
 	pkgwebhook "github.com/yorkie-team/yorkie/pkg/webhook"
 	"github.com/yorkie-team/yorkie/server/backend/background"
@@ -54,6 +56,10 @@ type Backend struct {
 	// AuthWebhookClient is used to send auth webhook.
 	AuthWebhookClient *pkgwebhook.Client[types.AuthWebhookRequest, types.AuthWebhookResponse]
 
+	// DocumentCache is used to cache the documents built to validate the
+	// pushed changes. It is nil if the cache is disabled.
+	DocumentCache *lru.Cache[string, *document.InternalDocument]
+
 	// ClusterClient is used to send requests to nodes in the cluster.
 	ClusterClient *cluster.Client
 
@@ -131,9 +137,16 @@ func New(
 		return nil, err
 	}
 
-	// 04. Create pubsub, and lockers.
+	// 04. Create pubsub, lockers, and the document cache.
 	lockers := sync.New()
 	pubsub := pubsub.New()
+	var documentCache *lru.Cache[string, *document.InternalDocument]
+	if conf.DocumentCacheSize > 0 {
+		documentCache, err = lru.New[string, *document.InternalDocument](conf.DocumentCacheSize)
+		if err != nil {
+			return nil, fmt.Errorf("create document cache: %w", err)
+		}
+	}
 
 	// 05. Create the background instance. The background instance is used to
 	// manage background tasks.
@@ -201,6 +214,7 @@ func New(
 		AuthWebhookCache:    authWebhookCache,
 		AuthWebhookClient:   authWebhookClient,
 		EventWebhookManager: eventWebhookManger,
+		DocumentCache:       documentCache,
 
 		ClusterClient: clusterClient,
 
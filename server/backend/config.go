@@ -91,6 +91,10 @@ type Config struct {
 	// ProjectCacheTTL is the TTL value to set when caching the project metadata.
 	ProjectCacheTTL string `yaml:"ProjectCacheTTL"`
 
+	// DocumentCacheSize is the cache size of the documents built to validate
+	// the pushed changes. If it is zero, the documents are not cached.
+	DocumentCacheSize int `yaml:"DocumentCacheSize"`
+
 	// Hostname is yorkie server hostname. hostname is used by metrics.
 	Hostname string `yaml:"Hostname"`
 
@@ -55,6 +55,14 @@ type ClientDocInfo struct {
 	Status    string `bson:"status"`
 	ServerSeq int64  `bson:"server_seq"`
 	ClientSeq uint32 `bson:"client_seq"`
+
+	// ReadOnly is whether the document is attached in read-only mode. The
+	// changes from the read-only attachment are rejected.
+	ReadOnly bool `bson:"read_only"`
+
+	// PullOnly is whether the document is attached in pull-only mode. The
+	// changes from the pull-only attachment are rejected.
+	PullOnly bool `bson:"pull_only"`
 }
 
 // ClientDocInfoMap is a map that associates DocRefKey with ClientDocInfo instances.
@@ -215,6 +223,40 @@ func (i *ClientInfo) ServerSeq(
 	return i.Documents[docID].ServerSeq, nil
 }
 
+// SetReadOnly makes the given attached document read-only.
+func (i *ClientInfo) SetReadOnly(docID types.ID) error {
+	if err := i.EnsureDocumentAttached(docID); err != nil {
+		return err
+	}
+
+	i.Documents[docID].ReadOnly = true
+	i.UpdatedAt = gotime.Now()
+
+	return nil
+}
+
+// IsReadOnly returns whether the given document is attached in read-only mode.
+func (i *ClientInfo) IsReadOnly(docID types.ID) bool {
+	return i.hasDocument(docID) && i.Documents[docID].ReadOnly
+}
+
+// SetPullOnly makes the given attached document pull-only.
+func (i *ClientInfo) SetPullOnly(docID types.ID) error {
+	if err := i.EnsureDocumentAttached(docID); err != nil {
+		return err
+	}
+
+	i.Documents[docID].PullOnly = true
+	i.UpdatedAt = gotime.Now()
+
+	return nil
+}
+
+// IsPullOnly returns whether the given document is attached in pull-only mode.
+func (i *ClientInfo) IsPullOnly(docID types.ID) bool {
+	return i.hasDocument(docID) && i.Documents[docID].PullOnly
+}
+
 // EnsureActivated ensures the client is activated.
 func (i *ClientInfo) EnsureActivated() error {
 	if i.Status != ClientActivated {
@@ -272,6 +314,8 @@ func (i *ClientInfo) DeepCopy() *ClientInfo {
 			Status:    docInfo.Status,
 			ServerSeq: docInfo.ServerSeq,
 			ClientSeq: docInfo.ClientSeq,
+			ReadOnly:  docInfo.ReadOnly,
+			PullOnly:  docInfo.PullOnly,
 		}
 	}
 
@@ -162,4 +162,52 @@ func TestClientInfo(t *testing.T) {
 		err = clientInfo.EnsureDocumentsNotAttachedWhenDeactivated()
 		assert.Equal(t, database.ErrAttachedDocumentExists, err)
 	})
+
+	t.Run("read-only document test", func(t *testing.T) {
+		clientInfo := database.ClientInfo{
+			Status: database.ClientActivated,
+		}
+
+		err := clientInfo.SetReadOnly(dummyDocID)
+		assert.ErrorIs(t, err, database.ErrDocumentNotAttached)
+
+		err = clientInfo.AttachDocument(dummyDocID, false)
+		assert.NoError(t, err)
+		assert.False(t, clientInfo.IsReadOnly(dummyDocID))
+
+		err = clientInfo.SetReadOnly(dummyDocID)
+		assert.NoError(t, err)
+		assert.True(t, clientInfo.IsReadOnly(dummyDocID))
+		assert.True(t, clientInfo.DeepCopy().IsReadOnly(dummyDocID))
+
+		err = clientInfo.DetachDocument(dummyDocID)
+		assert.NoError(t, err)
+		err = clientInfo.AttachDocument(dummyDocID, false)
+		assert.NoError(t, err)
+		assert.False(t, clientInfo.IsReadOnly(dummyDocID))
+	})
+
+	t.Run("pull-only document test", func(t *testing.T) {
+		clientInfo := database.ClientInfo{
+			Status: database.ClientActivated,
+		}
+
+		err := clientInfo.SetPullOnly(dummyDocID)
+		assert.ErrorIs(t, err, database.ErrDocumentNotAttached)
+
+		err = clientInfo.AttachDocument(dummyDocID, false)
+		assert.NoError(t, err)
+		assert.False(t, clientInfo.IsPullOnly(dummyDocID))
+
+		err = clientInfo.SetPullOnly(dummyDocID)
+		assert.NoError(t, err)
+		assert.True(t, clientInfo.IsPullOnly(dummyDocID))
+		assert.True(t, clientInfo.DeepCopy().IsPullOnly(dummyDocID))
+
+		err = clientInfo.DetachDocument(dummyDocID)
+		assert.NoError(t, err)
+		err = clientInfo.AttachDocument(dummyDocID, false)
+		assert.NoError(t, err)
+		assert.False(t, clientInfo.IsPullOnly(dummyDocID))
+	})
 }
@@ -20,6 +20,7 @@ package database
 import (
 	"context"
 	"errors"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
@@ -268,6 +269,15 @@ type Database interface {
 		to int64,
 	) ([]*ChangeInfo, error)
 
+	// FindServerSeqByTime returns the server sequence of the latest change
+	// created at or before the given time. It returns 0 if there is no such
+	// change.
+	FindServerSeqByTime(
+		ctx context.Context,
+		docRefKey types.DocRefKey,
+		at gotime.Time,
+	) (int64, error)
+
 	// CreateSnapshotInfo stores the snapshot of the given document.
 	CreateSnapshotInfo(
 		ctx context.Context,
@@ -298,6 +308,13 @@ type Database interface {
 		versionVector time.VersionVector,
 	) (time.VersionVector, error)
 
+	// FindVersionVectorInfosByDocRefKey returns the version vectors of the
+	// clients attached to the given document.
+	FindVersionVectorInfosByDocRefKey(
+		ctx context.Context,
+		docRefKey types.DocRefKey,
+	) ([]*VersionVectorInfo, error)
+
 	// FindDocInfosByPaging returns the documentInfos of the given paging.
 	FindDocInfosByPaging(
 		ctx context.Context,
@@ -20,6 +20,7 @@ package memory
 import (
 	"context"
 	"fmt"
+	"math"
 	gotime "time"
 
 	"github.com/hashicorp/go-memdb"
@@ -696,6 +697,8 @@ func (d *DB) UpdateClientInfoAfterPushPull(
 			ServerSeq: serverSeq,
 			ClientSeq: clientSeq,
 			Status:    clientDocInfo.Status,
+			ReadOnly:  clientDocInfo.ReadOnly,
+			PullOnly:  clientDocInfo.PullOnly,
 		}
 		loaded.UpdatedAt = gotime.Now()
 	}
@@ -1070,6 +1073,7 @@ func (d *DB) CreateChangeInfos(
 			Message:        cn.Message(),
 			Operations:     encodedOperations,
 			PresenceChange: cn.PresenceChange(),
+			CreatedAt:      gotime.Now(),
 		}); err != nil {
 			return fmt.Errorf("create change: %w", err)
 		}
@@ -1128,13 +1132,24 @@ func (d *DB) CompactChangeInfos(
 	txn := d.db.Txn(true)
 	defer txn.Abort()
 
+	// NOTE: The compacted change keeps the creation time of the last change
+	// so that the document can be still found by time.
+	var createdAt gotime.Time
+	raw, err := txn.First(tblChanges, "doc_id_server_seq", docInfo.ID.String(), lastServerSeq)
+	if err != nil {
+		return fmt.Errorf("find change: %w", err)
+	}
+	if raw != nil {
+		createdAt = raw.(*database.ChangeInfo).CreatedAt
+	}
+
 	// 1. Purge the resources of the document.
 	if _, err := d.purgeDocumentInternals(ctx, projectID, docInfo.ID, txn); err != nil {
 		return err
 	}
 
 	// 2. Store compacted change and update document
-	raw, err := txn.First(
+	raw, err = txn.First(
 		tblDocuments,
 		"project_id_id",
 		projectID.String(),
@@ -1177,6 +1192,7 @@ func (d *DB) CompactChangeInfos(
 			Message:        cn.Message(),
 			Operations:     encodedOperations,
 			PresenceChange: cn.PresenceChange(),
+			CreatedAt:      createdAt,
 		}); err != nil {
 			return fmt.Errorf("store change: %w", err)
 		}
@@ -1284,6 +1300,41 @@ func (d *DB) FindChangeInfosBetweenServerSeqs(
 	return infos, nil
 }
 
+// FindServerSeqByTime returns the server sequence of the latest change created
+// at or before the given time.
+func (d *DB) FindServerSeqByTime(
+	_ context.Context,
+	docRefKey types.DocRefKey,
+	at gotime.Time,
+) (int64, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.ReverseLowerBound(
+		tblChanges,
+		"doc_id_server_seq",
+		docRefKey.DocID.String(),
+		int64(math.MaxInt64),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("fetch changes of %s: %w", docRefKey, err)
+	}
+
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.ChangeInfo)
+		if info.DocID != docRefKey.DocID {
+			break
+		}
+		// NOTE: The changes stored before CreatedAt was introduced are
+		// regarded as created at the beginning.
+		if !info.CreatedAt.After(at) {
+			return info.ServerSeq, nil
+		}
+	}
+
+	return 0, nil
+}
+
 // CreateSnapshotInfo stores the snapshot of the given document.
 func (d *DB) CreateSnapshotInfo(
 	_ context.Context,
@@ -1486,6 +1537,36 @@ func (d *DB) UpdateAndFindMinVersionVector(
 	return minVersionVector, nil
 }
 
+// FindVersionVectorInfosByDocRefKey returns the version vectors of the
+// clients attached to the given document.
+func (d *DB) FindVersionVectorInfosByDocRefKey(
+	_ context.Context,
+	docRefKey types.DocRefKey,
+) ([]*database.VersionVectorInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.Get(tblVersionVectors, "doc_id", docRefKey.DocID.String())
+	if err != nil {
+		return nil, fmt.Errorf("find version vectors of %s: %w", docRefKey, err)
+	}
+
+	var infos []*database.VersionVectorInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.VersionVectorInfo)
+		infos = append(infos, &database.VersionVectorInfo{
+			ID:            info.ID,
+			ProjectID:     info.ProjectID,
+			DocID:         info.DocID,
+			ClientID:      info.ClientID,
+			VersionVector: info.VersionVector.DeepCopy(),
+			ServerSeq:     info.ServerSeq,
+		})
+	}
+
+	return infos, nil
+}
+
 // FindDocInfosByPaging returns the documentInfos of the given paging.
 func (d *DB) FindDocInfosByPaging(
 	_ context.Context,
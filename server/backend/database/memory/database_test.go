@@ -64,6 +64,10 @@ func TestDB(t *testing.T) {
 		testcases.RunFindChangeInfosBetweenServerSeqsTest(t, db, projectID)
 	})
 
+	t.Run("RunFindServerSeqByTimeTest test", func(t *testing.T) {
+		testcases.RunFindServerSeqByTimeTest(t, db, projectID)
+	})
+
 	t.Run("RunFindLatestChangeInfoTest test", func(t *testing.T) {
 		testcases.RunFindLatestChangeInfoTest(t, db, projectID)
 	})
@@ -120,6 +124,10 @@ func TestDB(t *testing.T) {
 		testcases.RunFindClientInfosByAttachedDocRefKeyTest(t, db, projectID)
 	})
 
+	t.Run("FindVersionVectorInfosByDocRefKey test", func(t *testing.T) {
+		testcases.RunFindVersionVectorInfosByDocRefKeyTest(t, db, projectID)
+	})
+
 	t.Run("RunPurgeDocument test", func(t *testing.T) {
 		testcases.RunPurgeDocument(t, db, projectID)
 	})
@@ -188,6 +188,8 @@ func (c *Client) ensureDefaultProjectInfo(
 			"client_deactivate_threshold":  candidate.ClientDeactivateThreshold,
 			"max_subscribers_per_document": candidate.MaxSubscribersPerDocument,
 			"max_attachments_per_document": candidate.MaxAttachmentsPerDocument,
+			"max_document_size":            candidate.MaxDocumentSize,
+			"max_change_size":              candidate.MaxChangeSize,
 			"public_key":                   candidate.PublicKey,
 			"secret_key":                   candidate.SecretKey,
 			"created_at":                   candidate.CreatedAt,
@@ -666,8 +668,10 @@ func (c *Client) UpdateClientInfoAfterPushPull(
 			clientDocInfoKey(docInfo.ID, "client_seq"): clientDocInfo.ClientSeq,
 		},
 		"$set": bson.M{
-			clientDocInfoKey(docInfo.ID, StatusKey): clientDocInfo.Status,
-			"updated_at":                            clientInfo.UpdatedAt,
+			clientDocInfoKey(docInfo.ID, StatusKey):   clientDocInfo.Status,
+			clientDocInfoKey(docInfo.ID, "read_only"): clientDocInfo.ReadOnly,
+			clientDocInfoKey(docInfo.ID, "pull_only"): clientDocInfo.PullOnly,
+			"updated_at": clientInfo.UpdatedAt,
 		},
 	}
 
@@ -682,7 +686,9 @@ func (c *Client) UpdateClientInfoAfterPushPull(
 				clientDocInfoKey(docInfo.ID, "server_seq"): 0,
 				clientDocInfoKey(docInfo.ID, "client_seq"): 0,
 				clientDocInfoKey(docInfo.ID, StatusKey):    clientDocInfo.Status,
-				"updated_at":                               clientInfo.UpdatedAt,
+				clientDocInfoKey(docInfo.ID, "read_only"):  false,
+				clientDocInfoKey(docInfo.ID, "pull_only"):  false,
+				"updated_at": clientInfo.UpdatedAt,
 			},
 		}
 	}
@@ -1026,6 +1032,7 @@ func (c *Client) CreateChangeInfos(
 ) error {
 	docRefKey := docInfo.RefKey()
 
+	now := gotime.Now()
 	var models []mongo.WriteModel
 	for _, cn := range changes {
 		encodedOperations, err := database.EncodeOperations(cn.Operations())
@@ -1045,6 +1052,7 @@ func (c *Client) CreateChangeInfos(
 			"message":         cn.Message(),
 			"operations":      encodedOperations,
 			"presence_change": cn.PresenceChange(),
+			"created_at":      now,
 		}}).SetUpsert(true))
 	}
 
@@ -1060,7 +1068,6 @@ func (c *Client) CreateChangeInfos(
 		}
 	}
 
-	now := gotime.Now()
 	updateFields := bson.M{
 		"server_seq": docInfo.ServerSeq,
 	}
@@ -1103,6 +1110,24 @@ func (c *Client) CompactChangeInfos(
 	lastServerSeq int64,
 	changes []*change.Change,
 ) error {
+	// NOTE: The compacted change keeps the creation time of the last change
+	// so that the document can be still found by time.
+	var createdAt gotime.Time
+	result := c.collection(ColChanges).FindOne(ctx, bson.M{
+		"project_id": projectID,
+		"doc_id":     docInfo.ID,
+		"server_seq": lastServerSeq,
+	})
+	if result.Err() == nil {
+		lastInfo := &database.ChangeInfo{}
+		if err := result.Decode(lastInfo); err != nil {
+			return fmt.Errorf("decode change: %w", err)
+		}
+		createdAt = lastInfo.CreatedAt
+	} else if result.Err() != mongo.ErrNoDocuments {
+		return fmt.Errorf("find change: %w", result.Err())
+	}
+
 	// 1. Purge the resources of the document.
 	if _, err := c.purgeDocumentInternals(ctx, projectID, docInfo.ID); err != nil {
 		return err
@@ -1133,6 +1158,7 @@ func (c *Client) CompactChangeInfos(
 			"message":         cn.Message(),
 			"operations":      encodedOperations,
 			"presence_change": cn.PresenceChange(),
+			"created_at":      createdAt,
 		}); err != nil {
 			return fmt.Errorf("store change: %w", err)
 		}
@@ -1248,6 +1274,42 @@ func (c *Client) FindChangeInfosBetweenServerSeqs(
 	return infos, nil
 }
 
+// FindServerSeqByTime returns the server sequence of the latest change created
+// at or before the given time.
+func (c *Client) FindServerSeqByTime(
+	ctx context.Context,
+	docRefKey types.DocRefKey,
+	at gotime.Time,
+) (int64, error) {
+	option := options.FindOne().SetSort(bson.M{
+		"server_seq": -1,
+	})
+
+	result := c.collection(ColChanges).FindOne(ctx, bson.M{
+		"project_id": docRefKey.ProjectID,
+		"doc_id":     docRefKey.DocID,
+		// NOTE: The changes stored before created_at was introduced are
+		// regarded as created at the beginning.
+		"$or": bson.A{
+			bson.M{"created_at": bson.M{"$lte": at}},
+			bson.M{"created_at": bson.M{"$exists": false}},
+		},
+	}, option)
+	if result.Err() == mongo.ErrNoDocuments {
+		return 0, nil
+	}
+	if result.Err() != nil {
+		return 0, fmt.Errorf("find change: %w", result.Err())
+	}
+
+	changeInfo := &database.ChangeInfo{}
+	if err := result.Decode(changeInfo); err != nil {
+		return 0, fmt.Errorf("decode change: %w", err)
+	}
+
+	return changeInfo.ServerSeq, nil
+}
+
 // CreateSnapshotInfo stores the snapshot of the given document.
 func (c *Client) CreateSnapshotInfo(
 	ctx context.Context,
@@ -1404,6 +1466,28 @@ func (c *Client) UpdateAndFindMinVersionVector(
 	return time.MinVersionVector(vectors...), nil
 }
 
+// FindVersionVectorInfosByDocRefKey returns the version vectors of the
+// clients attached to the given document.
+func (c *Client) FindVersionVectorInfosByDocRefKey(
+	ctx context.Context,
+	docRefKey types.DocRefKey,
+) ([]*database.VersionVectorInfo, error) {
+	cursor, err := c.collection(ColVersionVectors).Find(ctx, bson.M{
+		"project_id": docRefKey.ProjectID,
+		"doc_id":     docRefKey.DocID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("find version vectors: %w", err)
+	}
+
+	var infos []*database.VersionVectorInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		return nil, fmt.Errorf("decode version vectors: %w", err)
+	}
+
+	return infos, nil
+}
+
 // updateVersionVector updates the given version vector of the given client
 func (c *Client) updateVersionVector(
 	ctx context.Context,
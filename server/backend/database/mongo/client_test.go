@@ -80,6 +80,10 @@ func TestClient(t *testing.T) {
 		testcases.RunFindChangeInfosBetweenServerSeqsTest(t, cli, dummyProjectID)
 	})
 
+	t.Run("RunFindServerSeqByTimeTest test", func(t *testing.T) {
+		testcases.RunFindServerSeqByTimeTest(t, cli, dummyProjectID)
+	})
+
 	t.Run("RunFindLatestChangeInfoTest test", func(t *testing.T) {
 		testcases.RunFindLatestChangeInfoTest(t, cli, dummyProjectID)
 	})
@@ -136,4 +140,8 @@ func TestClient(t *testing.T) {
 	t.Run("FindClientInfosByAttachedDocRefKey test", func(t *testing.T) {
 		testcases.RunFindClientInfosByAttachedDocRefKeyTest(t, cli, dummyProjectID)
 	})
+
+	t.Run("FindVersionVectorInfosByDocRefKey test", func(t *testing.T) {
+		testcases.RunFindVersionVectorInfosByDocRefKeyTest(t, cli, dummyProjectID)
+	})
 }
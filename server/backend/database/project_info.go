@@ -75,9 +75,19 @@ type ProjectInfo struct {
 	// If it is 0, there is no limit.
 	MaxAttachmentsPerDocument int `bson:"max_attachments_per_document"`
 
+	// MaxDocumentSize is the maximum size of a document in bytes.
+	MaxDocumentSize int `bson:"max_document_size"`
+
+	// MaxChangeSize is the maximum size in bytes that a single change can add
+	// to a document.
+	MaxChangeSize int `bson:"max_change_size"`
+
 	// AllowedOrigins is the list of allowed origins.
 	AllowedOrigins []string `bson:"allowed_origins"`
 
+	// Schema is the schema that the root of documents should conform to.
+	Schema string `bson:"schema"`
+
 	// CreatedAt is the time when the project was created.
 	CreatedAt time.Time `bson:"created_at"`
 
@@ -93,6 +103,8 @@ func NewProjectInfo(name string, owner types.ID, clientDeactivateThreshold strin
 		ClientDeactivateThreshold: clientDeactivateThreshold,
 		MaxSubscribersPerDocument: 0,
 		MaxAttachmentsPerDocument: 0,
+		MaxDocumentSize:           0,
+		MaxChangeSize:             0,
 		PublicKey:                 shortuuid.New(),
 		SecretKey:                 shortuuid.New(),
 		CreatedAt:                 time.Now(),
@@ -118,7 +130,10 @@ func (i *ProjectInfo) DeepCopy() *ProjectInfo {
 		ClientDeactivateThreshold: i.ClientDeactivateThreshold,
 		MaxSubscribersPerDocument: i.MaxSubscribersPerDocument,
 		MaxAttachmentsPerDocument: i.MaxAttachmentsPerDocument,
+		MaxDocumentSize:           i.MaxDocumentSize,
+		MaxChangeSize:             i.MaxChangeSize,
 		AllowedOrigins:            i.AllowedOrigins,
+		Schema:                    i.Schema,
 		CreatedAt:                 i.CreatedAt,
 		UpdatedAt:                 i.UpdatedAt,
 	}
@@ -150,9 +165,18 @@ func (i *ProjectInfo) UpdateFields(fields *types.UpdatableProjectFields) {
 	if fields.MaxAttachmentsPerDocument != nil {
 		i.MaxAttachmentsPerDocument = *fields.MaxAttachmentsPerDocument
 	}
+	if fields.MaxDocumentSize != nil {
+		i.MaxDocumentSize = *fields.MaxDocumentSize
+	}
+	if fields.MaxChangeSize != nil {
+		i.MaxChangeSize = *fields.MaxChangeSize
+	}
 	if fields.AllowedOrigins != nil {
 		i.AllowedOrigins = *fields.AllowedOrigins
 	}
+	if fields.Schema != nil {
+		i.Schema = *fields.Schema
+	}
 }
 
 // ToProject converts the ProjectInfo to the Project.
@@ -168,7 +192,10 @@ func (i *ProjectInfo) ToProject() *types.Project {
 		ClientDeactivateThreshold: i.ClientDeactivateThreshold,
 		MaxSubscribersPerDocument: i.MaxSubscribersPerDocument,
 		MaxAttachmentsPerDocument: i.MaxAttachmentsPerDocument,
+		MaxDocumentSize:           i.MaxDocumentSize,
+		MaxChangeSize:             i.MaxChangeSize,
 		AllowedOrigins:            i.AllowedOrigins,
+		Schema:                    i.Schema,
 		PublicKey:                 i.PublicKey,
 		SecretKey:                 i.SecretKey,
 		CreatedAt:                 i.CreatedAt,
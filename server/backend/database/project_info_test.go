@@ -70,5 +70,20 @@ func TestProjectInfo(t *testing.T) {
 			MaxAttachmentsPerDocument: &testMaxAttachmentsPerDocument,
 		})
 		assert.Equal(t, testMaxAttachmentsPerDocument, project.MaxAttachmentsPerDocument)
+
+		testMaxDocumentSize, testMaxChangeSize := 1024, 128
+		project.UpdateFields(&types.UpdatableProjectFields{
+			MaxDocumentSize: &testMaxDocumentSize,
+			MaxChangeSize:   &testMaxChangeSize,
+		})
+		assert.Equal(t, testMaxDocumentSize, project.ToProject().MaxDocumentSize)
+		assert.Equal(t, testMaxChangeSize, project.ToProject().MaxChangeSize)
+
+		testSchema := `{"type": "object"}`
+		project.UpdateFields(&types.UpdatableProjectFields{
+			Schema: &testSchema,
+		})
+		assert.Equal(t, testSchema, project.Schema)
+		assert.Equal(t, testSchema, project.ToProject().Schema)
 	})
 }
@@ -343,6 +343,111 @@ func RunFindChangesBetweenServerSeqsTest(
 	})
 }
 
+// RunFindServerSeqByTimeTest runs the FindServerSeqByTime test for the given db.
+func RunFindServerSeqByTimeTest(
+	t *testing.T,
+	db database.Database,
+	projectID types.ID,
+) {
+	t.Run("find server seq by time test", func(t *testing.T) {
+		ctx := context.Background()
+
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), map[string]string{"userID": t.Name()})
+		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, clientInfo.RefKey(), docKey, true)
+		docRefKey := docInfo.RefKey()
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+		doc := document.New(key.Key(t.Name()))
+		doc.SetActor(actorID)
+		for idx := 0; idx < 5; idx++ {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k", idx)
+				return nil
+			}))
+		}
+		pack := doc.CreateChangePack()
+		for idx, c := range pack.Changes {
+			c.SetServerSeq(int64(idx + 1))
+		}
+
+		before := gotime.Now().Add(-gotime.Second)
+
+		// Store the first three changes and then the rest after a while.
+		assert.NoError(t, db.CreateChangeInfos(ctx, projectID, docInfo, 0, pack.Changes[:3], false))
+		gotime.Sleep(10 * gotime.Millisecond)
+		middle := gotime.Now()
+		gotime.Sleep(10 * gotime.Millisecond)
+		assert.NoError(t, db.CreateChangeInfos(ctx, projectID, docInfo, 0, pack.Changes[3:], false))
+
+		serverSeq, err := db.FindServerSeqByTime(ctx, docRefKey, before)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), serverSeq)
+
+		serverSeq, err = db.FindServerSeqByTime(ctx, docRefKey, middle)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), serverSeq)
+
+		serverSeq, err = db.FindServerSeqByTime(ctx, docRefKey, gotime.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), serverSeq)
+	})
+
+	t.Run("find server seq by time after compaction test", func(t *testing.T) {
+		ctx := context.Background()
+
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), map[string]string{"userID": t.Name()})
+		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, clientInfo.RefKey(), docKey, true)
+		docRefKey := docInfo.RefKey()
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+		doc := document.New(key.Key(t.Name()))
+		doc.SetActor(actorID)
+		for idx := 0; idx < 3; idx++ {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k", idx)
+				return nil
+			}))
+		}
+		pack := doc.CreateChangePack()
+		for _, c := range pack.Changes {
+			c.SetServerSeq(docInfo.IncreaseServerSeq())
+		}
+		assert.NoError(t, db.CreateChangeInfos(ctx, projectID, docInfo, 0, pack.Changes, false))
+		gotime.Sleep(10 * gotime.Millisecond)
+		at := gotime.Now()
+		gotime.Sleep(10 * gotime.Millisecond)
+
+		// The compacted change keeps the creation time of the last change.
+		compacted := document.New(key.Key(t.Name()))
+		compacted.SetActor(actorID)
+		assert.NoError(t, compacted.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("k", 2)
+			return nil
+		}))
+		assert.NoError(t, db.CompactChangeInfos(
+			ctx,
+			projectID,
+			docInfo,
+			docInfo.ServerSeq,
+			compacted.CreateChangePack().Changes,
+		))
+
+		serverSeq, err := db.FindServerSeqByTime(ctx, docRefKey, at)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), serverSeq)
+	})
+}
+
 // RunFindChangeInfosBetweenServerSeqsTest runs the FindChangeInfosBetweenServerSeqs test for the given db.
 func RunFindChangeInfosBetweenServerSeqsTest(
 	t *testing.T,
@@ -1261,6 +1366,54 @@ func RunUpdateClientInfoAfterPushPullTest(t *testing.T, db database.Database, pr
 		assert.NoError(t, err)
 	})
 
+	t.Run("read-only document attach test", func(t *testing.T) {
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), map[string]string{"userID": t.Name()})
+		assert.NoError(t, err)
+
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+		docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, clientInfo.RefKey(), docKey, true)
+		assert.NoError(t, err)
+
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
+		assert.NoError(t, clientInfo.SetReadOnly(docInfo.ID))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+
+		result, err := db.FindClientInfoByRefKey(ctx, clientInfo.RefKey())
+		assert.NoError(t, err)
+		assert.True(t, result.IsReadOnly(docInfo.ID))
+
+		assert.NoError(t, clientInfo.DetachDocument(docInfo.ID))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+
+		result, err = db.FindClientInfoByRefKey(ctx, clientInfo.RefKey())
+		assert.NoError(t, err)
+		assert.False(t, result.IsReadOnly(docInfo.ID))
+	})
+
+	t.Run("pull-only document attach test", func(t *testing.T) {
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), map[string]string{"userID": t.Name()})
+		assert.NoError(t, err)
+
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+		docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, clientInfo.RefKey(), docKey, true)
+		assert.NoError(t, err)
+
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
+		assert.NoError(t, clientInfo.SetPullOnly(docInfo.ID))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+
+		result, err := db.FindClientInfoByRefKey(ctx, clientInfo.RefKey())
+		assert.NoError(t, err)
+		assert.True(t, result.IsPullOnly(docInfo.ID))
+
+		assert.NoError(t, clientInfo.DetachDocument(docInfo.ID))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+
+		result, err = db.FindClientInfoByRefKey(ctx, clientInfo.RefKey())
+		assert.NoError(t, err)
+		assert.False(t, result.IsPullOnly(docInfo.ID))
+	})
+
 	t.Run("update server_seq and client_seq in clientInfo test", func(t *testing.T) {
 		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), map[string]string{"userID": t.Name()})
 		assert.NoError(t, err)
@@ -1686,6 +1839,60 @@ func RunFindClientInfosByAttachedDocRefKeyTest(t *testing.T, db database.Databas
 	})
 }
 
+// RunFindVersionVectorInfosByDocRefKeyTest runs the FindVersionVectorInfosByDocRefKey test for the given db.
+func RunFindVersionVectorInfosByDocRefKeyTest(t *testing.T, db database.Database, projectID types.ID) {
+	t.Run("FindVersionVectorInfosByDocRefKey test", func(t *testing.T) {
+		ctx := context.Background()
+
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+
+		clientInfo1, _ := db.ActivateClient(ctx, projectID, t.Name(), map[string]string{"userID": t.Name()})
+		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, clientInfo1.RefKey(), docKey, true)
+		docRefKey := docInfo.RefKey()
+		assert.NoError(t, clientInfo1.AttachDocument(docRefKey.DocID, false))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo1, docInfo))
+
+		clientInfo2, _ := db.ActivateClient(ctx, projectID, t.Name()+"2", map[string]string{"userID": t.Name() + "2"})
+		assert.NoError(t, clientInfo2.AttachDocument(docRefKey.DocID, false))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo2, docInfo))
+
+		actorID1, _ := clientInfo1.ID.ToActorID()
+		actorID2, _ := clientInfo2.ID.ToActorID()
+		vector1 := time.NewVersionVector()
+		vector1.Set(actorID1, 3)
+		vector2 := time.NewVersionVector()
+		vector2.Set(actorID1, 1)
+		vector2.Set(actorID2, 2)
+
+		// 01. Store the version vectors of the attached clients.
+		_, err := db.UpdateAndFindMinVersionVector(ctx, clientInfo1, docRefKey, vector1)
+		assert.NoError(t, err)
+		_, err = db.UpdateAndFindMinVersionVector(ctx, clientInfo2, docRefKey, vector2)
+		assert.NoError(t, err)
+
+		infos, err := db.FindVersionVectorInfosByDocRefKey(ctx, docRefKey)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 2)
+		vectors := map[types.ID]time.VersionVector{}
+		for _, info := range infos {
+			vectors[info.ClientID] = info.VersionVector
+		}
+		assert.Equal(t, vector1, vectors[clientInfo1.ID])
+		assert.Equal(t, vector2, vectors[clientInfo2.ID])
+
+		// 02. The version vector of the detached client is removed.
+		assert.NoError(t, clientInfo1.DetachDocument(docRefKey.DocID))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo1, docInfo))
+		_, err = db.UpdateAndFindMinVersionVector(ctx, clientInfo1, docRefKey, vector1)
+		assert.NoError(t, err)
+
+		infos, err = db.FindVersionVectorInfosByDocRefKey(ctx, docRefKey)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Equal(t, clientInfo2.ID, infos[0].ClientID)
+	})
+}
+
 // RunPurgeDocument runs the RunPurgeDocument tests for the given db.
 func RunPurgeDocument(t *testing.T, db database.Database, projectID types.ID) {
 	t.Run("PurgeDocument test", func(t *testing.T) {
@@ -86,6 +86,8 @@ const (
 	DefaultProjectCacheSize = 256
 	DefaultProjectCacheTTL  = 10 * time.Minute
 
+	DefaultDocumentCacheSize = 128
+
 	DefaultHostname    = ""
 	DefaultGatewayAddr = "localhost:8080"
 )
@@ -248,6 +250,10 @@ func (c *Config) ensureDefaultValue() {
 		c.Backend.ProjectCacheTTL = DefaultProjectCacheTTL.String()
 	}
 
+	if c.Backend.DocumentCacheSize == 0 {
+		c.Backend.DocumentCacheSize = DefaultDocumentCacheSize
+	}
+
 	if c.Mongo != nil {
 		if c.Mongo.ConnectionURI == "" {
 			c.Mongo.ConnectionURI = DefaultMongoConnectionURI
@@ -21,10 +21,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/diff"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
@@ -52,6 +54,10 @@ var (
 
 	// ErrDocumentNotRemoved is returned when the document is not removed yet.
 	ErrDocumentNotRemoved = errors.New("document is not removed yet")
+
+	// ErrInvalidStaleThreshold is returned when the given stale threshold is
+	// not a valid duration.
+	ErrInvalidStaleThreshold = errors.New("invalid stale threshold")
 )
 
 // CreateDocument creates a new document with the given key and server sequence.
@@ -269,6 +275,81 @@ func GetDocumentByServerSeq(
 	return doc, nil
 }
 
+// GetDocumentDiff returns the differences of the document between the given
+// server sequences.
+func GetDocumentDiff(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	fromServerSeq int64,
+	toServerSeq int64,
+) ([]*diff.Diff, error) {
+	docInfo, err := be.DB.FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := packs.BuildInternalDocForServerSeq(ctx, be, docInfo, fromServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := packs.BuildInternalDocForServerSeq(ctx, be, docInfo, toServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff.Between(from.Root(), to.Root()), nil
+}
+
+// GetDocumentAtRevision returns the document at the given revision. The
+// revision is the given time if it is not zero, otherwise the given server
+// sequence. A negative server sequence means the latest revision.
+func GetDocumentAtRevision(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	serverSeq int64,
+	at gotime.Time,
+) (*types.DocumentRevision, error) {
+	docInfo, err := be.DB.FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return nil, err
+	}
+
+	if !at.IsZero() {
+		serverSeq, err = be.DB.FindServerSeqByTime(ctx, docInfo.RefKey(), at)
+		if err != nil {
+			return nil, err
+		}
+	} else if serverSeq < 0 {
+		serverSeq = docInfo.ServerSeq
+	}
+
+	if serverSeq > docInfo.ServerSeq {
+		return nil, fmt.Errorf(
+			"server seq %d greater than server seq of '%s' %d: %w",
+			serverSeq,
+			k,
+			docInfo.ServerSeq,
+			packs.ErrInvalidServerSeq,
+		)
+	}
+
+	doc, err := packs.BuildInternalDocForServerSeq(ctx, be, docInfo, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DocumentRevision{
+		Key:       k,
+		ServerSeq: serverSeq,
+		Root:      doc.Marshal(),
+	}, nil
+}
+
 // SearchDocumentSummaries returns document summaries that match the query parameters.
 func SearchDocumentSummaries(
 	ctx context.Context,
@@ -401,6 +482,78 @@ func UpdateDocument(
 	}, nil
 }
 
+// RestoreDocument restores the given document to the given server sequence.
+// The difference between the current root and the root at the server sequence
+// is pushed as a new change, so that the attached clients converge on the
+// restored content without being detached.
+func RestoreDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	serverSeq int64,
+) (*types.DocumentSummary, error) {
+	if serverSeq < 0 || serverSeq > docInfo.ServerSeq {
+		return nil, fmt.Errorf("restore to %d: %w", serverSeq, packs.ErrInvalidServerSeq)
+	}
+
+	revision, err := packs.BuildInternalDocForServerSeq(ctx, be, docInfo, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	clientInfo := &database.ClientInfo{
+		ID:        types.IDFromActorID(time.InitialActorID),
+		ProjectID: project.ID,
+		Documents: map[types.ID]*database.ClientDocInfo{
+			docInfo.ID: {
+				Status:    database.DocumentAttached,
+				ServerSeq: docInfo.ServerSeq,
+				ClientSeq: 0,
+			},
+		},
+	}
+
+	doc, err := packs.BuildDocForCheckpoint(ctx, be, docInfo, change.Checkpoint{
+		ServerSeq: docInfo.ServerSeq,
+		ClientSeq: 0,
+	}, time.InitialActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = doc.Update(func(r *json.Object, p *presence.Presence) error {
+		return restoreObject(r, revision.RootObject())
+	}, fmt.Sprintf("restore to %d", serverSeq)); err != nil {
+		return nil, err
+	}
+
+	if _, err = packs.PushPull(
+		ctx,
+		be,
+		project,
+		clientInfo,
+		docInfo,
+		doc.CreateChangePack(),
+		packs.PushPullOptions{
+			Mode:   types.SyncModePushOnly,
+			Status: document.StatusAttached,
+		}); err != nil {
+		return nil, err
+	}
+
+	return &types.DocumentSummary{
+		ID:              docInfo.ID,
+		Key:             docInfo.Key,
+		AttachedClients: 0,
+		CreatedAt:       docInfo.CreatedAt,
+		AccessedAt:      docInfo.AccessedAt,
+		UpdatedAt:       docInfo.UpdatedAt,
+		Snapshot:        doc.Marshal(),
+		DocSize:         doc.DocSize(),
+	}, nil
+}
+
 // RemoveDocument removes the given document. If force is false, it only removes
 // the document if it is not attached to any client.
 func RemoveDocument(
@@ -462,6 +615,132 @@ func CompactDocument(
 	return nil
 }
 
+// GetDocumentGCStatus returns the status of the garbage collection of the
+// given document.
+func GetDocumentGCStatus(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+) (*types.DocumentGCStatus, error) {
+	doc, err := packs.BuildInternalDocForServerSeq(ctx, be, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	clientInfos, err := be.DB.FindClientInfosByAttachedDocRefKey(ctx, docInfo.RefKey())
+	if err != nil {
+		return nil, err
+	}
+	updatedAts := make(map[types.ID]gotime.Time)
+	for _, info := range clientInfos {
+		updatedAts[info.ID] = info.UpdatedAt
+	}
+
+	vectorInfos, err := be.DB.FindVersionVectorInfosByDocRefKey(ctx, docInfo.RefKey())
+	if err != nil {
+		return nil, err
+	}
+	var vectors []time.VersionVector
+	for _, info := range vectorInfos {
+		vectors = append(vectors, info.VersionVector)
+	}
+	minVector := time.MinVersionVector(vectors...).DeepCopy()
+
+	// NOTE: A client holds back the garbage collection if it has not seen the
+	// latest change of an actor, and the minimum of the actor comes from it.
+	latestVector := doc.VersionVector()
+	var blockers []types.GCBlocker
+	for _, info := range vectorInfos {
+		for actorID, lamport := range minVector {
+			if lamport < latestVector.VersionOf(actorID) && info.VersionVector.VersionOf(actorID) == lamport {
+				blockers = append(blockers, types.GCBlocker{
+					ClientID:      info.ClientID,
+					VersionVector: info.VersionVector,
+					UpdatedAt:     updatedAts[info.ClientID],
+				})
+				break
+			}
+		}
+	}
+
+	return &types.DocumentGCStatus{
+		Key:              docInfo.Key,
+		GarbageLen:       doc.GarbageLen(),
+		DocSize:          doc.DocSize(),
+		MinVersionVector: minVector,
+		AttachedClients:  len(clientInfos),
+		Blockers:         blockers,
+	}, nil
+}
+
+// CollectDocumentGarbage forces the garbage collection of the given document.
+// It detaches the clients that have not been accessed for the given threshold,
+// and compacts the document if no clients remain attached to it.
+func CollectDocumentGarbage(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	staleThreshold gotime.Duration,
+) (*types.DocumentGCResult, error) {
+	clientInfos, err := be.DB.FindClientInfosByAttachedDocRefKey(ctx, docInfo.RefKey())
+	if err != nil {
+		return nil, err
+	}
+
+	// 01. Detach the stale clients from the document.
+	result := &types.DocumentGCResult{}
+	staleAt := gotime.Now().Add(-staleThreshold)
+	for _, info := range clientInfos {
+		if info.UpdatedAt.After(staleAt) {
+			continue
+		}
+
+		actorID, err := info.ID.ToActorID()
+		if err != nil {
+			return nil, err
+		}
+		if err := be.ClusterClient.DetachDocument(
+			ctx,
+			project,
+			actorID,
+			docInfo.ID,
+			project.PublicKey,
+			docInfo.Key,
+		); err != nil {
+			return nil, err
+		}
+		result.DetachedClients = append(result.DetachedClients, info.ID)
+	}
+
+	// 02. Compact the document if no clients remain attached to it. The
+	// document is not compacted again if nothing has changed since the last
+	// compaction.
+	if len(result.DetachedClients) == len(clientInfos) &&
+		(len(result.DetachedClients) > 0 || !isCompacted(docInfo)) {
+		if err := CompactDocument(ctx, be, project, docInfo); err != nil {
+			return nil, err
+		}
+		result.Compacted = true
+	}
+
+	docInfo, err = be.DB.FindDocInfoByRefKey(ctx, docInfo.RefKey())
+	if err != nil {
+		return nil, err
+	}
+	if result.Status, err = GetDocumentGCStatus(ctx, be, docInfo); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// isCompacted returns whether the given document has been compacted and has
+// no changes after the compaction.
+func isCompacted(docInfo *database.DocInfo) bool {
+	return !docInfo.CompactedAt.IsZero() && docInfo.ServerSeq <= 1
+}
+
 // PurgeDocument purges the given document.
 func PurgeDocument(
 	ctx context.Context,
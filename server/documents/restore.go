@@ -0,0 +1,267 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package documents
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"unicode/utf16"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/diff"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/yson"
+)
+
+// container is the proxy of an element that has children, e.g. Object and
+// Array, whose children are accessed by the key of the given type.
+type container[K any] interface {
+	GetObject(key K) *json.Object
+	GetArray(key K) *json.Array
+	GetText(key K) *json.Text
+	GetCounter(key K) *json.Counter
+}
+
+// restoreObject updates the given object to be the same as the target. Only
+// the members that differ from the target are changed.
+func restoreObject(obj *json.Object, target *crdt.Object) error {
+	targetMembers := target.Members()
+	for k := range obj.Members() {
+		if _, ok := targetMembers[k]; !ok {
+			obj.Delete(k)
+		}
+	}
+
+	for k, targetElem := range targetMembers {
+		currentElem := obj.Get(k)
+		if currentElem != nil && currentElem.Marshal() == targetElem.Marshal() {
+			continue
+		}
+
+		if currentElem != nil && currentElem.CreatedAt().Compare(targetElem.CreatedAt()) == 0 {
+			restored, err := restoreInPlace[string](obj, k, currentElem, targetElem)
+			if err != nil {
+				return err
+			}
+			if restored {
+				continue
+			}
+		}
+
+		value, err := yson.FromCRDT(targetElem)
+		if err != nil {
+			return err
+		}
+		obj.SetYSONElement(k, value)
+	}
+
+	return nil
+}
+
+// restoreArray updates the given array to be the same as the target. The
+// elements that do not exist in the target are removed, the ones removed
+// after the target are inserted again and the others are moved to their
+// positions in the target.
+func restoreArray(arr *json.Array, target *crdt.Array) error {
+	targetElems := target.Elements()
+	inTarget := make(map[string]bool, len(targetElems))
+	for _, elem := range targetElems {
+		inTarget[elem.CreatedAt().Key()] = true
+	}
+	for i := arr.Len() - 1; i >= 0; i-- {
+		if !inTarget[arr.Get(i).CreatedAt().Key()] {
+			arr.Delete(i)
+		}
+	}
+
+	// NOTE: The elements before the index are the same as the ones of the
+	// target in each iteration.
+	for i, targetElem := range targetElems {
+		idx := arr.IndexOf(targetElem.CreatedAt())
+		if idx < 0 {
+			value, err := yson.FromCRDT(targetElem)
+			if err != nil {
+				return err
+			}
+			arr.Splice(i, 0, value)
+			continue
+		}
+
+		if idx != i {
+			if i == 0 {
+				arr.MoveFrontByIndex(idx)
+			} else {
+				arr.MoveAfterByIndex(i-1, idx)
+			}
+		}
+
+		currentElem := arr.Get(i)
+		if currentElem.Marshal() == targetElem.Marshal() {
+			continue
+		}
+		restored, err := restoreInPlace[int](arr, i, currentElem, targetElem)
+		if err != nil {
+			return err
+		}
+		if restored {
+			continue
+		}
+
+		value, err := yson.FromCRDT(targetElem)
+		if err != nil {
+			return err
+		}
+		arr.Splice(i, 1, value)
+	}
+
+	return nil
+}
+
+// restoreInPlace updates the given child of the parent to be the same as the
+// target of the same creation time without replacing it. It returns false if
+// the child can not be updated in place, e.g. Tree, whose nodes can not be
+// matched with the ones of the target yet, and the child should be replaced
+// as a whole.
+func restoreInPlace[K any](parent container[K], key K, current, target crdt.Element) (bool, error) {
+	switch target := target.(type) {
+	case *crdt.Object:
+		if _, ok := current.(*crdt.Object); ok {
+			return true, restoreObject(parent.GetObject(key), target)
+		}
+	case *crdt.Array:
+		if _, ok := current.(*crdt.Array); ok {
+			return true, restoreArray(parent.GetArray(key), target)
+		}
+	case *crdt.Text:
+		if _, ok := current.(*crdt.Text); ok {
+			return restoreText(parent.GetText(key), target), nil
+		}
+	case *crdt.Counter:
+		if current, ok := current.(*crdt.Counter); ok {
+			return restoreCounter(parent.GetCounter(key), current, target), nil
+		}
+	}
+
+	return false, nil
+}
+
+// restoreText updates the given text to be the same as the target with the
+// edits and the styles of the differences. It returns false if the text has
+// marks, which are anchored to the characters and can not be restored by the
+// edits.
+func restoreText(text *json.Text, target *crdt.Text) bool {
+	if len(text.Marks()) > 0 || len(target.Marks()) > 0 {
+		return false
+	}
+
+	// NOTE: The ranges of the edits are the indexes of the current text, so
+	// they are applied from the back so as not to shift the others.
+	diffs := diff.BetweenTexts(text.Text, target)
+	for i := len(diffs) - 1; i >= 0; i-- {
+		if diffs[i].Type == diff.TypeEdit {
+			text.Edit(diffs[i].From, diffs[i].To, diffs[i].New)
+		}
+	}
+
+	currentAttrs, targetAttrs := unitAttrs(text.Text), unitAttrs(target)
+	for from := 0; from < len(targetAttrs); {
+		attributes, attributesToRemove := styleOf(currentAttrs[from], targetAttrs[from])
+		to := from + 1
+		for to < len(targetAttrs) {
+			nextAttributes, nextAttributesToRemove := styleOf(currentAttrs[to], targetAttrs[to])
+			if !maps.Equal(attributes, nextAttributes) || !slices.Equal(attributesToRemove, nextAttributesToRemove) {
+				break
+			}
+			to++
+		}
+
+		if len(attributes) > 0 {
+			text.Style(from, to, attributes)
+		}
+		if len(attributesToRemove) > 0 {
+			text.RemoveStyle(from, to, attributesToRemove)
+		}
+		from = to
+	}
+
+	return true
+}
+
+// unitAttrs returns the attributes of each UTF-16 code unit of the text.
+func unitAttrs(text *crdt.Text) []map[string]string {
+	var attrs []map[string]string
+	for _, node := range text.Nodes() {
+		if node.RemovedAt() != nil {
+			continue
+		}
+
+		elements := node.Value().Attrs().Elements()
+		for range utf16.Encode([]rune(node.Value().Value())) {
+			attrs = append(attrs, elements)
+		}
+	}
+	return attrs
+}
+
+// styleOf returns the attributes to set and to remove to change the given
+// attributes to the target ones.
+func styleOf(attrs, targetAttrs map[string]string) (map[string]string, []string) {
+	attributes := make(map[string]string)
+	for key, value := range targetAttrs {
+		if prev, ok := attrs[key]; !ok || prev != value {
+			attributes[key] = value
+		}
+	}
+
+	var attributesToRemove []string
+	for key := range attrs {
+		if _, ok := targetAttrs[key]; !ok {
+			attributesToRemove = append(attributesToRemove, key)
+		}
+	}
+	sort.Strings(attributesToRemove)
+
+	return attributes, attributesToRemove
+}
+
+// restoreCounter increases the given counter by the difference from the
+// target. It returns false if the counter can not be increased to the target,
+// e.g. the bounded counter, whose rights belong to the actors.
+func restoreCounter(counter *json.Counter, current, target *crdt.Counter) bool {
+	if current.IsBounded() || target.IsBounded() || current.ValueType() != target.ValueType() {
+		return false
+	}
+
+	delta := counterValue(target) - counterValue(current)
+	if delta != 0 {
+		counter.Increase(delta)
+	}
+	return true
+}
+
+// counterValue returns the value of the given counter as int64.
+func counterValue(counter *crdt.Counter) int64 {
+	switch value := counter.Value().(type) {
+	case int32:
+		return int64(value)
+	case int64:
+		return value
+	default:
+		return 0
+	}
+}
@@ -85,6 +85,17 @@ func PushPull(
 	initialServerSeq := docInfo.ServerSeq
 
 	// 01. push changes: filter out the changes that are already saved in the database.
+	if opts.Mode == types.SyncModePullOnly ||
+		clientInfo.IsPullOnly(docInfo.ID) ||
+		clientInfo.IsReadOnly(docInfo.ID) {
+		if err := ensureNoNewChanges(clientInfo, docInfo, reqPack); err != nil {
+			return nil, err
+		}
+	}
+	validatedDoc, err := validateChanges(ctx, be, project, clientInfo, docInfo, reqPack)
+	if err != nil {
+		return nil, err
+	}
 	cpAfterPush, pushedChanges := pushChanges(ctx, clientInfo, docInfo, reqPack, initialServerSeq)
 	hostname := be.Config.Hostname
 	be.Metrics.AddPushPullReceivedChanges(hostname, project, reqPack.ChangesLen())
@@ -128,6 +139,9 @@ func PushPull(
 			return nil, err
 		}
 	}
+	if validatedDoc != nil {
+		cacheDocForValidation(be, docInfo, validatedDoc)
+	}
 
 	if !clientInfo.IsServerClient() {
 		if err := be.DB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo); err != nil {
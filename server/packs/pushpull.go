@@ -23,6 +23,7 @@ import (
 
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
@@ -33,6 +34,10 @@ var (
 	// ErrInvalidServerSeq is returned when the given server seq greater than
 	// the initial server seq.
 	ErrInvalidServerSeq = errors.New("invalid server seq")
+
+	// ErrPushNotAllowed is returned when the changes are pushed in the
+	// pull-only mode or from the read-only attachment.
+	ErrPushNotAllowed = errors.New("push is not allowed")
 )
 
 // pushChanges returns the changes excluding already saved in DB.
@@ -79,6 +84,183 @@ func pushChanges(
 	return cp, pushedChanges
 }
 
+// ensureNoNewChanges returns an error wrapping ErrPushNotAllowed if the given
+// pack has changes that are not saved yet. The changes already saved are
+// allowed, because the client can resend them when switching the sync mode.
+func ensureNoNewChanges(
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+) error {
+	cp := clientInfo.Checkpoint(docInfo.ID)
+	for _, cn := range reqPack.Changes {
+		if cn.ID().ClientSeq() > cp.ClientSeq {
+			return fmt.Errorf("push change of client seq %d: %w", cn.ID().ClientSeq(), ErrPushNotAllowed)
+		}
+	}
+
+	return nil
+}
+
+// validateChanges validates the changes to be pushed by replaying them on a
+// clone of the document. It returns an error wrapping
+// document.ErrDocumentSizeExceeded or document.ErrChangeSizeExceeded if a
+// change exceeds the size limit of the project, and an error wrapping
+// schema.ErrSchemaViolation if the result does not conform to the schema.
+//
+// If the changes are validated, it returns the document with the changes
+// applied, so that it can be cached after the changes are stored.
+func validateChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+) (*document.InternalDocument, error) {
+	sizeLimit := document.SizeLimit{
+		MaxDocumentSize: project.MaxDocumentSize,
+		MaxChangeSize:   project.MaxChangeSize,
+	}
+	if (!project.HasSchema() && !sizeLimit.IsEnabled()) || reqPack.OperationsLen() == 0 {
+		return nil, nil
+	}
+
+	cp := clientInfo.Checkpoint(docInfo.ID)
+	var changes []*change.Change
+	for _, cn := range reqPack.Changes {
+		if cn.ID().ClientSeq() > cp.ClientSeq {
+			changes = append(changes, cn)
+		}
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	doc, err := loadDocForValidation(ctx, be, docInfo)
+	if err != nil {
+		return nil, err
+	}
+	apply := func(changes ...*change.Change) error {
+		return doc.ApplyChangePack(change.NewPack(
+			docInfo.Key,
+			doc.Checkpoint().NextServerSeq(docInfo.ServerSeq),
+			changes,
+			nil,
+			nil,
+		), true)
+	}
+
+	if sizeLimit.IsEnabled() {
+		// NOTE: The changes are applied one by one to check the size that
+		// each of them adds to the document.
+		size := doc.DocSize()
+		for _, cn := range changes {
+			if err := apply(cn); err != nil {
+				return nil, err
+			}
+
+			next := doc.DocSize()
+			if err := sizeLimit.Validate(size, next); err != nil {
+				return nil, fmt.Errorf("push '%s' of '%s': %w", docInfo.Key, clientInfo.Key, err)
+			}
+			size = next
+		}
+	} else if err := apply(changes...); err != nil {
+		return nil, err
+	}
+
+	if !project.HasSchema() {
+		return doc, nil
+	}
+
+	s, err := project.DocumentSchema()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Validate(doc.RootObject()); err != nil {
+		return nil, fmt.Errorf("validate '%s' of '%s': %w", docInfo.Key, clientInfo.Key, err)
+	}
+
+	return doc, nil
+}
+
+// loadDocForValidation returns the document of the given docInfo to validate
+// the pushed changes. It reuses the cached document and applies the changes
+// stored after it instead of building the document from the snapshot.
+func loadDocForValidation(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+) (*document.InternalDocument, error) {
+	if be.DocumentCache == nil {
+		return BuildInternalDocForServerSeq(ctx, be, docInfo, docInfo.ServerSeq)
+	}
+
+	// NOTE: The cached document is removed while the changes are validated,
+	// because the changes are applied to it. It is cached again only after
+	// the changes are stored.
+	cacheKey := documentCacheKey(docInfo)
+	doc, ok := be.DocumentCache.Get(cacheKey)
+	if !ok || doc.Checkpoint().ServerSeq > docInfo.ServerSeq {
+		return BuildInternalDocForServerSeq(ctx, be, docInfo, docInfo.ServerSeq)
+	}
+	be.DocumentCache.Remove(cacheKey)
+
+	if doc.Checkpoint().ServerSeq == docInfo.ServerSeq {
+		return doc, nil
+	}
+
+	changes, err := be.DB.FindChangesBetweenServerSeqs(
+		ctx,
+		docInfo.RefKey(),
+		doc.Checkpoint().ServerSeq+1,
+		docInfo.ServerSeq,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := doc.ApplyChangePack(change.NewPack(
+		docInfo.Key,
+		doc.Checkpoint().NextServerSeq(docInfo.ServerSeq),
+		changes,
+		nil,
+		nil,
+	), be.Config.SnapshotDisableGC); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// cacheDocForValidation caches the given document that the pushed changes
+// are applied to, so that the next push reuses it.
+func cacheDocForValidation(be *backend.Backend, docInfo *database.DocInfo, doc *document.InternalDocument) {
+	if be.DocumentCache == nil {
+		return
+	}
+
+	// NOTE: The document is marked with the server sequence after the changes
+	// are stored, so that the changes stored after it are applied next time.
+	if err := doc.ApplyChangePack(change.NewPack(
+		docInfo.Key,
+		doc.Checkpoint().NextServerSeq(docInfo.ServerSeq),
+		nil,
+		nil,
+		nil,
+	), true); err != nil {
+		return
+	}
+	be.DocumentCache.Add(documentCacheKey(docInfo), doc)
+}
+
+// documentCacheKey returns the key of the document cache. The time of the
+// compaction is included, because the compaction rewrites the changes and
+// the server sequence of the document.
+func documentCacheKey(docInfo *database.DocInfo) string {
+	return fmt.Sprintf("%s:%s:%d", docInfo.ProjectID, docInfo.ID, docInfo.CompactedAt.UnixNano())
+}
+
 func pullPack(
 	ctx context.Context,
 	be *backend.Backend,
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	gotime "time"
 
 	"connectrpc.com/connect"
 
@@ -406,6 +407,69 @@ func (s *adminServer) GetSnapshotMeta(
 	}), nil
 }
 
+// GetDocumentAtRevision gets the document at the given server sequence or time.
+func (s *adminServer) GetDocumentAtRevision(
+	ctx context.Context,
+	req *connect.Request[api.GetDocumentAtRevisionRequest],
+) (*connect.Response[api.GetDocumentAtRevisionResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var at gotime.Time
+	if req.Msg.Timestamp != nil {
+		at = req.Msg.Timestamp.AsTime()
+	}
+
+	revision, err := documents.GetDocumentAtRevision(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.Msg.DocumentKey),
+		req.Msg.ServerSeq,
+		at,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.GetDocumentAtRevisionResponse{
+		Root:      revision.Root,
+		ServerSeq: revision.ServerSeq,
+	}), nil
+}
+
+// GetDocumentDiff gets the differences of the document between the given
+// server sequences.
+func (s *adminServer) GetDocumentDiff(
+	ctx context.Context,
+	req *connect.Request[api.GetDocumentDiffRequest],
+) (*connect.Response[api.GetDocumentDiffResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs, err := documents.GetDocumentDiff(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.Msg.DocumentKey),
+		req.Msg.FromServerSeq,
+		req.Msg.ToServerSeq,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.GetDocumentDiffResponse{
+		Diffs: converter.ToDocumentDiffs(diffs),
+	}), nil
+}
+
 // ListDocuments lists documents.
 func (s *adminServer) ListDocuments(
 	ctx context.Context,
@@ -520,6 +584,146 @@ func (s *adminServer) UpdateDocument(
 	}), nil
 }
 
+// RestoreDocument restores the document to the given server sequence.
+func (s *adminServer) RestoreDocument(
+	ctx context.Context,
+	req *connect.Request[api.RestoreDocumentRequest],
+) (*connect.Response[api.RestoreDocumentResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	docInfo, err := documents.FindDocInfoByKey(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.Msg.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	locker, err := s.backend.Lockers.Locker(ctx, packs.DocEditKey(project.ID, docInfo.Key))
+	if err != nil {
+		return nil, err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+	}()
+
+	doc, err := documents.RestoreDocument(
+		ctx,
+		s.backend,
+		project,
+		docInfo,
+		req.Msg.ServerSeq,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.RestoreDocumentResponse{
+		Document: converter.ToDocumentSummary(doc),
+	}), nil
+}
+
+// GetDocumentGCStatus returns the status of the garbage collection of the
+// document.
+func (s *adminServer) GetDocumentGCStatus(
+	ctx context.Context,
+	req *connect.Request[api.GetDocumentGCStatusRequest],
+) (*connect.Response[api.GetDocumentGCStatusResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	docInfo, err := documents.FindDocInfoByKey(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.Msg.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := documents.GetDocumentGCStatus(ctx, s.backend, docInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	pbStatus, err := converter.ToDocumentGCStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.GetDocumentGCStatusResponse{
+		Status: pbStatus,
+	}), nil
+}
+
+// CollectDocumentGarbage detaches the stale clients from the document and
+// compacts the document if no clients remain attached.
+func (s *adminServer) CollectDocumentGarbage(
+	ctx context.Context,
+	req *connect.Request[api.CollectDocumentGarbageRequest],
+) (*connect.Response[api.CollectDocumentGarbageResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := req.Msg.StaleThreshold
+	if threshold == "" {
+		threshold = project.ClientDeactivateThreshold
+	}
+	staleThreshold, err := gotime.ParseDuration(threshold)
+	if err != nil || staleThreshold < 0 {
+		return nil, fmt.Errorf("parse %q: %w", threshold, documents.ErrInvalidStaleThreshold)
+	}
+
+	docInfo, err := documents.FindDocInfoByKey(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.Msg.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := documents.CollectDocumentGarbage(ctx, s.backend, project, docInfo, staleThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	pbStatus, err := converter.ToDocumentGCStatus(result.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	var detachedClientIDs []string
+	for _, id := range result.DetachedClients {
+		detachedClientIDs = append(detachedClientIDs, id.String())
+	}
+
+	return connect.NewResponse(&api.CollectDocumentGarbageResponse{
+		DetachedClientIds: detachedClientIDs,
+		Compacted:         result.Compacted,
+		Status:            pbStatus,
+	}), nil
+}
+
 // RemoveDocumentByAdmin removes the document of the given key.
 func (s *adminServer) RemoveDocumentByAdmin(
 	ctx context.Context,
@@ -22,6 +22,7 @@ import (
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/projects"
 	"github.com/yorkie-team/yorkie/server/rpc/metadata"
@@ -59,3 +60,19 @@ func VerifyAccess(ctx context.Context, be *backend.Backend, accessInfo *types.Ac
 		accessInfo,
 	)
 }
+
+// VerifyAttachAccess verifies the access for attaching the document of the
+// given pack. The document attached in read-only mode is verified only for
+// types.Read, and the others for types.ReadWrite, so that the document granted
+// only the read by the webhook can be attached only in read-only mode.
+func VerifyAttachAccess(ctx context.Context, be *backend.Backend, pack *change.Pack, readOnly bool) error {
+	verb := types.ReadWrite
+	if readOnly {
+		verb = types.Read
+	}
+
+	return VerifyAccess(ctx, be, &types.AccessInfo{
+		Method:     types.AttachDocument,
+		Attributes: types.NewAccessAttributes([]key.Key{pack.DocumentKey}, verb),
+	})
+}
@@ -96,26 +96,37 @@ func (s *clusterServer) DetachDocument(
 		return nil, err
 	}
 
-	// 01. Create changePack with presence clear change
+	// 01. Create changePack with presence clear change. The read-only and
+	// pull-only attachments have no presence pushed, so they are detached
+	// without changes.
 	cp := clientInfo.Checkpoint(summary.ID)
-	latestChangeInfo, err := s.backend.DB.FindLatestChangeInfoByActor(
-		ctx,
-		docRefKey,
-		types.ID(req.Msg.ClientId),
-		cp.ServerSeq,
-	)
-	if err != nil {
-		return nil, err
+	var changes []*change.Change
+	if !clientInfo.IsReadOnly(summary.ID) && !clientInfo.IsPullOnly(summary.ID) {
+		latestChangeInfo, err := s.backend.DB.FindLatestChangeInfoByActor(
+			ctx,
+			docRefKey,
+			types.ID(req.Msg.ClientId),
+			cp.ServerSeq,
+		)
+		if err != nil {
+			return nil, err
+		}
+		changeCtx := change.NewContext(
+			change.NewID(
+				cp.ClientSeq,
+				cp.ServerSeq,
+				latestChangeInfo.Lamport,
+				actorID,
+				latestChangeInfo.VersionVector,
+			).Next(),
+			"",
+			nil,
+		)
+		p := presence.New(changeCtx, innerpresence.New())
+		p.Clear()
+
+		changes = append(changes, changeCtx.ToChange())
 	}
-	changeCtx := change.NewContext(
-		change.NewID(cp.ClientSeq, cp.ServerSeq, latestChangeInfo.Lamport, actorID, latestChangeInfo.VersionVector).Next(),
-		"",
-		nil,
-	)
-	p := presence.New(changeCtx, innerpresence.New())
-	p.Clear()
-
-	changes := []*change.Change{changeCtx.ToChange()}
 	pack := change.NewPack(docInfo.Key, cp, changes, nil, nil)
 
 	// 02. PushPull with the created ChangePack.
@@ -28,7 +28,9 @@ import (
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/internal/metaerrors"
 	"github.com/yorkie-team/yorkie/internal/validation"
+	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/schema"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/document/yson"
 	"github.com/yorkie-team/yorkie/pkg/webhook"
@@ -43,18 +45,21 @@ import (
 // errorToConnectCode maps an error to connectRPC status code.
 var errorToConnectCode = map[error]connect.Code{
 	// InvalidArgument means the request is malformed.
-	converter.ErrPackRequired:         connect.CodeInvalidArgument,
-	converter.ErrCheckpointRequired:   connect.CodeInvalidArgument,
-	converter.ErrUnsupportedDateRange: connect.CodeInvalidArgument,
-	time.ErrInvalidHexString:          connect.CodeInvalidArgument,
-	time.ErrInvalidActorID:            connect.CodeInvalidArgument,
-	types.ErrInvalidID:                connect.CodeInvalidArgument,
-	clients.ErrInvalidClientID:        connect.CodeInvalidArgument,
-	clients.ErrInvalidClientKey:       connect.CodeInvalidArgument,
-	key.ErrInvalidKey:                 connect.CodeInvalidArgument,
-	types.ErrEmptyProjectFields:       connect.CodeInvalidArgument,
-	yson.ErrInvalidYSON:               connect.CodeInvalidArgument,
-	yson.ErrUnsupported:               connect.CodeInvalidArgument,
+	converter.ErrPackRequired:          connect.CodeInvalidArgument,
+	converter.ErrCheckpointRequired:    connect.CodeInvalidArgument,
+	converter.ErrUnsupportedDateRange:  connect.CodeInvalidArgument,
+	time.ErrInvalidHexString:           connect.CodeInvalidArgument,
+	time.ErrInvalidActorID:             connect.CodeInvalidArgument,
+	types.ErrInvalidID:                 connect.CodeInvalidArgument,
+	clients.ErrInvalidClientID:         connect.CodeInvalidArgument,
+	clients.ErrInvalidClientKey:        connect.CodeInvalidArgument,
+	key.ErrInvalidKey:                  connect.CodeInvalidArgument,
+	types.ErrEmptyProjectFields:        connect.CodeInvalidArgument,
+	yson.ErrInvalidYSON:                connect.CodeInvalidArgument,
+	yson.ErrUnsupported:                connect.CodeInvalidArgument,
+	schema.ErrInvalidSchema:            connect.CodeInvalidArgument,
+	schema.ErrSchemaViolation:          connect.CodeInvalidArgument,
+	documents.ErrInvalidStaleThreshold: connect.CodeInvalidArgument,
 
 	// NotFound means the requested resource does not exist.
 	database.ErrProjectNotFound:  connect.CodeNotFound,
@@ -76,8 +81,11 @@ var errorToConnectCode = map[error]connect.Code{
 	database.ErrDocumentAlreadyDetached: connect.CodeFailedPrecondition,
 	documents.ErrDocumentAttached:       connect.CodeFailedPrecondition,
 	packs.ErrInvalidServerSeq:           connect.CodeFailedPrecondition,
+	packs.ErrPushNotAllowed:             connect.CodeFailedPrecondition,
 	database.ErrConflictOnUpdate:        connect.CodeFailedPrecondition,
 	documents.ErrDocumentNotRemoved:     connect.CodeFailedPrecondition,
+	document.ErrDocumentSizeExceeded:    connect.CodeFailedPrecondition,
+	document.ErrChangeSizeExceeded:      connect.CodeFailedPrecondition,
 
 	// Unimplemented means the server does not implement the functionality.
 	converter.ErrUnsupportedOperation:   connect.CodeUnimplemented,
@@ -107,15 +115,18 @@ var errorToConnectCode = map[error]connect.Code{
 // TODO(hackerwins): We need to add codes by hand for each error. It would be
 // better to generate this map automatically.
 var errorToCode = map[error]string{
-	converter.ErrPackRequired:       "ErrPackRequired",
-	converter.ErrCheckpointRequired: "ErrCheckpointRequired",
-	time.ErrInvalidHexString:        "ErrInvalidHexString",
-	time.ErrInvalidActorID:          "ErrInvalidActorID",
-	types.ErrInvalidID:              "ErrInvalidID",
-	clients.ErrInvalidClientID:      "ErrInvalidClientID",
-	clients.ErrInvalidClientKey:     "ErrInvalidClientKey",
-	key.ErrInvalidKey:               "ErrInvalidKey",
-	types.ErrEmptyProjectFields:     "ErrEmptyProjectFields",
+	converter.ErrPackRequired:          "ErrPackRequired",
+	converter.ErrCheckpointRequired:    "ErrCheckpointRequired",
+	time.ErrInvalidHexString:           "ErrInvalidHexString",
+	time.ErrInvalidActorID:             "ErrInvalidActorID",
+	types.ErrInvalidID:                 "ErrInvalidID",
+	clients.ErrInvalidClientID:         "ErrInvalidClientID",
+	clients.ErrInvalidClientKey:        "ErrInvalidClientKey",
+	key.ErrInvalidKey:                  "ErrInvalidKey",
+	types.ErrEmptyProjectFields:        "ErrEmptyProjectFields",
+	schema.ErrInvalidSchema:            "ErrInvalidSchema",
+	schema.ErrSchemaViolation:          "ErrSchemaViolation",
+	documents.ErrInvalidStaleThreshold: "ErrInvalidStaleThreshold",
 
 	database.ErrProjectNotFound:  "ErrProjectNotFound",
 	database.ErrClientNotFound:   "ErrClientNotFound",
@@ -132,7 +143,10 @@ var errorToCode = map[error]string{
 	database.ErrDocumentAlreadyDetached: "ErrDocumentAlreadyDetached",
 	documents.ErrDocumentAttached:       "ErrDocumentAttached",
 	packs.ErrInvalidServerSeq:           "ErrInvalidServerSeq",
+	packs.ErrPushNotAllowed:             "ErrPushNotAllowed",
 	database.ErrConflictOnUpdate:        "ErrConflictOnUpdate",
+	document.ErrDocumentSizeExceeded:    "ErrDocumentSizeExceeded",
+	document.ErrChangeSizeExceeded:      "ErrChangeSizeExceeded",
 
 	converter.ErrUnsupportedOperation:   "ErrUnsupportedOperation",
 	converter.ErrUnsupportedElement:     "ErrUnsupportedElement",
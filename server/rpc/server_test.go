@@ -168,6 +168,10 @@ func TestSDKRPCServerBackend(t *testing.T) {
 		testcases.RunPushPullChangeOnRemovedDocumentTest(t, testClient)
 	})
 
+	t.Run("push/pull on pull-only document test", func(t *testing.T) {
+		testcases.RunPushPullChangeOnPullOnlyDocumentTest(t, testClient)
+	})
+
 	t.Run("remove document test", func(t *testing.T) {
 		testcases.RunRemoveDocumentTest(t, testClient)
 	})
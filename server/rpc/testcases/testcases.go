@@ -37,6 +37,7 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/clients"
+	"github.com/yorkie-team/yorkie/server/packs"
 	"github.com/yorkie-team/yorkie/server/rpc/connecthelper"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
@@ -470,6 +471,92 @@ func RunPushPullChangeOnRemovedDocumentTest(
 	assert.Equal(t, connecthelper.CodeOf(database.ErrDocumentNotAttached), converter.ErrorCodeOf(err))
 }
 
+// RunPushPullChangeOnPullOnlyDocumentTest runs the PushChange and PullChange test on
+// a document attached in pull-only mode.
+func RunPushPullChangeOnPullOnlyDocumentTest(
+	t *testing.T,
+	testClient v1connect.YorkieServiceClient,
+) {
+	packWithNoChanges := &api.ChangePack{
+		DocumentKey: helper.TestDocKey(t).String(),
+		Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+	}
+
+	activateResp, err := testClient.ActivateClient(
+		context.Background(),
+		connect.NewRequest(&api.ActivateClientRequest{ClientKey: helper.TestDocKey(t).String()}))
+	assert.NoError(t, err)
+
+	actorID, _ := hex.DecodeString(activateResp.Msg.ClientId)
+	pbVector, _ := converter.ToVersionVector(time.NewVersionVector())
+	packWithChanges := &api.ChangePack{
+		DocumentKey: helper.TestDocKey(t).String(),
+		Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 1},
+		Changes: []*api.Change{{
+			Id: &api.ChangeID{
+				ClientSeq:     1,
+				Lamport:       1,
+				ActorId:       actorID,
+				VersionVector: pbVector,
+			},
+		}},
+	}
+
+	// try to attach in pull-only mode with changes
+	_, err = testClient.AttachDocument(
+		context.Background(),
+		connect.NewRequest(&api.AttachDocumentRequest{
+			ClientId:   activateResp.Msg.ClientId,
+			ChangePack: packWithChanges,
+			PullOnly:   true,
+		},
+		))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	assert.Equal(t, connecthelper.CodeOf(packs.ErrPushNotAllowed), converter.ErrorCodeOf(err))
+
+	resPack, err := testClient.AttachDocument(
+		context.Background(),
+		connect.NewRequest(&api.AttachDocumentRequest{
+			ClientId:   activateResp.Msg.ClientId,
+			ChangePack: packWithNoChanges,
+			PullOnly:   true,
+		},
+		))
+	assert.NoError(t, err)
+
+	// try to push changes without the pull-only flag of the request
+	_, err = testClient.PushPullChanges(
+		context.Background(),
+		connect.NewRequest(&api.PushPullChangesRequest{
+			ClientId:   activateResp.Msg.ClientId,
+			DocumentId: resPack.Msg.DocumentId,
+			ChangePack: packWithChanges,
+		},
+		))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	assert.Equal(t, connecthelper.CodeOf(packs.ErrPushNotAllowed), converter.ErrorCodeOf(err))
+
+	_, err = testClient.PushPullChanges(
+		context.Background(),
+		connect.NewRequest(&api.PushPullChangesRequest{
+			ClientId:   activateResp.Msg.ClientId,
+			DocumentId: resPack.Msg.DocumentId,
+			ChangePack: packWithNoChanges,
+		},
+		))
+	assert.NoError(t, err)
+
+	_, err = testClient.DetachDocument(
+		context.Background(),
+		connect.NewRequest(&api.DetachDocumentRequest{
+			ClientId:   activateResp.Msg.ClientId,
+			DocumentId: resPack.Msg.DocumentId,
+			ChangePack: packWithNoChanges,
+		},
+		))
+	assert.NoError(t, err)
+}
+
 // RunRemoveDocumentTest runs the RemoveDocument test.
 func RunRemoveDocumentTest(
 	t *testing.T,
@@ -142,10 +142,9 @@ func (s *yorkieServer) AttachDocument(
 		return nil, err
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
-		Method:     types.AttachDocument,
-		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
+	// NOTE: The document attached in read-only or pull-only mode never pushes
+	// changes, so only the read of it is verified.
+	if err := auth.VerifyAttachAccess(ctx, s.backend, pack, req.Msg.ReadOnly || req.Msg.PullOnly); err != nil {
 		return nil, err
 	}
 
@@ -192,6 +191,16 @@ func (s *yorkieServer) AttachDocument(
 	if err := clientInfo.AttachDocument(docInfo.ID, pack.IsAttached()); err != nil {
 		return nil, err
 	}
+	if req.Msg.ReadOnly {
+		if err := clientInfo.SetReadOnly(docInfo.ID); err != nil {
+			return nil, err
+		}
+	}
+	if req.Msg.PullOnly {
+		if err := clientInfo.SetPullOnly(docInfo.ID); err != nil {
+			return nil, err
+		}
+	}
 
 	pulled, err := packs.PushPull(ctx, s.backend, project, clientInfo, docInfo, pack, packs.PushPullOptions{
 		Mode:   types.SyncModePushPull,
@@ -207,8 +216,12 @@ func (s *yorkieServer) AttachDocument(
 	}
 
 	return connect.NewResponse(&api.AttachDocumentResponse{
-		ChangePack: pbChangePack,
-		DocumentId: docInfo.ID.String(),
+		ChangePack:      pbChangePack,
+		DocumentId:      docInfo.ID.String(),
+		Schema:          project.Schema,
+		MaxDocumentSize: converter.ClampToInt32(project.MaxDocumentSize),
+		MaxChangeSize:   converter.ClampToInt32(project.MaxChangeSize),
+		PresencePatch:   true,
 	}), nil
 }
 
@@ -374,8 +387,12 @@ func (s *yorkieServer) PushPullChanges(
 		return nil, err
 	}
 
+	// NOTE: The document attached in pull-only mode is always synchronized in
+	// pull-only mode regardless of the mode of the request.
 	syncMode := types.SyncModePushPull
-	if req.Msg.PushOnly {
+	if req.Msg.PullOnly || clientInfo.IsPullOnly(docInfo.ID) {
+		syncMode = types.SyncModePullOnly
+	} else if req.Msg.PushOnly {
 		syncMode = types.SyncModePushOnly
 	}
 
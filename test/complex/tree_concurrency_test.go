@@ -154,6 +154,11 @@ type editOperationType struct {
 	desc       string
 }
 
+type moveOperationType struct {
+	fromPath, toPath []int
+	desc             string
+}
+
 func (op styleOperationType) getDesc() string {
 	return op.desc
 }
@@ -162,6 +167,17 @@ func (op editOperationType) getDesc() string {
 	return op.desc
 }
 
+func (op moveOperationType) getDesc() string {
+	return op.desc
+}
+
+func (op moveOperationType) run(t *testing.T, doc *document.Document, user int, ranges twoRangesType) {
+	assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+		root.GetTree("t").Move(op.fromPath, op.toPath)
+		return nil
+	}))
+}
+
 func (op styleOperationType) run(t *testing.T, doc *document.Document, user int, ranges twoRangesType) {
 	interval := getRange(ranges, op.selector, user)
 	from, to := interval.from, interval.to
@@ -523,3 +539,84 @@ func TestTreeConcurrencyEditStyle(t *testing.T) {
 
 	RunTestTreeConcurrency("concurrently-edit-style-test", t, initialState, initialXML, ranges, editOperations, styleOperations)
 }
+
+func TestTreeConcurrencyMoveMove(t *testing.T) {
+	// <root> <div> <p> a </p> </div> <div> <p> b </p> </div> <p> c </p> </root>
+
+	initialState := json.TreeNode{
+		Type: "root",
+		Children: []json.TreeNode{
+			{Type: "div", Children: []json.TreeNode{
+				{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+			}},
+			{Type: "div", Children: []json.TreeNode{
+				{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+			}},
+			{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "c"}}},
+		},
+	}
+	initialXML := `<root><div><p>a</p></div><div><p>b</p></div><p>c</p></root>`
+
+	ranges := []twoRangesType{
+		// ranges are not used for moves.
+		makeTwoRanges(0, 0, 0, 0, 0, 0, `move`),
+	}
+
+	moveOperations1 := []operationInterface{
+		moveOperationType{[]int{0}, []int{3}, `moveFirstToBack`},
+		moveOperationType{[]int{2}, []int{0}, `moveLastToFront`},
+		moveOperationType{[]int{0}, []int{1, 1}, `moveFirstIntoSecond`},
+		moveOperationType{[]int{2}, []int{1, 0}, `moveLastIntoSecond`},
+	}
+
+	moveOperations2 := []operationInterface{
+		moveOperationType{[]int{0}, []int{2}, `moveFirstToMiddle`},
+		moveOperationType{[]int{2}, []int{1}, `moveLastToMiddle`},
+		moveOperationType{[]int{1}, []int{0, 1}, `moveSecondIntoFirst`},
+		moveOperationType{[]int{2}, []int{0, 0}, `moveLastIntoFirst`},
+	}
+
+	RunTestTreeConcurrency("concurrently-move-move-test", t, initialState, initialXML, ranges, moveOperations1, moveOperations2)
+}
+
+func TestTreeConcurrencyMoveEdit(t *testing.T) {
+	//       0   1 2    3   4 5    6   7 8    9
+	// <root> <p> a </p> <p> b </p> <p> c </p> </root>
+
+	initialState := json.TreeNode{
+		Type: "root",
+		Children: []json.TreeNode{
+			{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+			{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+			{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "c"}}},
+		},
+	}
+	initialXML := `<root><p>a</p><p>b</p><p>c</p></root>`
+
+	content := &json.TreeNode{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "d"}}}
+	textNode := &json.TreeNode{Type: "text", Value: "X"}
+
+	ranges := []twoRangesType{
+		// moved: <p>a</p>
+		makeTwoRanges(0, 3, 3, 0, 2, 3, `moved`),
+		// next to moved: <p>b</p>
+		makeTwoRanges(0, 3, 3, 3, 5, 6, `next-to-moved`),
+		// contain moved: <p>a</p><p>b</p>
+		makeTwoRanges(0, 3, 3, 0, 5, 6, `contain-moved`),
+	}
+
+	moveOperations := []operationInterface{
+		moveOperationType{[]int{0}, []int{3}, `moveFirstToBack`},
+		moveOperationType{[]int{0}, []int{2}, `moveFirstToMiddle`},
+	}
+
+	editOperations := []operationInterface{
+		editOperationType{RangeFront, EditUpdate, content, 0, `insertFront`},
+		editOperationType{RangeMiddle, EditUpdate, textNode, 0, `insertTextMiddle`},
+		editOperationType{RangeBack, EditUpdate, content, 0, `insertBack`},
+		editOperationType{RangeAll, EditUpdate, nil, 0, `delete`},
+		editOperationType{RangeAll, EditUpdate, content, 0, `replace`},
+	}
+
+	RunTestTreeConcurrency("concurrently-move-edit-test", t, initialState, initialXML, ranges, moveOperations, editOperations)
+}
@@ -92,6 +92,7 @@ var (
 	EventWebhookCacheTTL        = 10 * gotime.Second
 	ProjectCacheSize            = 256
 	ProjectCacheTTL             = 5 * gotime.Second
+	DocumentCacheSize           = 128
 
 	MongoConnectionURI     = "mongodb://localhost:27017"
 	MongoConnectionTimeout = "5s"
@@ -300,6 +301,7 @@ func TestConfig() *server.Config {
 			EventWebhookMinWaitInterval: EventWebhookMinWaitInterval.String(),
 			EventWebhookRequestTimeout:  EventWebhookRequestTimeout.String(),
 			ProjectCacheSize:            ProjectCacheSize,
+			DocumentCacheSize:           DocumentCacheSize,
 			ProjectCacheTTL:             ProjectCacheTTL.String(),
 			GatewayAddr:                 fmt.Sprintf("localhost:%d", RPCPort+portOffset),
 		},
@@ -122,6 +122,173 @@ func TestAdmin(t *testing.T) {
 		assert.Equal(t, int32(0), doc.Root().GetCounter("counter").Value())
 	})
 
+	t.Run("admin and client document restore sync test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() {
+			assert.NoError(t, cli.Deactivate(ctx))
+			assert.NoError(t, cli.Close())
+		}()
+
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetNewObject("todo").SetString("title", "buy coffee")
+			r.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.GetObject("todo").SetBool("done", true)
+			r.Delete("k1")
+			r.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.Equal(t, `{"k2":"v2","todo":{"done":true,"title":"buy coffee"}}`, doc.Marshal())
+
+		// 01. admin restores the document to the revision before the last change.
+		// NOTE: The first change is made to set the initial presence when attaching.
+		summary, err := adminCli.RestoreDocument(ctx, "default", doc.Key(), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":"v1","todo":{"title":"buy coffee"}}`, summary.Snapshot)
+
+		// 02. the attached client converges on the restored content.
+		assert.NoError(t, cli.Sync(ctx))
+		assert.Equal(t, `{"k1":"v1","todo":{"title":"buy coffee"}}`, doc.Marshal())
+
+		// 03. admin tries to restore the document to a revision that does not exist.
+		_, err = adminCli.RestoreDocument(ctx, "default", doc.Key(), 100)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+
+	t.Run("restore text, array and counter in place test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() {
+			assert.NoError(t, cli.Deactivate(ctx))
+			assert.NoError(t, cli.Close())
+		}()
+
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetNewText("text").Edit(0, 0, "Hello World")
+			r.SetNewArray("list").AddInteger(1, 2, 3)
+			r.SetNewCounter("cnt", crdt.IntegerCnt, 1)
+			r.SetNewTree("tree", json.TreeNode{
+				Type:     "doc",
+				Children: []json.TreeNode{{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "ab"}}}},
+			})
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.GetText("text").Edit(0, 5, "Hi")
+			r.GetText("text").Style(0, 8, map[string]string{"b": "1"})
+			list := r.GetArray("list")
+			list.Delete(0)
+			list.AddInteger(4)
+			list.MoveFrontByIndex(2)
+			r.GetCounter("cnt").Increase(5)
+			r.GetTree("tree").Edit(1, 3, &json.TreeNode{Type: "text", Value: "cd"}, 0)
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		root := doc.Root()
+		text, list := root.GetText("text").CreatedAt(), root.GetArray("list").CreatedAt()
+		cnt, tree := root.GetCounter("cnt").CreatedAt(), root.GetTree("tree").CreatedAt()
+
+		// 01. admin restores the document to the revision before the last change.
+		// NOTE: The first change is made to set the initial presence when attaching.
+		summary, err := adminCli.RestoreDocument(ctx, "default", doc.Key(), 2)
+		assert.NoError(t, err)
+
+		// 02. text, array and counter are restored in place, while tree is
+		// replaced as a whole because its nodes can not be matched yet.
+		// NOTE: The restored text is split into the nodes of the edits.
+		assert.NoError(t, cli.Sync(ctx))
+		assert.Equal(t, summary.Snapshot, doc.Marshal())
+		root = doc.Root()
+		assert.Equal(t, "Hello World", root.GetText("text").String())
+		assert.NotContains(t, root.GetText("text").Marshal(), "attrs")
+		assert.Equal(t, `[1,2,3]`, root.GetArray("list").Marshal())
+		assert.Equal(t, int32(1), root.GetCounter("cnt").Value())
+		assert.Equal(t, "<doc><p>ab</p></doc>", root.GetTree("tree").ToXML())
+		assert.Equal(t, text, root.GetText("text").CreatedAt())
+		assert.Equal(t, list, root.GetArray("list").CreatedAt())
+		assert.Equal(t, cnt, root.GetCounter("cnt").CreatedAt())
+		assert.NotEqual(t, tree, root.GetTree("tree").CreatedAt())
+	})
+
+	t.Run("document gc status and forced gc test", func(t *testing.T) {
+		ctx := context.Background()
+		clients := activeClients(t, 2)
+		cli1, cli2 := clients[0], clients[1]
+		defer deactivateAndCloseClients(t, clients)
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetString("k1", "v1")
+			r.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, cli1.Sync(ctx))
+		assert.NoError(t, cli2.Sync(ctx))
+
+		// 01. cli2 does not sync after cli1 removes an element, so it holds
+		// back the garbage collection.
+		assert.NoError(t, d1.Update(func(r *json.Object, p *presence.Presence) error {
+			r.Delete("k1")
+			return nil
+		}))
+		assert.NoError(t, cli1.Sync(ctx))
+
+		status, err := adminCli.GetDocumentGCStatus(ctx, "default", d1.Key())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, status.GarbageLen)
+		assert.Equal(t, 2, status.AttachedClients)
+		assert.Greater(t, status.DocSize.GC.Data, 0)
+		assert.Len(t, status.Blockers, 1)
+		assert.Equal(t, cli2.ID().String(), status.Blockers[0].ClientID.String())
+
+		// 02. admin tries to collect the garbage with an invalid threshold.
+		_, err = adminCli.CollectDocumentGarbage(ctx, "default", d1.Key(), "invalid")
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		// 03. admin detaches the stale clients and compacts the document.
+		result, err := adminCli.CollectDocumentGarbage(ctx, "default", d1.Key(), "0s")
+		assert.NoError(t, err)
+		assert.Len(t, result.DetachedClients, 2)
+		assert.True(t, result.Compacted)
+		assert.Equal(t, 0, result.Status.GarbageLen)
+		assert.Equal(t, 0, result.Status.AttachedClients)
+		assert.Len(t, result.Status.Blockers, 0)
+
+		// 04. admin collects the garbage again, but the document is not
+		// compacted again because nothing has changed.
+		result, err = adminCli.CollectDocumentGarbage(ctx, "default", d1.Key(), "0s")
+		assert.NoError(t, err)
+		assert.Len(t, result.DetachedClients, 0)
+		assert.False(t, result.Compacted)
+		assert.Equal(t, 0, result.Status.GarbageLen)
+	})
+
 	t.Run("admin and client document deletion sync test", func(t *testing.T) {
 		ctx := context.Background()
 
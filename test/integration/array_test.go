@@ -236,6 +236,61 @@ func TestArray(t *testing.T) {
 		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
 	})
 
+	t.Run("concurrent array move front and last test", func(t *testing.T) {
+		t.Skip("remove this after supporting concurrent moves after the moved element")
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("k1").AddInteger(0, 1, 2)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("k1").MoveFrontByIndex(2)
+			assert.Equal(t, `{"k1":[2,0,1]}`, root.Marshal())
+			return nil
+		}))
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("k1").MoveLastByIndex(0)
+			assert.Equal(t, `{"k1":[1,2,0]}`, root.Marshal())
+			return nil
+		}))
+
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+	})
+
+	t.Run("concurrent array splice test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("k1").AddInteger(0, 1, 2, 3)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("k1").Splice(1, 2, "a", yson.Object{"k": "v"})
+			assert.Equal(t, `{"k1":[0,"a",{"k":"v"},3]}`, root.Marshal())
+			return nil
+		}))
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("k1").Splice(2, 1, "b")
+			assert.Equal(t, `{"k1":[0,1,"b",3]}`, root.Marshal())
+			return nil
+		}))
+
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+	})
+
 	t.Run("array.set with value add, delete test", func(t *testing.T) {
 		ctx := context.Background()
 		d1 := document.New(helper.TestDocKey(t))
@@ -20,6 +20,7 @@ package integration
 
 import (
 	"context"
+	"encoding/hex"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -31,12 +32,15 @@ import (
 
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/api/yorkie/v1/v1connect"
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/pkg/webhook"
 	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/packs"
 	"github.com/yorkie-team/yorkie/server/rpc/auth"
 	"github.com/yorkie-team/yorkie/server/rpc/connecthelper"
 	"github.com/yorkie-team/yorkie/test/helper"
@@ -668,3 +672,137 @@ func TestAuthWebhookNewToken(t *testing.T) {
 		assert.NoError(t, cli.Activate(ctx))
 	})
 }
+
+func TestAuthWebhookReadOnly(t *testing.T) {
+	ctx := context.Background()
+	token := xid.New().String()
+
+	// NOTE: The webhook grants only the read of the documents.
+	authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := types.NewAuthWebhookRequest(r.Body)
+		assert.NoError(t, err)
+
+		var res types.AuthWebhookResponse
+		res.Allowed = req.Token == token
+		for _, attr := range req.Attributes {
+			if attr.Verb != types.Read {
+				res.Allowed = false
+			}
+		}
+
+		if res.Allowed {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusForbidden)
+		}
+		_, err = res.Write(w)
+		assert.NoError(t, err)
+	}))
+
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { adminCli.Close() }()
+	project, err := adminCli.CreateProject(ctx, "read-only-auth-webhook")
+	assert.NoError(t, err)
+	project.AuthWebhookURL = authServer.URL
+	_, err = adminCli.UpdateProject(
+		ctx,
+		project.ID.String(),
+		&types.UpdatableProjectFields{
+			AuthWebhookURL:     &project.AuthWebhookURL,
+			AuthWebhookMethods: &[]string{string(types.AttachDocument)},
+		},
+	)
+	assert.NoError(t, err)
+
+	t.Run("attach in read-only mode granted by webhook test", func(t *testing.T) {
+		cli, err := client.Dial(
+			svr.RPCAddr(),
+			client.WithAPIKey(project.PublicKey),
+			client.WithToken(token),
+		)
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		// 01. The document with the presence can not be attached.
+		doc := document.New(helper.TestDocKey(t))
+		err = cli.Attach(ctx, doc)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		// 02. The document can be attached in read-only mode.
+		doc = document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc, client.WithReadOnly()))
+		assert.NoError(t, cli.Detach(ctx, doc))
+	})
+
+	t.Run("server rejects changes from read-only attachment by webhook test", func(t *testing.T) {
+		testClient := v1connect.NewYorkieServiceClient(
+			http.DefaultClient,
+			"http://"+svr.RPCAddr(),
+			connect.WithInterceptors(client.NewAuthInterceptor(project.PublicKey, token)),
+		)
+
+		activateResp, err := testClient.ActivateClient(
+			ctx,
+			connect.NewRequest(&api.ActivateClientRequest{ClientKey: t.Name()}))
+		assert.NoError(t, err)
+
+		// 01. The document can not be attached without the read-only flag of
+		// the request, because the webhook grants only the read.
+		_, err = testClient.AttachDocument(
+			ctx,
+			connect.NewRequest(&api.AttachDocumentRequest{
+				ClientId: activateResp.Msg.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: helper.TestDocKey(t).String(),
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+				},
+			}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		resPack, err := testClient.AttachDocument(
+			ctx,
+			connect.NewRequest(&api.AttachDocumentRequest{
+				ClientId: activateResp.Msg.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: helper.TestDocKey(t).String(),
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+				},
+				ReadOnly: true,
+			}))
+		assert.NoError(t, err)
+
+		// 02. The changes of the read-only attachment are rejected.
+		actorID, _ := hex.DecodeString(activateResp.Msg.ClientId)
+		_, err = testClient.PushPullChanges(
+			ctx,
+			connect.NewRequest(&api.PushPullChangesRequest{
+				ClientId:   activateResp.Msg.ClientId,
+				DocumentId: resPack.Msg.DocumentId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: helper.TestDocKey(t).String(),
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 1},
+					Changes: []*api.Change{{
+						Id: &api.ChangeID{
+							ClientSeq: 1,
+							Lamport:   1,
+							ActorId:   actorID,
+						},
+					}},
+				},
+			}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		assert.Equal(t, connecthelper.CodeOf(packs.ErrPushNotAllowed), converter.ErrorCodeOf(err))
+
+		_, err = testClient.DeactivateClient(
+			ctx,
+			connect.NewRequest(&api.DeactivateClientRequest{ClientId: activateResp.Msg.ClientId}))
+		assert.NoError(t, err)
+	})
+}
@@ -21,10 +21,13 @@ package integration
 import (
 	"context"
 	"testing"
+	gotime "time"
 
+	"connectrpc.com/connect"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/diff"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/test/helper"
@@ -76,4 +79,77 @@ func TestHistory(t *testing.T) {
 		assert.Equal(t, `{"todos":["buy coffee"]}`, changes[1].Snapshot)
 		assert.Equal(t, `{"todos":["buy coffee","buy bread"]}`, changes[0].Snapshot)
 	})
+
+	t.Run("document at revision test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, d1))
+		defer func() { assert.NoError(t, cli.Detach(ctx, d1)) }()
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("todos").AddString("buy coffee")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		at := gotime.Now()
+		gotime.Sleep(10 * gotime.Millisecond)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("todos").AddString("buy bread")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		// NOTE: The first change is made to set the initial presence when attaching.
+		revision, err := adminCli.GetDocumentAtRevision(ctx, "default", d1.Key(), 2, gotime.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), revision.ServerSeq)
+		assert.Equal(t, `{"todos":["buy coffee"]}`, revision.Root)
+
+		revision, err = adminCli.GetDocumentAtRevision(ctx, "default", d1.Key(), 0, at)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), revision.ServerSeq)
+		assert.Equal(t, `{"todos":["buy coffee"]}`, revision.Root)
+
+		revision, err = adminCli.GetDocumentAtRevision(ctx, "default", d1.Key(), 0, gotime.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), revision.ServerSeq)
+		assert.Equal(t, `{"todos":["buy coffee","buy bread"]}`, revision.Root)
+
+		// A negative server sequence means the latest revision.
+		revision, err = adminCli.GetDocumentAtRevision(ctx, "default", d1.Key(), -1, gotime.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), revision.ServerSeq)
+		assert.Equal(t, `{"todos":["buy coffee","buy bread"]}`, revision.Root)
+
+		_, err = adminCli.GetDocumentAtRevision(ctx, "default", d1.Key(), 4, gotime.Time{})
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+	t.Run("document diff test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, d1))
+		defer func() { assert.NoError(t, cli.Detach(ctx, d1)) }()
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("todos").AddString("buy coffee")
+			root.SetString("title", "todos")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("todos").AddString("buy bread")
+			root.Delete("title")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		diffs, err := adminCli.GetDocumentDiff(ctx, "default", d1.Key(), 2, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []*diff.Diff{
+			{Type: diff.TypeDelete, Path: "$.title", Old: `"todos"`},
+			{Type: diff.TypeInsert, Path: "$.todos", To: 1, New: `"buy bread"`},
+		}, diffs)
+	})
 }
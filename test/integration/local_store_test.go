@@ -0,0 +1,96 @@
+//go:build integration
+
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/client/localstore"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestLocalStore(t *testing.T) {
+	t.Run("resume unsynced local changes after restart test", func(t *testing.T) {
+		ctx := context.Background()
+		store, err := localstore.NewFileStore(t.TempDir())
+		assert.NoError(t, err)
+
+		// 01. c1 syncs a change, then crashes with unsynced local changes.
+		c1, err := client.Dial(defaultServer.RPCAddr(), client.WithLocalStore(store))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text").Edit(0, 0, "abc")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(3, 3, "d")
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Close())
+
+		// 02. c2 edits the document concurrently.
+		clients := activeClients(t, 1)
+		c2 := clients[0]
+		defer deactivateAndCloseClients(t, clients)
+
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(0, 0, "z")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+
+		// 03. c3 restarts with the same store and resumes the local changes.
+		c3, err := client.Dial(defaultServer.RPCAddr(), client.WithLocalStore(store))
+		assert.NoError(t, err)
+		assert.NoError(t, c3.Activate(ctx))
+		defer func() {
+			assert.NoError(t, c3.Deactivate(ctx))
+			assert.NoError(t, c3.Close())
+		}()
+
+		d3 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c3.Attach(ctx, d3))
+		assert.False(t, d3.HasLocalChanges())
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, "zabcd", d3.Root().GetText("text").String())
+		assert.Equal(t, d2.Marshal(), d3.Marshal())
+		assert.Contains(t, d3.Marshal(), `"k1":"v1"`)
+
+		// 04. The state is deleted after detaching.
+		assert.NoError(t, c3.Detach(ctx, d3))
+		_, err = store.Get(d3.Key())
+		assert.ErrorIs(t, err, localstore.ErrNotFound)
+	})
+}
@@ -70,6 +70,34 @@ func TestPresence(t *testing.T) {
 		assert.Equal(t, fmt.Sprintf(`{"%s":{"updated":"true"},"%s":{}}`, c1.ID(), c2.ID()), string(encoded))
 	})
 
+	t.Run("presence patch test", func(t *testing.T) {
+		// 01. Create a document and attach it to the clients
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1, client.WithPresence(innerpresence.Presence{"name": "a"})))
+		defer func() { assert.NoError(t, c1.Detach(ctx, d1)) }()
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		defer func() { assert.NoError(t, c2.Detach(ctx, d2)) }()
+
+		// 02. Update a key of the presence with a structured value
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			p.SetValue("cursor", map[string]int{"x": 3, "y": 4})
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+
+		// 03. Check that the other client has the whole presence
+		expected := innerpresence.Presence{"name": "a", "cursor": `{"x":3,"y":4}`}
+		assert.Equal(t, expected, d1.MyPresence())
+		assert.Equal(t, expected, d2.PresenceForTest(c1.ID().String()))
+
+		var cursor map[string]int
+		assert.NoError(t, d2.PresenceForTest(c1.ID().String()).Value("cursor", &cursor))
+		assert.Equal(t, map[string]int{"x": 3, "y": 4}, cursor)
+	})
+
 	t.Run("presence with snapshot test", func(t *testing.T) {
 		// 01. Create a document and attach it to the clients
 		ctx := context.Background()
@@ -0,0 +1,140 @@
+//go:build integration
+
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/schema"
+	"github.com/yorkie-team/yorkie/server/rpc/connecthelper"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestSchemaValidation(t *testing.T) {
+	ctx := context.Background()
+
+	svr := newYorkieServer(t, "default")
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { adminCli.Close() }()
+
+	project, err := adminCli.CreateProject(ctx, "schema-validation")
+	assert.NoError(t, err)
+
+	projectSchema := `{
+		"type": "object",
+		"properties": {"title": {"type": "string"}, "content": {"type": "text"}},
+		"additionalProperties": false
+	}`
+	project, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+		Schema: &projectSchema,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, projectSchema, project.Schema)
+
+	cli := newActivatedClient(t, ctx, svr.RPCAddr(), project.PublicKey)
+	defer func() { assert.NoError(t, cli.Close()) }()
+
+	t.Run("client-side validation test", func(t *testing.T) {
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NotNil(t, doc.Schema())
+
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("title", 1)
+			return nil
+		})
+		assert.ErrorIs(t, err, schema.ErrSchemaViolation)
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "hello")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+	})
+
+	t.Run("server-side validation test", func(t *testing.T) {
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// NOTE: Remove the schema from the document to simulate a client that
+		// does not validate the updates locally.
+		doc.SetSchema(nil)
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("unknown", "value")
+			return nil
+		}))
+
+		err := cli.Sync(ctx)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		assert.Equal(t, connecthelper.CodeOf(schema.ErrSchemaViolation), converter.ErrorCodeOf(err))
+	})
+	t.Run("server-side validation with cached document test", func(t *testing.T) {
+		cli1 := newActivatedClient(t, ctx, svr.RPCAddr(), project.PublicKey)
+		defer func() { assert.NoError(t, cli1.Close()) }()
+		cli2 := newActivatedClient(t, ctx, svr.RPCAddr(), project.PublicKey)
+		defer func() { assert.NoError(t, cli2.Close()) }()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli2.Attach(ctx, d2))
+		d1.SetSchema(nil)
+		d2.SetSchema(nil)
+
+		// 01. Push valid changes from both clients, so that the document
+		// cached by the first push catches up with the changes of the other.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "hello")
+			return nil
+		}))
+		assert.NoError(t, cli1.Sync(ctx))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("content").Edit(0, 0, "world")
+			return nil
+		}))
+		assert.NoError(t, cli2.Sync(ctx))
+
+		// 02. Push an invalid change. It should be rejected and should not be
+		// left in the cached document.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("unknown", "value")
+			return nil
+		}))
+		err := cli2.Sync(ctx)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "hi")
+			return nil
+		}))
+		assert.NoError(t, cli1.Sync(ctx))
+		assert.Equal(t, `{"content":[{"val":"world"}],"title":"hi"}`, d1.Marshal())
+	})
+}
@@ -22,8 +22,8 @@ import (
 	"context"
 	"sync"
 	"testing"
+	gotime "time"
 
-	"connectrpc.com/connect"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/client"
@@ -55,10 +55,10 @@ func TestServer(t *testing.T) {
 					assert.Fail(t, "unexpected ctx done")
 					return
 				case wr := <-wrch:
-					// TODO(hackerwins): Define ClientError instead of using ConnectError later.
-					// For now, we use ConnectError to check whether the stream is closed. To
-					// simplify the interface, we will define ClientError later.
-					if connect.CodeOf(wr.Err) == connect.CodeCanceled {
+					// NOTE: The disconnection is not an error, because the stream
+					// is reconnected according to the reconnect policy.
+					if wr.Type == client.StreamDisconnected {
+						assert.NoError(t, wr.Err)
 						assert.Len(t, wr.Presences, 0)
 						wg.Done()
 						return
@@ -72,4 +72,39 @@ func TestServer(t *testing.T) {
 
 		wg.Wait()
 	})
+	t.Run("reconnect watch stream with policy test", func(t *testing.T) {
+		ctx := context.Background()
+		svr := helper.TestServer()
+		assert.NoError(t, svr.Start())
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithReconnectPolicy(client.ReconnectPolicy{
+			InitialInterval: 10 * gotime.Millisecond,
+			MaxInterval:     50 * gotime.Millisecond,
+			Multiplier:      2,
+			MaxRetries:      2,
+		}))
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc, client.WithRealtimeSync()))
+
+		wrch, _, err := cli.Subscribe(doc)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Shutdown(true))
+
+		// NOTE: The channel is closed after the policy gives up reconnecting.
+		var types []client.WatchResponseType
+		for wr := range wrch {
+			assert.NoError(t, wr.Err)
+			types = append(types, wr.Type)
+		}
+		assert.Equal(t, []client.WatchResponseType{
+			client.StreamDisconnected,
+			client.StreamConnecting,
+			client.StreamDisconnected,
+			client.StreamConnecting,
+			client.StreamDisconnected,
+		}, types)
+	})
 }
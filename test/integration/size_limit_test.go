@@ -0,0 +1,98 @@
+//go:build integration
+
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server/rpc/connecthelper"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestSizeLimit(t *testing.T) {
+	ctx := context.Background()
+
+	svr := newYorkieServer(t, "default")
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { adminCli.Close() }()
+
+	project, err := adminCli.CreateProject(ctx, "size-limit")
+	assert.NoError(t, err)
+
+	maxDocumentSize, maxChangeSize := 200, 60
+	project, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+		MaxDocumentSize: &maxDocumentSize,
+		MaxChangeSize:   &maxChangeSize,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, maxDocumentSize, project.MaxDocumentSize)
+	assert.Equal(t, maxChangeSize, project.MaxChangeSize)
+
+	cli := newActivatedClient(t, ctx, svr.RPCAddr(), project.PublicKey)
+	defer func() { assert.NoError(t, cli.Close()) }()
+
+	t.Run("client-side size limit test", func(t *testing.T) {
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.Equal(t, document.SizeLimit{
+			MaxDocumentSize: maxDocumentSize,
+			MaxChangeSize:   maxChangeSize,
+		}, doc.SizeLimit())
+
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "hello world")
+			return nil
+		})
+		assert.ErrorIs(t, err, document.ErrChangeSizeExceeded)
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+	})
+
+	t.Run("server-side size limit test", func(t *testing.T) {
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// NOTE: Remove the size limit from the document to simulate a client
+		// that does not check the updates locally.
+		doc.SetSizeLimit(document.SizeLimit{})
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "hello world")
+			return nil
+		}))
+
+		err := cli.Sync(ctx)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		assert.Equal(t, connecthelper.CodeOf(document.ErrChangeSizeExceeded), converter.ErrorCodeOf(err))
+	})
+}
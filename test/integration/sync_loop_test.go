@@ -0,0 +1,180 @@
+//go:build integration
+
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestSyncLoop(t *testing.T) {
+	ctx := context.Background()
+
+	dialClient := func(t *testing.T) *client.Client {
+		c, err := client.Dial(
+			defaultServer.RPCAddr(),
+			client.WithSyncLoopDuration(10*gotime.Millisecond),
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, c.Activate(ctx))
+		t.Cleanup(func() {
+			assert.NoError(t, c.Deactivate(ctx))
+			assert.NoError(t, c.Close())
+		})
+		return c
+	}
+
+	t.Run("sync documents attached in realtime test", func(t *testing.T) {
+		c1, c2 := dialClient(t), dialClient(t)
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1, client.WithRealtimeSync()))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2, client.WithRealtimeSync()))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+
+		assert.Eventually(t, func() bool {
+			return d2.Marshal() == `{"k1":"v1","k2":"v2"}`
+		}, 3*gotime.Second, 10*gotime.Millisecond)
+		assert.False(t, d1.HasLocalChanges())
+	})
+
+	t.Run("pause and resume sync loop test", func(t *testing.T) {
+		c1, c2 := dialClient(t), dialClient(t)
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1, client.WithRealtimeSync()))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2, client.WithRealtimeSync()))
+
+		// 01. Local changes of the paused document are not pushed.
+		assert.NoError(t, c1.Pause(d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		gotime.Sleep(100 * gotime.Millisecond)
+		assert.True(t, d1.HasLocalChanges())
+		assert.Equal(t, `{}`, d2.Marshal())
+
+		// 02. Changes of others are not pulled into the paused document.
+		assert.NoError(t, c1.Resume(d1))
+		assert.NoError(t, c2.Pause(d2))
+		assert.Eventually(t, func() bool {
+			return !d1.HasLocalChanges()
+		}, 3*gotime.Second, 10*gotime.Millisecond)
+		gotime.Sleep(100 * gotime.Millisecond)
+		assert.Equal(t, `{}`, d2.Marshal())
+
+		// 03. The changes made while paused are pulled after resuming.
+		assert.NoError(t, c2.Resume(d2))
+		assert.Eventually(t, func() bool {
+			return d2.Marshal() == `{"k1":"v1"}`
+		}, 3*gotime.Second, 10*gotime.Millisecond)
+
+		assert.ErrorIs(t, c1.Pause(document.New(helper.TestDocKey(t)+"-other")), client.ErrDocumentNotAttached)
+	})
+
+	t.Run("realtime sync off mode test", func(t *testing.T) {
+		c1, c2 := dialClient(t), dialClient(t)
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(
+			ctx,
+			d1,
+			client.WithRealtimeSync(),
+			client.WithSyncMode(types.SyncModeRealtimeSyncOff),
+		))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2, client.WithRealtimeSync()))
+
+		// 01. The document in RealtimeSyncOff mode neither pushes nor pulls.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.Eventually(t, func() bool {
+			return !d2.HasLocalChanges()
+		}, 3*gotime.Second, 10*gotime.Millisecond)
+		gotime.Sleep(100 * gotime.Millisecond)
+		assert.True(t, d1.HasLocalChanges())
+		assert.Equal(t, `{"k1":"v1"}`, d1.Marshal())
+		assert.Equal(t, `{"k2":"v2"}`, d2.Marshal())
+
+		// 02. The documents converge after changing the mode to PushPull.
+		assert.NoError(t, c1.ChangeSyncMode(d1, types.SyncModePushPull))
+		assert.Eventually(t, func() bool {
+			return d1.Marshal() == `{"k1":"v1","k2":"v2"}` && d2.Marshal() == `{"k1":"v1","k2":"v2"}`
+		}, 3*gotime.Second, 10*gotime.Millisecond)
+	})
+
+	t.Run("deactivate stops sync loops and watch streams test", func(t *testing.T) {
+		c1 := dialClient(t)
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1, client.WithRealtimeSync()))
+		rch, _, err := c1.Subscribe(d1)
+		assert.NoError(t, err)
+
+		// NOTE: The channel is closed after the watch stream is stopped.
+		assert.NoError(t, c1.Deactivate(ctx))
+		assert.Eventually(t, func() bool {
+			for {
+				select {
+				case _, ok := <-rch:
+					if !ok {
+						return true
+					}
+				default:
+					return false
+				}
+			}
+		}, 3*gotime.Second, 10*gotime.Millisecond)
+
+		// NOTE: The local changes are no longer pushed by the sync loop.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		gotime.Sleep(100 * gotime.Millisecond)
+		assert.True(t, d1.HasLocalChanges())
+	})
+}
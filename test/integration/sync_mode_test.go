@@ -0,0 +1,113 @@
+//go:build integration
+
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestSyncMode(t *testing.T) {
+	clients := activeClients(t, 2)
+	c1, c2 := clients[0], clients[1]
+	defer deactivateAndCloseClients(t, clients)
+
+	t.Run("pull only mode test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1, client.WithSyncMode(types.SyncModePullOnly)))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. The document attached in PullOnly mode pulls the changes of others.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, `{"k1":"v1"}`, d1.Marshal())
+
+		// 02. The document attached in PullOnly mode rejects the updates,
+		// because the server rejects the changes of it.
+		err := d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		})
+		assert.ErrorIs(t, err, document.ErrDocumentReadOnly)
+		assert.False(t, d1.HasLocalChanges())
+		assert.Equal(t, `{"k1":"v1"}`, d1.Marshal())
+
+		// 03. The document attached in PullOnly mode can not push changes.
+		assert.ErrorIs(t, c1.ChangeSyncMode(d1, types.SyncModePushPull), client.ErrDocumentPullOnly)
+		assert.ErrorIs(t, c1.ChangeSyncMode(d1, types.SyncModePushOnly), client.ErrDocumentPullOnly)
+		assert.NoError(t, c1.ChangeSyncMode(d1, types.SyncModeRealtimeSyncOff))
+		assert.NoError(t, c1.Sync(ctx))
+
+		assert.NoError(t, c1.Detach(ctx, d1))
+		assert.NoError(t, c2.Detach(ctx, d2))
+
+		// 04. The document with local changes can not be attached in PullOnly mode.
+		d3 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, d3.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k3", "v3")
+			return nil
+		}))
+		assert.ErrorIs(t, c1.Attach(ctx, d3, client.WithSyncMode(types.SyncModePullOnly)), client.ErrDocumentPullOnly)
+	})
+
+	t.Run("change to pull only mode test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. The local changes are held back after changing to PullOnly mode.
+		assert.NoError(t, c1.ChangeSyncMode(d1, types.SyncModePullOnly))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{}`, d2.Marshal())
+
+		// 02. The held back changes are pushed after changing to PushPull mode.
+		assert.NoError(t, c1.ChangeSyncMode(d1, types.SyncModePushPull))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.False(t, d1.HasLocalChanges())
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+
+		assert.NoError(t, c1.Detach(ctx, d1))
+		assert.NoError(t, c2.Detach(ctx, d2))
+	})
+}